@@ -19,29 +19,41 @@ const (
 	ArgumentKeySupport           = "support"
 	ArgumentKeySerializers       = "serializers"
 	ArgumentKeyTransformStrategy = "transform"
+	ArgumentKeyLookupStrategy    = "lookup"
 	ArgumentKeyScanDirectory     = "dir"
 	ArgumentKeyOutputFile        = "out"
 	ArgumentKeyKeepFile          = "keepfile"
+	ArgumentKeyTests             = "tests"
+	ArgumentKeyGettextDirectory  = "gettext"
+	ArgumentKeyGraphQLDirectory  = "graphql"
+	ArgumentKeyEntDirectory      = "ent"
+	ArgumentKeySqlcDirectory     = "sqlc"
 )
 
-func parseFlags(args []string, cArgs *config.Args, scanPath, outputFile *string, keepFile *bool) error {
+func parseFlags(args []string, cArgs *config.Args, scanPath, outputFile, gettextDir, graphqlDir, entDir, sqlcDir *string, keepFile, tests *bool) error {
 	// setup flags
 	flags := flag.NewFlagSet("", flag.ContinueOnError)
 	flags.SetOutput(io.Discard)
 	flags.StringVar(outputFile, ArgumentKeyOutputFile, "types_enumer", "the filename of the generated file; defaults to \"types_enumer\" which results in \"types_enumer.go\".")
-	flags.StringVar(&cArgs.TransformStrategy, ArgumentKeyTransformStrategy, "noop", "string transformation (camel|pascal|kebab|snake|... see README.md); defaults to \"noop\" which applies no transormation to the enum values.")
-	flags.Var(&cArgs.Serializers, ArgumentKeySerializers, "a list of opt-in serializers (binary|json|sql|text|yaml).")
-	flags.Var(&cArgs.SupportedFeatures, ArgumentKeySupport, "a list of opt-in supported features (undefined|ignore-case|ent).")
+	flags.StringVar(&cArgs.TransformStrategy, ArgumentKeyTransformStrategy, "noop", fmt.Sprintf("string transformation (%s); defaults to \"noop\" which applies no transormation to the enum values.", strings.Join(config.TransformStrategies, "|")))
+	flags.StringVar(&cArgs.LookupStrategy, ArgumentKeyLookupStrategy, "map", fmt.Sprintf("string lookup of the FromString functions (%s); defaults to \"map\", while \"auto\" selects the lookup by the count of enum values.", strings.Join(config.LookupStrategies, "|")))
+	flags.Var(&cArgs.Serializers, ArgumentKeySerializers, fmt.Sprintf("a list of opt-in serializers (%s).", strings.Join(config.Serializers, "|")))
+	flags.Var(&cArgs.SupportedFeatures, ArgumentKeySupport, fmt.Sprintf("a list of opt-in supported features (%s).", strings.Join(config.SupportedFeatures, "|")))
 	flags.StringVar(scanPath, ArgumentKeyScanDirectory, "", "directory of target package; defaults to CWD.")
+	flags.StringVar(gettextDir, ArgumentKeyGettextDirectory, "", "directory to export the enum labels to as gettext message catalogs (<package>.<language>.po); relative to the target package.")
+	flags.StringVar(graphqlDir, ArgumentKeyGraphQLDirectory, "", "directory to export the enums with \"graphql\" serializer to as GraphQL schema (<package>.graphqls); relative to the target package.")
+	flags.StringVar(entDir, ArgumentKeyEntDirectory, "", "directory of the ent schema package to export the field helpers of the enums with \"ent\" support to (<package>_enumer.go); relative to the target package.")
+	flags.StringVar(sqlcDir, ArgumentKeySqlcDirectory, "", "directory to export the sqlc type overrides of the enums with \"sql\" serializer to (<package>.sqlc.yaml); relative to the target package.")
+	flags.BoolVar(tests, ArgumentKeyTests, false, "generate tests of the enums next to the generated file (<out>_test.go), which round-trip all values through the serializers and include fuzz targets.")
 	flags.BoolVar(keepFile, ArgumentKeyKeepFile, false, "for testing purposes: prevents deleting existing enumer file; defaults to `false`.")
 	return flags.Parse(args)
 }
 
 func Execute(args []string) error {
 	var cArgs config.Args
-	var scanPath, outputFile string
-	var keepFile bool
-	err := parseFlags(args, &cArgs, &scanPath, &outputFile, &keepFile)
+	var scanPath, outputFile, gettextDir, graphqlDir, entDir, sqlcDir string
+	var keepFile, tests bool
+	err := parseFlags(args, &cArgs, &scanPath, &outputFile, &gettextDir, &graphqlDir, &entDir, &sqlcDir, &keepFile, &tests)
 	if err != nil {
 		return fmt.Errorf("failed parsing arguments. err: %s", err)
 	}
@@ -72,7 +84,11 @@ func Execute(args []string) error {
 		gen.NewInspector(cfg),
 		gen.NewRenderer(cfg),
 	)
-	buf, err := g.Generate(targetDir)
+	file, err := g.Inspect(targetDir)
+	if err != nil {
+		return fmt.Errorf("failed generating code. err: %s", err)
+	}
+	buf, err := g.Render(file)
 	if err != nil {
 		return fmt.Errorf("failed generating code. err: %s", err)
 	}
@@ -87,9 +103,111 @@ func Execute(args []string) error {
 	if err != nil {
 		return fmt.Errorf("failed writing output to file. err: %s", err)
 	}
+
+	if tests {
+		buf, err := g.RenderTests(file)
+		if err != nil {
+			return fmt.Errorf("failed generating tests. err: %s", err)
+		}
+		filename := targetFilename(targetDir, outputFile+"_test", cfg)
+		if err := os.WriteFile(filename, buf, 0o644); err != nil {
+			return fmt.Errorf("failed writing tests to file. err: %s", err)
+		}
+	}
+	if len(gettextDir) > 0 {
+		if err := exportGettext(targetDir, gettextDir, file); err != nil {
+			return fmt.Errorf("failed exporting labels. err: %s", err)
+		}
+	}
+	if len(graphqlDir) > 0 {
+		if err := exportGraphQLSchema(targetDir, graphqlDir, file); err != nil {
+			return fmt.Errorf("failed exporting graphql schema. err: %s", err)
+		}
+	}
+	if len(entDir) > 0 {
+		if err := exportEntFields(targetDir, entDir, file); err != nil {
+			return fmt.Errorf("failed exporting ent fields. err: %s", err)
+		}
+	}
+	if len(sqlcDir) > 0 {
+		if err := exportSqlcOverrides(targetDir, sqlcDir, file); err != nil {
+			return fmt.Errorf("failed exporting sqlc overrides. err: %s", err)
+		}
+	}
+	return nil
+}
+
+func exportGettext(targetDir, gettextDir string, file *gen.File) error {
+	if !filepath.IsAbs(gettextDir) {
+		gettextDir = filepath.Join(targetDir, gettextDir)
+	}
+	catalogs := gen.ExportGettext(file)
+	if len(catalogs) == 0 {
+		return errors.New("no enum labels detected")
+	}
+	if err := os.MkdirAll(gettextDir, os.ModePerm); err != nil {
+		return err
+	}
+	for lang, buf := range catalogs {
+		filename := filepath.Join(gettextDir, fmt.Sprintf("%s.%s.po", file.Header.Package.Name, lang))
+		if err := os.WriteFile(filename, buf, 0o644); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
+func exportGraphQLSchema(targetDir, graphqlDir string, file *gen.File) error {
+	if !filepath.IsAbs(graphqlDir) {
+		graphqlDir = filepath.Join(targetDir, graphqlDir)
+	}
+	schema := gen.ExportGraphQLSchema(file)
+	if schema == nil {
+		return errors.New("no enums with graphql serializer detected")
+	}
+	if err := os.MkdirAll(graphqlDir, os.ModePerm); err != nil {
+		return err
+	}
+	filename := filepath.Join(graphqlDir, fmt.Sprintf("%s.graphqls", file.Header.Package.Name))
+	return os.WriteFile(filename, schema, 0o644)
+}
+
+func exportEntFields(targetDir, entDir string, file *gen.File) error {
+	if !filepath.IsAbs(entDir) {
+		entDir = filepath.Join(targetDir, entDir)
+	}
+	if filepath.Clean(entDir) == filepath.Clean(targetDir) {
+		return errors.New("the ent schema package must differ from the target package")
+	}
+	src, err := gen.ExportEntFields(file, filepath.Base(entDir))
+	if err != nil {
+		return err
+	}
+	if src == nil {
+		return errors.New("no enums with ent support detected")
+	}
+	if err := os.MkdirAll(entDir, os.ModePerm); err != nil {
+		return err
+	}
+	filename := filepath.Join(entDir, fmt.Sprintf("%s_enumer.go", file.Header.Package.Name))
+	return os.WriteFile(filename, src, 0o644)
+}
+
+func exportSqlcOverrides(targetDir, sqlcDir string, file *gen.File) error {
+	if !filepath.IsAbs(sqlcDir) {
+		sqlcDir = filepath.Join(targetDir, sqlcDir)
+	}
+	overrides := gen.ExportSqlcOverrides(file)
+	if overrides == nil {
+		return errors.New("no enums with sql serializer detected")
+	}
+	if err := os.MkdirAll(sqlcDir, os.ModePerm); err != nil {
+		return err
+	}
+	filename := filepath.Join(sqlcDir, fmt.Sprintf("%s.sqlc.yaml", file.Header.Package.Name))
+	return os.WriteFile(filename, overrides, 0o644)
+}
+
 var targetFilename = func(dir, filename string, cfg *config.Options) string {
 	filename = fmt.Sprintf("%s.go", filename)
 	return filepath.Join(dir, filename)
@@ -131,8 +249,7 @@ var findAndDeleteOldGeneratedFile = func(dir string) error {
 			return fmt.Errorf("failed reading first %d bytes of file %q", buf.Len(), fse.Name())
 		}
 		if enumer.GEN_ENUMER_FILE.Match(buf.Bytes()) {
-			os.Remove(inspectFile)
-			break
+			os.Remove(inspectFile) // hint: also removes the generated tests
 		}
 	}
 	return nil
@@ -148,8 +265,5 @@ func validate(filename string, cfg *config.Options) error {
 	if strings.ContainsAny(filename, "\"") {
 		return errors.New("output file name contains forbidden characters")
 	}
-	if cfg.Serializers.Contains(config.SerializerYaml) && cfg.Serializers.Contains(config.SerializerYamlV3) {
-		return fmt.Errorf("serializers %q and %q cannot be applied together", config.SerializerYaml, config.SerializerYamlV3)
-	}
-	return nil
+	return cfg.Validate()
 }
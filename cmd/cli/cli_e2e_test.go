@@ -31,7 +31,13 @@ func TestE2E_CLI(t *testing.T) {
 		{"standard serializers - deserialize with ignore case",
 			"greeting", []string{"-serializers=sql,graphql,json,yaml,binary,text", "-support=ignore-case"}, "gen.serializers.ignore-case.golden"},
 		{"standard output and ent interface",
-			"greeting", []string{"-support=ent"}, "gen.ent.golden"},
+			"greeting", []string{"-serializers=sql.int", "-support=ent"}, "gen.ent.golden"},
+		{"lookup by perfect hash",
+			"greeting", []string{"-lookup=perfect-hash"}, "gen.lookup.perfect-hash.golden"},
+		{"lookup by switch",
+			"greeting", []string{"-lookup=switch"}, "gen.lookup.switch.golden"},
+		{"lookup by map - same as default",
+			"greeting", []string{"-lookup=map"}, "gen.golden"},
 	}
 	for idx, tC := range testcases {
 		t.Run(fmt.Sprintf("Generate (idx: %d %q)", idx, tC.desc), func(t *testing.T) {
@@ -57,6 +63,145 @@ func TestE2E_CLI(t *testing.T) {
 	}
 }
 
+func TestE2E_Tests(t *testing.T) {
+	cli.PatchDeleteOldGeneratedFileFunc(t)
+
+	t.Run("generate tests next to the generated file", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		cli.PatchTargetFilenameFunc(t, tmpDir)
+
+		err := cli.Execute([]string{"-dir=" + filepath.Join("testdata", "greeting"), "-serializers=json,sql.int,text", "-support=ignore-case", "-tests"})
+		require.NoError(t, err)
+		require.FileExists(t, filepath.Join(tmpDir, "types_enumer.go"))
+
+		actual, err := os.ReadFile(filepath.Join(tmpDir, "types_enumer_test.go"))
+		require.NoError(t, err)
+		expected, err := os.ReadFile(filepath.Join("testdata", "greeting", "gen.tests.golden"))
+		require.NoError(t, err)
+		require.Equal(t, string(expected), string(actual))
+	})
+}
+
+func TestE2E_GettextExport(t *testing.T) {
+	cli.PatchDeleteOldGeneratedFileFunc(t)
+
+	t.Run("export labels as gettext message catalogs", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		cli.PatchTargetFilenameFunc(t, tmpDir)
+		gettextDir := filepath.Join(tmpDir, "locales")
+
+		err := cli.Execute([]string{"-dir=" + filepath.Join("testdata", "labels"), "-gettext=" + gettextDir})
+		require.NoError(t, err)
+
+		for _, filename := range []string{"labels.de.po", "labels.en.po"} {
+			actual, err := os.ReadFile(filepath.Join(gettextDir, filename))
+			require.NoError(t, err)
+			expected, err := os.ReadFile(filepath.Join("testdata", "labels", filename))
+			require.NoError(t, err)
+			require.Equal(t, string(expected), string(actual))
+		}
+	})
+	t.Run("fail on missing labels", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		cli.PatchTargetFilenameFunc(t, tmpDir)
+
+		err := cli.Execute([]string{"-dir=" + filepath.Join("testdata", "greeting"), "-gettext=" + tmpDir})
+		require.EqualError(t, err, "failed exporting labels. err: no enum labels detected")
+	})
+}
+
+func TestE2E_GraphQLSchemaExport(t *testing.T) {
+	cli.PatchDeleteOldGeneratedFileFunc(t)
+
+	t.Run("export enums as graphql schema", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		cli.PatchTargetFilenameFunc(t, tmpDir)
+		graphqlDir := filepath.Join(tmpDir, "graphql")
+
+		err := cli.Execute([]string{"-dir=" + filepath.Join("testdata", "greeting"), "-serializers=graphql", "-transform=upper", "-graphql=" + graphqlDir})
+		require.NoError(t, err)
+
+		actual, err := os.ReadFile(filepath.Join(graphqlDir, "greeting.graphqls"))
+		require.NoError(t, err)
+		expected, err := os.ReadFile(filepath.Join("testdata", "greeting", "greeting.graphqls"))
+		require.NoError(t, err)
+		require.Equal(t, string(expected), string(actual))
+	})
+	t.Run("fail on missing graphql serializer", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		cli.PatchTargetFilenameFunc(t, tmpDir)
+
+		err := cli.Execute([]string{"-dir=" + filepath.Join("testdata", "greeting"), "-graphql=" + tmpDir})
+		require.EqualError(t, err, "failed exporting graphql schema. err: no enums with graphql serializer detected")
+	})
+}
+
+func TestE2E_EntFieldsExport(t *testing.T) {
+	cli.PatchDeleteOldGeneratedFileFunc(t)
+
+	t.Run("export ent field helpers", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		cli.PatchTargetFilenameFunc(t, tmpDir)
+		entDir := filepath.Join(tmpDir, "schema")
+
+		err := cli.Execute([]string{"-dir=" + filepath.Join("testdata", "greeting"), "-serializers=sql.int", "-support=ent", "-ent=" + entDir})
+		require.NoError(t, err)
+
+		actual, err := os.ReadFile(filepath.Join(entDir, "greeting_enumer.go"))
+		require.NoError(t, err)
+		expected, err := os.ReadFile(filepath.Join("testdata", "greeting", "gen.ent.schema.golden"))
+		require.NoError(t, err)
+		require.Equal(t, string(expected), string(actual))
+	})
+	t.Run("fail on missing ent support", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		cli.PatchTargetFilenameFunc(t, tmpDir)
+
+		err := cli.Execute([]string{"-dir=" + filepath.Join("testdata", "greeting"), "-ent=" + tmpDir})
+		require.EqualError(t, err, "failed exporting ent fields. err: no enums with ent support detected")
+	})
+	t.Run("fail on ent support without sql serializer", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		cli.PatchTargetFilenameFunc(t, tmpDir)
+
+		err := cli.Execute([]string{"-dir=" + filepath.Join("testdata", "greeting"), "-serializers=json", "-support=ent", "-ent=" + tmpDir})
+		require.ErrorContains(t, err, "feature \"ent\" requires serializer \"sql\" or \"sql.int\"")
+	})
+	t.Run("fail on ent schema package equal to the target package", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		cli.PatchTargetFilenameFunc(t, tmpDir)
+
+		err := cli.Execute([]string{"-dir=" + filepath.Join("testdata", "greeting"), "-serializers=sql.int", "-support=ent", "-ent=."})
+		require.EqualError(t, err, "failed exporting ent fields. err: the ent schema package must differ from the target package")
+	})
+}
+
+func TestE2E_SqlcOverridesExport(t *testing.T) {
+	cli.PatchDeleteOldGeneratedFileFunc(t)
+
+	t.Run("export sqlc overrides", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		cli.PatchTargetFilenameFunc(t, tmpDir)
+		sqlcDir := filepath.Join(tmpDir, "sqlc")
+
+		err := cli.Execute([]string{"-dir=" + filepath.Join("testdata", "greeting"), "-serializers=sql", "-sqlc=" + sqlcDir})
+		require.NoError(t, err)
+
+		actual, err := os.ReadFile(filepath.Join(sqlcDir, "greeting.sqlc.yaml"))
+		require.NoError(t, err)
+		expected, err := os.ReadFile(filepath.Join("testdata", "greeting", "greeting.sqlc.yaml"))
+		require.NoError(t, err)
+		require.Equal(t, string(expected), string(actual))
+	})
+	t.Run("fail on missing sql serializer", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		cli.PatchTargetFilenameFunc(t, tmpDir)
+
+		err := cli.Execute([]string{"-dir=" + filepath.Join("testdata", "greeting"), "-serializers=sql.int", "-sqlc=" + tmpDir})
+		require.EqualError(t, err, "failed exporting sqlc overrides. err: no enums with sql serializer detected")
+	})
+}
+
 func TestE2E_DeleteOldGeneratedFile(t *testing.T) {
 	t.Run("delete generated file from temp directory with various files", func(t *testing.T) {
 		tmpDir := t.TempDir()
@@ -87,13 +232,17 @@ func TestE2E_DeleteOldGeneratedFile(t *testing.T) {
 			// this is our TARGET (marked with x to ensure its read as last entry)
 			err = os.WriteFile(filepath.Join(tmpDir, "x_deleteMe.go"), buf[:100], os.ModePerm)
 			require.NoError(t, err)
+			// these are the generated tests of our TARGET
+			err = os.WriteFile(filepath.Join(tmpDir, "x_deleteMe_test.go"), buf[:100], os.ModePerm)
+			require.NoError(t, err)
 		}
 
 		args := []string{"-dir=" + tmpDir}
 		err = cli.Execute(args)
 		require.ErrorContains(t, err, "no enums detected")
 
-		require.NoFileExists(t, filepath.Join(tmpDir, "deleteMe.go"))
+		require.NoFileExists(t, filepath.Join(tmpDir, "x_deleteMe.go"))
+		require.NoFileExists(t, filepath.Join(tmpDir, "x_deleteMe_test.go"))
 
 		require.DirExists(t, filepath.Join(tmpDir, "keepMe_Dir"))
 		for _, filename := range []string{"keepMe", "keepMe_0.go", "keepMe_1.go", "keepMe_2.go"} {
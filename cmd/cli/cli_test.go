@@ -39,6 +39,26 @@ func TestCli(t *testing.T) {
 				[]string{"-serializers=yaml,yaml.v3"},
 				"serializers \"yaml\" and \"yaml.v3\" cannot be applied together",
 			},
+			{
+				"on misspelled transform strategy",
+				[]string{"-transform=snkae"},
+				"unknown transform strategy \"snkae\" (did you mean \"snake\"?)",
+			},
+			{
+				"on unknown lookup strategy",
+				[]string{"-lookup=btree"},
+				"unknown lookup strategy \"btree\" (valid values: \"auto\", \"map\", \"switch\", \"perfect-hash\")",
+			},
+			{
+				"on unknown serializer",
+				[]string{"-serializers=json,protobuf"},
+				"unknown serializer \"protobuf\" (valid values: \"binary\", \"binary.varint\", \"bson\", \"cbor\", \"flag\", \"gob\", \"graphql\", \"json\", \"msgpack\", \"sql\", \"sql.int\", \"text\", \"xml\", \"yaml\", \"yaml.v3\")",
+			},
+			{
+				"on misspelled supported feature",
+				[]string{"-support=ignorecase"},
+				"unknown supported feature \"ignorecase\" (did you mean \"ignore-case\"?)",
+			},
 		}
 		for _, tC := range testcases {
 			t.Run(tC.desc, func(t *testing.T) {
@@ -0,0 +1,4 @@
+package labels
+
+//go:enum -from=fruits.csv
+type Fruit uint
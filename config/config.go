@@ -1,28 +1,83 @@
 package config
 
 import (
+	"fmt"
 	"sort"
 	"strings"
 
 	env "github.com/ilyakaznacheev/cleanenv"
+	"github.com/mvrahden/go-enumer/internal/suggest"
 	"github.com/mvrahden/go-enumer/pkg/utils/slices"
 )
 
 const (
-	SerializerBinary = "binary"
-	SerializerBSON   = "bson"
-	SerializerGQL    = "graphql"
-	SerializerJSON   = "json"
-	SerializerSQL    = "sql"
-	SerializerText   = "text"
-	SerializerYaml   = "yaml"
-	SerializerYamlV3 = "yaml.v3"
+	TransformNoop       = "noop"
+	TransformCamel      = "camel"
+	TransformPascal     = "pascal"
+	TransformKebab      = "kebab"
+	TransformSnake      = "snake"
+	TransformLower      = "lower"
+	TransformUpper      = "upper"
+	TransformUpperKebab = "upper-kebab"
+	TransformUpperSnake = "upper-snake"
+	TransformWhitespace = "whitespace"
+)
+
+const (
+	SerializerBinary       = "binary"
+	SerializerBinaryVarint = "binary.varint"
+	SerializerBSON         = "bson"
+	SerializerCBOR         = "cbor"
+	SerializerFlag         = "flag"
+	SerializerGob          = "gob"
+	SerializerGQL          = "graphql"
+	SerializerJSON         = "json"
+	SerializerMsgpack      = "msgpack"
+	SerializerSQL          = "sql"
+	SerializerSQLInt       = "sql.int"
+	SerializerText         = "text"
+	SerializerXML          = "xml"
+	SerializerYaml         = "yaml"
+	SerializerYamlV3       = "yaml.v3"
+)
+
+const (
+	LookupAuto        = "auto"
+	LookupMap         = "map"
+	LookupSwitch      = "switch"
+	LookupPerfectHash = "perfect-hash"
 )
 
 const (
 	SupportUndefined    = "undefined"
 	SupportIgnoreCase   = "ignore-case"
+	SupportUnicodeFold  = "unicode-fold"
 	SupportEntInterface = "ent"
+	SupportGorm         = "gorm"
+	SupportPgx          = "pgx"
+	SupportRegistry     = "registry"
+	SupportSet          = "set"
+	SupportSlog         = "slog"
+	SupportZap          = "zap"
+)
+
+var (
+	TransformStrategies = []string{
+		TransformNoop, TransformCamel, TransformPascal, TransformKebab, TransformSnake,
+		TransformLower, TransformUpper, TransformUpperKebab, TransformUpperSnake, TransformWhitespace,
+	}
+	Serializers = []string{
+		SerializerBinary, SerializerBinaryVarint, SerializerBSON, SerializerCBOR, SerializerFlag, SerializerGob,
+		SerializerGQL, SerializerJSON, SerializerMsgpack, SerializerSQL, SerializerSQLInt, SerializerText,
+		SerializerXML, SerializerYaml, SerializerYamlV3,
+	}
+	LookupStrategies = []string{
+		LookupAuto, LookupMap, LookupSwitch, LookupPerfectHash,
+	}
+	SupportedFeatures = []string{
+		SupportUndefined, SupportIgnoreCase, SupportUnicodeFold, SupportEntInterface, SupportGorm, SupportPgx,
+		SupportRegistry, SupportSet, SupportSlog, SupportZap,
+	}
 )
 
 type Args Options
@@ -30,6 +85,7 @@ type Options struct {
 	TransformStrategy string     `yaml:"transform" env-default:"noop"`
 	Serializers       stringList `yaml:"serializers"`
 	SupportedFeatures stringList `yaml:"support"`
+	LookupStrategy    string     `yaml:"lookup" env-default:"map"`
 }
 
 func (o *Options) Clone() *Options {
@@ -40,6 +96,62 @@ func (o *Options) Clone() *Options {
 	return (*Options)(&args)
 }
 
+// Validate asserts that all options refer to known values.
+// Unknown values are reported along with the closest valid names.
+func (o *Options) Validate() error {
+	// hint: an empty transform strategy falls back to "noop"
+	if len(o.TransformStrategy) > 0 {
+		if err := validateValue("transform strategy", o.TransformStrategy, TransformStrategies); err != nil {
+			return err
+		}
+	}
+	// hint: an empty lookup strategy falls back to "map"
+	if len(o.LookupStrategy) > 0 {
+		if err := validateValue("lookup strategy", o.LookupStrategy, LookupStrategies); err != nil {
+			return err
+		}
+	}
+	for _, v := range o.Serializers {
+		if err := validateValue("serializer", v, Serializers); err != nil {
+			return err
+		}
+	}
+	for _, v := range o.SupportedFeatures {
+		if err := validateValue("supported feature", v, SupportedFeatures); err != nil {
+			return err
+		}
+	}
+	if o.Serializers.Contains(SerializerYaml) && o.Serializers.Contains(SerializerYamlV3) {
+		return fmt.Errorf("serializers %q and %q cannot be applied together", SerializerYaml, SerializerYamlV3)
+	}
+	if o.Serializers.Contains(SerializerBinary) && o.Serializers.Contains(SerializerBinaryVarint) {
+		return fmt.Errorf("serializers %q and %q cannot be applied together", SerializerBinary, SerializerBinaryVarint)
+	}
+	if o.Serializers.Contains(SerializerSQL) && o.Serializers.Contains(SerializerSQLInt) {
+		return fmt.Errorf("serializers %q and %q cannot be applied together", SerializerSQL, SerializerSQLInt)
+	}
+	if o.SupportedFeatures.Contains(SupportEntInterface) && !o.Serializers.Contains(SerializerSQL) && !o.Serializers.Contains(SerializerSQLInt) {
+		return fmt.Errorf("feature %q requires serializer %q or %q", SupportEntInterface, SerializerSQL, SerializerSQLInt)
+	}
+	return nil
+}
+
+func validateValue(kind, value string, valid []string) error {
+	if slices.Any(valid, func(v string, _ int) bool { return v == value }) {
+		return nil
+	}
+	if suggestions := suggest.ClosestMatches(value, valid); len(suggestions) > 0 {
+		return fmt.Errorf("unknown %s %q (did you mean %s?)", kind, value, quoteJoin(suggestions, " or "))
+	}
+	return fmt.Errorf("unknown %s %q (valid values: %s)", kind, value, quoteJoin(valid, ", "))
+}
+
+func quoteJoin(values []string, sep string) string {
+	return strings.Join(slices.Map(values, func(v string, _ int) string {
+		return fmt.Sprintf("%q", v)
+	}), sep)
+}
+
 type stringList []string
 
 func (sl stringList) Contains(s string) bool {
@@ -70,6 +182,10 @@ func (sl *stringList) Set(v string) error {
 	v = strings.ReplaceAll(v, ", ", ",")
 	v = strings.TrimSpace(v)
 	v = strings.TrimSuffix(v, ",")
+	if len(v) == 0 {
+		*sl = nil
+		return nil
+	}
 	*sl = strings.Split(v, ",")
 	return nil
 }
@@ -21,6 +21,7 @@ func TestConfigLoading(t *testing.T) {
 		cfg := LoadFrom("")
 		require.Equal(t, &Options{
 			TransformStrategy: "noop",
+			LookupStrategy:    "map",
 		}, cfg)
 	})
 	t.Run("Load from Config file", func(t *testing.T) {
@@ -28,7 +29,8 @@ func TestConfigLoading(t *testing.T) {
 transform: def
 addPrefix: ghi
 serializers: [jkl,mno,pqr]
-support: [stu,vwx,yz]`), os.ModePerm)
+support: [stu,vwx,yz]
+lookup: abc`), os.ModePerm)
 		require.NoError(t, err)
 
 		cfg := LoadFrom(configFile)
@@ -36,6 +38,7 @@ support: [stu,vwx,yz]`), os.ModePerm)
 			TransformStrategy: "def",
 			Serializers:       []string{"jkl", "mno", "pqr"},
 			SupportedFeatures: []string{"stu", "vwx", "yz"},
+			LookupStrategy:    "abc",
 		}, cfg)
 	})
 	t.Run("Load with Args", func(t *testing.T) {
@@ -44,13 +47,15 @@ support: [stu,vwx,yz]`), os.ModePerm)
 			cfg := LoadWith(args)
 			require.Equal(t, &Options{
 				TransformStrategy: "noop",
+				LookupStrategy:    "map",
 			}, cfg)
 		})
 		t.Run("preserves value if value present", func(t *testing.T) {
-			args := &Args{TransformStrategy: "def"}
+			args := &Args{TransformStrategy: "def", LookupStrategy: "abc"}
 			cfg := LoadWith(args)
 			require.Equal(t, &Options{
 				TransformStrategy: "def",
+				LookupStrategy:    "abc",
 			}, cfg)
 		})
 	})
@@ -66,6 +71,58 @@ func TestCopy(t *testing.T) {
 	})
 }
 
+func TestValidate(t *testing.T) {
+	t.Run("passes on known values", func(t *testing.T) {
+		cfg := &Options{
+			TransformStrategy: TransformUpperSnake,
+			Serializers:       stringList{SerializerJSON, SerializerYamlV3},
+			SupportedFeatures: stringList{SupportIgnoreCase, SupportUndefined},
+			LookupStrategy:    LookupPerfectHash,
+		}
+		require.NoError(t, cfg.Validate())
+	})
+	t.Run("fails on unknown values with suggestions", func(t *testing.T) {
+		for _, tC := range []struct {
+			cfg Options
+			msg string
+		}{
+			{Options{TransformStrategy: "snkae"}, "unknown transform strategy \"snkae\" (did you mean \"snake\"?)"},
+			{Options{TransformStrategy: "upper_snake"}, "unknown transform strategy \"upper_snake\" (did you mean \"upper-snake\"?)"},
+			{Options{TransformStrategy: "upperkebab"}, "unknown transform strategy \"upperkebab\" (did you mean \"upper-kebab\"?)"},
+			{Options{TransformStrategy: "noop", Serializers: stringList{"yml"}}, "unknown serializer \"yml\" (did you mean \"xml\" or \"yaml\"?)"},
+			{Options{TransformStrategy: "noop", SupportedFeatures: stringList{"undefinde"}}, "unknown supported feature \"undefinde\" (did you mean \"undefined\"?)"},
+			{Options{TransformStrategy: "noop", LookupStrategy: "perfecthash"}, "unknown lookup strategy \"perfecthash\" (did you mean \"perfect-hash\"?)"},
+			{Options{TransformStrategy: "xxxxxxxx"}, "unknown transform strategy \"xxxxxxxx\" (valid values: \"noop\", \"camel\", \"pascal\", \"kebab\", \"snake\", \"lower\", \"upper\", \"upper-kebab\", \"upper-snake\", \"whitespace\")"},
+		} {
+			require.EqualError(t, tC.cfg.Validate(), tC.msg)
+		}
+	})
+	t.Run("fails on conflicting yaml serializers", func(t *testing.T) {
+		cfg := &Options{TransformStrategy: "noop", Serializers: stringList{SerializerYaml, SerializerYamlV3}}
+		require.EqualError(t, cfg.Validate(), "serializers \"yaml\" and \"yaml.v3\" cannot be applied together")
+	})
+	t.Run("fails on conflicting sql serializers", func(t *testing.T) {
+		cfg := &Options{TransformStrategy: "noop", Serializers: stringList{SerializerSQL, SerializerSQLInt}}
+		require.EqualError(t, cfg.Validate(), "serializers \"sql\" and \"sql.int\" cannot be applied together")
+	})
+	t.Run("fails on conflicting binary serializers", func(t *testing.T) {
+		cfg := &Options{TransformStrategy: "noop", Serializers: stringList{SerializerBinaryVarint, SerializerBinary}}
+		require.EqualError(t, cfg.Validate(), "serializers \"binary\" and \"binary.varint\" cannot be applied together")
+	})
+	t.Run("fails on ent without sql serializer", func(t *testing.T) {
+		for _, serializers := range []stringList{nil, {SerializerJSON}, {SerializerText, SerializerBinaryVarint}} {
+			cfg := &Options{TransformStrategy: "noop", Serializers: serializers, SupportedFeatures: stringList{SupportEntInterface}}
+			require.EqualError(t, cfg.Validate(), "feature \"ent\" requires serializer \"sql\" or \"sql.int\"")
+		}
+	})
+	t.Run("succeeds on ent with sql serializer", func(t *testing.T) {
+		for _, serializers := range []stringList{{SerializerSQL}, {SerializerSQLInt}, {SerializerJSON, SerializerSQLInt}} {
+			cfg := &Options{TransformStrategy: "noop", Serializers: serializers, SupportedFeatures: stringList{SupportEntInterface}}
+			require.NoError(t, cfg.Validate())
+		}
+	})
+}
+
 func TestStringList(t *testing.T) {
 	t.Run("Contains", func(t *testing.T) {
 		require.False(t, stringList{"a", "b", "c"}.Contains("v"))
@@ -83,5 +140,7 @@ func TestStringList(t *testing.T) {
 		sl := stringList{"a", "b", "a"}
 		require.NoError(t, sl.Set("a,b, c, d"))
 		require.Equal(t, "a,b,c,d", sl.String())
+		require.NoError(t, sl.Set(""))
+		require.Empty(t, sl)
 	})
 }
@@ -0,0 +1,89 @@
+package enum
+
+import (
+	"fmt"
+	"strings"
+)
+
+const arraySpaces = " \t\n\r\v\f"
+
+// AppendArray appends the elements as one-dimensional Postgres array literal to b,
+// e.g. `{Red,"dark blue"}`. Elements are quoted if they are empty, read NULL
+// or contain whitespace or any character with a special meaning within arrays.
+// It encodes the sets of generated enums with the `sql` or `sql.int` serializer.
+func AppendArray(b []byte, elems []string) []byte {
+	b = append(b, '{')
+	for idx, elem := range elems {
+		if idx > 0 {
+			b = append(b, ',')
+		}
+		if len(elem) > 0 && !strings.EqualFold(elem, "NULL") && !strings.ContainsAny(elem, `"\{},`+arraySpaces) {
+			b = append(b, elem...)
+			continue
+		}
+		b = append(b, '"')
+		for i := 0; i < len(elem); i++ {
+			if elem[i] == '"' || elem[i] == '\\' {
+				b = append(b, '\\')
+			}
+			b = append(b, elem[i])
+		}
+		b = append(b, '"')
+	}
+	return append(b, '}')
+}
+
+// ParseArray parses a one-dimensional Postgres array literal into its elements.
+// It is the counterpart of AppendArray and rejects NULL elements.
+func ParseArray(s string) ([]string, error) {
+	if len(s) < 2 || s[0] != '{' || s[len(s)-1] != '}' {
+		return nil, fmt.Errorf("%q is not an array literal", s)
+	}
+	rest := strings.TrimLeft(s[1:len(s)-1], arraySpaces)
+	elems := []string{}
+	if len(rest) == 0 {
+		return elems, nil
+	}
+	for {
+		var elem string
+		if rest[0] == '"' {
+			var sb strings.Builder
+			i := 1
+			for ; i < len(rest) && rest[i] != '"'; i++ {
+				if rest[i] == '\\' && i+1 < len(rest) {
+					i++
+				}
+				sb.WriteByte(rest[i])
+			}
+			if i == len(rest) {
+				return nil, fmt.Errorf("%q contains an unterminated quoted element", s)
+			}
+			elem, rest = sb.String(), strings.TrimLeft(rest[i+1:], arraySpaces)
+		} else {
+			end := strings.IndexByte(rest, ',')
+			if end < 0 {
+				end = len(rest)
+			}
+			elem, rest = strings.TrimRight(rest[:end], arraySpaces), rest[end:]
+			switch {
+			case len(elem) == 0:
+				return nil, fmt.Errorf("%q contains an empty element", s)
+			case strings.EqualFold(elem, "NULL"):
+				return nil, fmt.Errorf("%q contains a NULL element", s)
+			case strings.ContainsAny(elem, `"\{}`):
+				return nil, fmt.Errorf("%q contains a malformed element %q", s, elem)
+			}
+		}
+		elems = append(elems, elem)
+		if len(rest) == 0 {
+			return elems, nil
+		}
+		if rest[0] != ',' {
+			return nil, fmt.Errorf("%q contains a malformed element", s)
+		}
+		rest = strings.TrimLeft(rest[1:], arraySpaces)
+		if len(rest) == 0 {
+			return nil, fmt.Errorf("%q contains an empty element", s)
+		}
+	}
+}
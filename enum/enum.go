@@ -0,0 +1,28 @@
+// Package enum is the runtime companion of the code generated by go-enumer.
+// It provides the types, which are shared by all generated enums,
+// and an optional registry for dynamic access to enums.
+package enum
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrNoValidEnum is the error wrapped by all validation errors of generated enums.
+	// It allows to detect enum errors across packages via `errors.Is(err, enum.ErrNoValidEnum)`.
+	ErrNoValidEnum = errors.New("not a valid enum")
+)
+
+// Enum is the interface implemented by all generated enum types.
+// Enum types with the `ent` feature do not implement it, as ent
+// occupies the `Values` method with a different signature.
+type Enum[T any] interface {
+	fmt.Stringer
+	// IsValid tests whether the value is a valid enum value.
+	IsValid() bool
+	// Validate whether the value is within the range of enum values.
+	Validate() error
+	// Values returns all values of the enum.
+	Values() []T
+}
@@ -0,0 +1,219 @@
+package enum
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type color uint
+
+const (
+	colorRed color = iota + 1
+	colorGreen
+)
+
+var colorStrings = map[color]string{colorRed: "Red", colorGreen: "Green"}
+
+func (c color) String() string {
+	if s, ok := colorStrings[c]; ok {
+		return s
+	}
+	return fmt.Sprintf("color(%d)", c)
+}
+
+func (c color) IsValid() bool { return c >= colorRed && c <= colorGreen }
+
+func (c color) Validate() error {
+	if !c.IsValid() {
+		return fmt.Errorf("color(%d) is %w", c, ErrNoValidEnum)
+	}
+	return nil
+}
+
+func (color) Values() []color { return []color{colorRed, colorGreen} }
+
+func colorFromString(raw string) (color, bool) {
+	for c, s := range colorStrings {
+		if s == raw {
+			return c, true
+		}
+	}
+	return 0, false
+}
+
+var _ Enum[color] = color(0)
+
+func TestRegistry(t *testing.T) {
+	const name = "example.com/colors.color"
+	Register(name, color(0).Values, colorFromString)
+	t.Cleanup(func() { delete(registry, name) })
+
+	t.Run("Lookup", func(t *testing.T) {
+		typ, ok := Lookup(name)
+		require.True(t, ok)
+		require.Equal(t, name, typ.Name())
+		require.Equal(t, []fmt.Stringer{colorRed, colorGreen}, typ.Values())
+		require.Equal(t, []string{"Red", "Green"}, typ.Strings())
+
+		_, ok = Lookup("example.com/colors.unknown")
+		require.False(t, ok)
+	})
+	t.Run("Types", func(t *testing.T) {
+		var names []string
+		for _, typ := range Types() {
+			names = append(names, typ.Name())
+		}
+		require.Contains(t, names, name)
+	})
+	t.Run("Parse", func(t *testing.T) {
+		v, err := Parse(name, "Green")
+		require.NoError(t, err)
+		require.Equal(t, colorGreen, v)
+
+		_, err = Parse(name, "Gren")
+		require.ErrorIs(t, err, ErrNoValidEnum)
+		require.EqualError(t, err, "Value \"Gren\" does not represent a example.com/colors.color (did you mean \"Green\"?)")
+
+		_, err = Parse("example.com/colors.unknown", "Green")
+		require.EqualError(t, err, "enum type \"example.com/colors.unknown\" is not registered")
+	})
+	t.Run("Register twice", func(t *testing.T) {
+		require.PanicsWithValue(t, "enum: Register called twice for \"example.com/colors.color\"", func() {
+			Register(name, color(0).Values, colorFromString)
+		})
+	})
+}
+
+func TestParseError(t *testing.T) {
+	valid := []string{"Red", "Green", "Blue"}
+	t.Run("with suggestions", func(t *testing.T) {
+		var err error = NewParseError("Color", "gren", valid)
+		require.EqualError(t, err, "Value \"gren\" does not represent a Color (did you mean \"Green\"?)")
+		require.ErrorIs(t, err, ErrNoValidEnum)
+
+		var perr *ParseError
+		require.ErrorAs(t, fmt.Errorf("wrapped. err: %w", err), &perr)
+		require.Equal(t, &ParseError{Enum: "Color", Input: "gren", Valid: valid, Suggestions: []string{"Green"}}, perr)
+	})
+	t.Run("without suggestions", func(t *testing.T) {
+		err := NewParseError("Color", "Purple", valid)
+		require.EqualError(t, err, "Value \"Purple\" does not represent a Color")
+		require.Empty(t, err.Suggestions)
+	})
+	t.Run("with a large input", func(t *testing.T) {
+		input := strings.Repeat("Green", 40_000)
+		values := make([]string, 50)
+		for idx := range values {
+			values[idx] = fmt.Sprintf("Color%d", idx)
+		}
+		var err *ParseError
+		allocs := testing.AllocsPerRun(10, func() { err = NewParseError("Color", input, values) })
+		require.Empty(t, err.Suggestions)
+		require.LessOrEqual(t, allocs, 2.0)
+	})
+}
+
+func TestHash(t *testing.T) {
+	t.Run("Hash differs by length and by the leading and trailing bytes", func(t *testing.T) {
+		seen := map[uint32]string{}
+		for _, s := range []string{
+			"", "a", "b", "ab", "ba", "abc", "acb", "abcd", "abce", "bbcd",
+			"abcdefgh", "abcdefgi", "bbcdefgh", "abcdefghijklmnop", "abcdefghijklmnoq", "bbcdefghijklmnop",
+			"Europe/Berlin", "Europe/Busingen", "America/Argentina/Buenos_Aires", "America/Argentina/Catamarca",
+		} {
+			h := HashV1(s)
+			require.Equal(t, h, HashV1(string([]byte(s))), s)
+			require.NotContains(t, seen, h, "%q collides with %q", s, seen[h])
+			seen[h] = s
+		}
+	})
+	t.Run("Hash ignores the inner bytes of long strings", func(t *testing.T) {
+		require.Equal(t, HashV1("abcdefgh_x_abcdefgh"), HashV1("abcdefgh_y_abcdefgh"))
+	})
+	t.Run("Hash and Displace are frozen", func(t *testing.T) {
+		// hint: generated perfect hash tables depend on these results, see HashV1
+		for _, tC := range []struct {
+			s string
+			h uint32
+		}{
+			{"", 0x00000000},
+			{"a", 0x1a99618c},
+			{"GET", 0x952db7c2},
+			{"Mars", 0xf337b9b2},
+			{"Europe/Berlin", 0xa4317973},
+			{"America/Argentina/Buenos_Aires", 0x52873600},
+		} {
+			require.Equal(t, tC.h, HashV1(tC.s), tC.s)
+		}
+		require.Equal(t, uint32(0x4cf338b0), DisplaceV1(HashV1("Mars"), 0))
+		require.Equal(t, uint32(0xd72d0a86), DisplaceV1(HashV1("Mars"), 42))
+	})
+	t.Run("Displace by seed", func(t *testing.T) {
+		h := HashV1("foobar")
+		require.Equal(t, DisplaceV1(h, 1), DisplaceV1(h, 1))
+		require.NotEqual(t, DisplaceV1(h, 1), DisplaceV1(h, 2))
+	})
+}
+
+func TestCompareFoldASCII(t *testing.T) {
+	for _, tC := range []struct {
+		a, b     string
+		expected int
+	}{
+		{"", "", 0},
+		{"abc", "abc", 0},
+		{"abc", "ABC", 0},
+		{"aBc", "AbC", 0},
+		{"a-1_Z", "A-1_z", 0},
+		{"abc", "abd", -1},
+		{"ABC", "abd", -1},
+		{"abd", "ABC", +1},
+		{"ab", "abc", -1},
+		{"ABC", "ab", +1},
+		{"[", "A", -1},           // hint: compared in lower case, although "A" < "[" in upper case
+		{"Россия", "россия", -1}, // hint: non-ASCII letters are compared by their bytes
+		{"ſ", "s", +1},
+	} {
+		require.Equal(t, tC.expected, CompareFoldASCII(tC.a, tC.b), "%q vs %q", tC.a, tC.b)
+	}
+}
+
+func TestArray(t *testing.T) {
+	t.Run("round-trip", func(t *testing.T) {
+		for _, tC := range []struct {
+			elems    []string
+			expected string
+		}{
+			{[]string{}, `{}`},
+			{[]string{""}, `{""}`},
+			{[]string{"", "Mars"}, `{"",Mars}`},
+			{[]string{"Red", "Blue"}, `{Red,Blue}`},
+			{[]string{"1", "3"}, `{1,3}`},
+			{[]string{"null", "dark blue"}, `{"null","dark blue"}`},
+			{[]string{`a,b`, `{c}`, `"d"`, `e\f`}, `{"a,b","{c}","\"d\"","e\\f"}`},
+		} {
+			actual := AppendArray(nil, tC.elems)
+			require.Equal(t, tC.expected, string(actual))
+			elems, err := ParseArray(string(actual))
+			require.NoError(t, err)
+			require.Equal(t, tC.elems, elems)
+		}
+	})
+	t.Run("appends to the buffer", func(t *testing.T) {
+		require.Equal(t, "set={Red}", string(AppendArray([]byte("set="), []string{"Red"})))
+	})
+	t.Run("parses whitespace", func(t *testing.T) {
+		elems, err := ParseArray(`{ Red , "Blue" ,Green }`)
+		require.NoError(t, err)
+		require.Equal(t, []string{"Red", "Blue", "Green"}, elems)
+	})
+	t.Run("rejects malformed literals", func(t *testing.T) {
+		for _, s := range []string{``, `Red,Blue`, `{Red`, `{Red,}`, `{,Red}`, `{NULL}`, `{"Red}`, `{"Red"Blue}`, `{{Red}}`} {
+			_, err := ParseArray(s)
+			require.Error(t, err, s)
+		}
+	})
+}
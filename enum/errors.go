@@ -0,0 +1,46 @@
+package enum
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/mvrahden/go-enumer/internal/suggest"
+)
+
+// ParseError is returned upon deserialization of an input,
+// which does not represent any value of an enum.
+// It wraps ErrNoValidEnum and can be detected via `errors.As`.
+type ParseError struct {
+	Enum        string   // hint: the name of the enum type
+	Input       string   // hint: the rejected input
+	Valid       []string // hint: all valid String values of the enum
+	Suggestions []string // hint: the valid values closest to the input
+}
+
+// NewParseError creates a ParseError for the rejected input
+// and determines the closest matches among the valid values.
+func NewParseError(name, input string, valid []string) *ParseError {
+	return &ParseError{
+		Enum:        name,
+		Input:       input,
+		Valid:       valid,
+		Suggestions: suggest.ClosestMatches(input, valid),
+	}
+}
+
+func (e *ParseError) Error() string {
+	msg := fmt.Sprintf("Value %q does not represent a %s", e.Input, e.Enum)
+	if len(e.Suggestions) == 0 {
+		return msg
+	}
+	suggestions := make([]string, len(e.Suggestions))
+	for idx, s := range e.Suggestions {
+		suggestions[idx] = fmt.Sprintf("%q", s)
+	}
+	return fmt.Sprintf("%s (did you mean %s?)", msg, strings.Join(suggestions, " or "))
+}
+
+// Unwrap allows to detect a ParseError via `errors.Is(err, ErrNoValidEnum)`.
+func (e *ParseError) Unwrap() error {
+	return ErrNoValidEnum
+}
@@ -0,0 +1,35 @@
+package enum
+
+// CompareFoldASCII compares a and b lexicographically like strings.Compare,
+// but ignores the case of ASCII letters. It orders the fold tables, which
+// the FromStringIgnoreCase functions of generated enums with ASCII String values
+// search for the values.
+func CompareFoldASCII(a, b string) int {
+	n := len(a)
+	if len(b) < n {
+		n = len(b)
+	}
+	for i := 0; i < n; i++ {
+		ca, cb := lowerASCII(a[i]), lowerASCII(b[i])
+		if ca != cb {
+			if ca < cb {
+				return -1
+			}
+			return +1
+		}
+	}
+	switch {
+	case len(a) < len(b):
+		return -1
+	case len(a) > len(b):
+		return +1
+	}
+	return 0
+}
+
+func lowerASCII(c byte) byte {
+	if 'A' <= c && c <= 'Z' {
+		return c + 'a' - 'A'
+	}
+	return c
+}
@@ -0,0 +1,55 @@
+package enum
+
+// HashV1 returns a 32-bit hash of s, which is derived from its length and
+// up to its first and its last 8 bytes only. Along with DisplaceV1, it is the hash function
+// of the minimal perfect hash tables, which generated enums with the "perfect-hash"
+// lookup strategy determine their values by. As the tables compare the strings
+// in full, the hash trades collisions of similar strings for constant time.
+//
+// The hash is frozen: The tables are computed by the generator, but looked up with
+// the version of this package, which the generated code is compiled against.
+// Any change of its results therefore requires a new version (e.g. HashV2),
+// which lets code generated against a missing version fail to compile.
+func HashV1(s string) uint32 {
+	n := len(s)
+	h := uint64(n) * 0x9e3779b97f4a7c15
+	switch {
+	case n >= 8:
+		h = mix64(h ^ load64(s))
+		h ^= load64(s[n-8:])
+	case n >= 4:
+		h ^= uint64(load32(s))<<32 | uint64(load32(s[n-4:]))
+	case n > 0:
+		h ^= uint64(s[0])<<16 | uint64(s[n>>1])<<8 | uint64(s[n-1])
+	}
+	return uint32(mix64(h) >> 32)
+}
+
+// DisplaceV1 mixes the hash h of a string with the seed of its bucket,
+// which results in the slot of the string within a minimal perfect hash table.
+// Just like HashV1, it is frozen.
+func DisplaceV1(h, seed uint32) uint32 {
+	h ^= seed
+	h ^= h >> 16
+	h *= 0x85ebca6b
+	h ^= h >> 13
+	h *= 0xc2b2ae35
+	h ^= h >> 16
+	return h
+}
+
+func mix64(h uint64) uint64 {
+	h ^= h >> 33
+	h *= 0xff51afd7ed558ccd
+	h ^= h >> 33
+	return h
+}
+
+func load32(s string) uint32 {
+	return uint32(s[0]) | uint32(s[1])<<8 | uint32(s[2])<<16 | uint32(s[3])<<24
+}
+
+func load64(s string) uint64 {
+	return uint64(s[0]) | uint64(s[1])<<8 | uint64(s[2])<<16 | uint64(s[3])<<24 |
+		uint64(s[4])<<32 | uint64(s[5])<<40 | uint64(s[6])<<48 | uint64(s[7])<<56
+}
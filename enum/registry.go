@@ -0,0 +1,107 @@
+package enum
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+var (
+	registryMu sync.RWMutex
+	registry   = map[string]Type{}
+)
+
+// Type describes an enum type of the registry.
+type Type struct {
+	name       string
+	values     func() []fmt.Stringer
+	fromString func(raw string) (fmt.Stringer, bool)
+}
+
+// Name returns the qualified name of the enum type,
+// i.e. the package path and the type name, e.g. "example.com/pkg.Color".
+func (t Type) Name() string {
+	return t.name
+}
+
+// Values returns all values of the enum type.
+func (t Type) Values() []fmt.Stringer {
+	return t.values()
+}
+
+// Strings returns all String values of the enum type.
+func (t Type) Strings() []string {
+	values := t.values()
+	out := make([]string, 0, len(values))
+	for _, v := range values {
+		out = append(out, v.String())
+	}
+	return out
+}
+
+// Parse determines the enum value from its string representation.
+// It returns a *ParseError if the value is unknown.
+func (t Type) Parse(raw string) (fmt.Stringer, error) {
+	v, ok := t.fromString(raw)
+	if !ok {
+		return nil, NewParseError(t.name, raw, t.Strings())
+	}
+	return v, nil
+}
+
+// Register adds an enum type under the given name to the registry.
+// It is called by generated code and panics if the name is registered twice.
+func Register[T fmt.Stringer](name string, values func() []T, fromString func(raw string) (T, bool)) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	if _, ok := registry[name]; ok {
+		panic(fmt.Sprintf("enum: Register called twice for %q", name))
+	}
+	registry[name] = Type{
+		name: name,
+		values: func() []fmt.Stringer {
+			values := values()
+			out := make([]fmt.Stringer, 0, len(values))
+			for _, v := range values {
+				out = append(out, v)
+			}
+			return out
+		},
+		fromString: func(raw string) (fmt.Stringer, bool) {
+			return fromString(raw)
+		},
+	}
+}
+
+// Lookup returns the enum type registered under the given name.
+func Lookup(name string) (Type, bool) {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	t, ok := registry[name]
+	return t, ok
+}
+
+// Types returns all registered enum types sorted by their names.
+func Types() []Type {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	out := make([]Type, 0, len(registry))
+	for _, t := range registry {
+		out = append(out, t)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].name < out[j].name })
+	return out
+}
+
+// Parse determines the value of the enum type registered under the given name
+// from its string representation.
+func Parse(name, raw string) (fmt.Stringer, error) {
+	t, ok := Lookup(name)
+	if !ok {
+		return nil, fmt.Errorf("enum type %q is not registered", name)
+	}
+	return t.Parse(raw)
+}
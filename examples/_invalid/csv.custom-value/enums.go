@@ -0,0 +1,8 @@
+package invalid
+
+//go:enum -from=source.csv
+type CustomValueCSV uint
+
+const (
+	CustomApple CustomValueCSV = 1 // enum:"apple"
+)
@@ -0,0 +1,4 @@
+package invalid
+
+//go:enum -from=source.csv -support=ignore-case
+type IgnoreCaseCollisionCSV uint
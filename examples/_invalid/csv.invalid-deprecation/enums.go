@@ -0,0 +1,4 @@
+package invalid
+
+//go:enum -from=source.csv
+type InvalidDeprecationCSV uint
@@ -0,0 +1,4 @@
+package invalid
+
+//go:enum -from=source.csv -serializers=sql,xml -support=set
+type JoinedSeparatorCSV uint
@@ -0,0 +1,9 @@
+package invalid
+
+//go:enum -transitions=transitions.csv
+type MissingTransitionsCSV uint
+
+const (
+	MissingTransitionsCSVOpen MissingTransitionsCSV = iota
+	MissingTransitionsCSVClosed
+)
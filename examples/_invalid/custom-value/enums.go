@@ -0,0 +1,9 @@
+package invalid
+
+//go:enum
+type InvalidCustomValue uint
+
+const (
+	InvalidCustomValueA InvalidCustomValue = iota // enum:"a"
+	InvalidCustomValueB                           // enum:"b
+)
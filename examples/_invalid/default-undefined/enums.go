@@ -0,0 +1,10 @@
+package invalid
+
+//go:enum -default=Green -support=undefined
+type DefaultUndefined uint
+
+const (
+	DefaultUndefinedRed DefaultUndefined = iota + 1
+	DefaultUndefinedGreen
+	DefaultUndefinedBlue
+)
@@ -0,0 +1,9 @@
+package invalid
+
+//go:enum
+type DeprecatedAlternative uint
+
+const (
+	DeprecatedAlternativeGray DeprecatedAlternative = iota
+	DeprecatedAlternativeGrey                       = DeprecatedAlternativeGray // Deprecated: use Gray.
+)
@@ -0,0 +1,9 @@
+package invalid
+
+//go:enum -serializers=json -support=ent
+type EntWithoutSQL uint
+
+const (
+	EntWithoutSQLA EntWithoutSQL = iota
+	EntWithoutSQLB
+)
@@ -0,0 +1,9 @@
+package invalid
+
+//go:enum -transform=kebab -serializers=graphql
+type InvalidGraphQLName uint
+
+const (
+	InvalidGraphQLNameDarkRed InvalidGraphQLName = iota
+	InvalidGraphQLNameLightBlue
+)
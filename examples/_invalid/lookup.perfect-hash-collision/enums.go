@@ -0,0 +1,9 @@
+package invalid
+
+//go:enum -lookup=perfect-hash
+type PerfectHashCollision uint
+
+const (
+	PerfectHashCollisionX PerfectHashCollision = iota + 1 // enum:"abcdefgh_x_abcdefgh"
+	PerfectHashCollisionY                                 // enum:"abcdefgh_y_abcdefgh"
+)
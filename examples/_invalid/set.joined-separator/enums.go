@@ -0,0 +1,9 @@
+package invalid
+
+//go:enum -serializers=json,text -support=set
+type JoinedSeparator uint
+
+const (
+	JoinedSeparatorSalt   JoinedSeparator = iota + 1
+	JoinedSeparatorPepper                 // enum:"salt,pepper"
+)
@@ -0,0 +1,9 @@
+package invalid
+
+//go:enum -transform=snake
+type TransformCollision uint
+
+const (
+	TransformCollisionDarkRed TransformCollision = iota
+	TransformCollisionDark_Red
+)
@@ -0,0 +1,15 @@
+package invalid
+
+// UnknownTransitionState refers to an unknown state in its transitions.
+//
+// Transitions:
+//
+//	Open -> Closd
+//
+//go:enum
+type UnknownTransitionState uint
+
+const (
+	UnknownTransitionStateOpen UnknownTransitionState = iota
+	UnknownTransitionStateClosed
+)
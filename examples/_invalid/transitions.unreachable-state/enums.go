@@ -0,0 +1,16 @@
+package invalid
+
+// UnreachableTransitionState has a state which cannot be reached.
+//
+// Transitions:
+//
+//	Open -> Closed
+//
+//go:enum
+type UnreachableTransitionState uint
+
+const (
+	UnreachableTransitionStateOpen UnreachableTransitionState = iota
+	UnreachableTransitionStateClosed
+	UnreachableTransitionStateArchived
+)
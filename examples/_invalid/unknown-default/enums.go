@@ -0,0 +1,10 @@
+package invalid
+
+//go:enum -default=Gren
+type UnknownDefault uint
+
+const (
+	UnknownDefaultRed UnknownDefault = iota + 1
+	UnknownDefaultGreen
+	UnknownDefaultBlue
+)
@@ -0,0 +1,9 @@
+package invalid
+
+//go:enum -serializers=json,jsno -support=ignore-case
+type UnknownSerializer uint
+
+const (
+	UnknownSerializerA UnknownSerializer = iota
+	UnknownSerializerB
+)
@@ -0,0 +1,9 @@
+package invalid
+
+//go:enum -transform=snkae
+type UnknownTransform uint
+
+const (
+	UnknownTransformA UnknownTransform = iota
+	UnknownTransformB
+)
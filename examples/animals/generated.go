@@ -5,20 +5,18 @@ package animals
 import (
 	"database/sql/driver"
 	"encoding/json"
-	"errors"
 	"fmt"
+	_enumer "github.com/mvrahden/go-enumer/enum"
 	"gopkg.in/yaml.v3"
-	"io"
-	"strconv"
+	"strings"
 )
 
 var (
-	ErrNoValidEnum = errors.New("not a valid enum")
+	ErrNoValidEnum = _enumer.ErrNoValidEnum
 )
 
 const (
-	_AnimalString      = "DogCatSealSeaLionIceBear"
-	_AnimalLowerString = "dogcatsealsealionicebear"
+	_AnimalString = "DogCatSealSeaLionIceBear"
 )
 
 var (
@@ -50,6 +48,11 @@ func AnimalStrings() []string {
 	return cp[:]
 }
 
+// Values returns all values of the enum.
+func (Animal) Values() []Animal {
+	return AnimalValues()
+}
+
 // IsValid tests whether the value is a valid enum value.
 func (_a Animal) IsValid() bool {
 	return _a >= 0 && _a <= 4
@@ -74,6 +77,54 @@ func (_a Animal) String() string {
 	return _AnimalStrings[idx]
 }
 
+// Index returns the position of the value within the ordered values of the enum
+// (ignoring alternative values) or -1 if the value is invalid.
+func (_a Animal) Index() int {
+	if !_a.IsValid() {
+		return -1
+	}
+	idx := int(_a)
+	return idx
+}
+
+// Compare returns -1, 0 or +1 depending on whether the value orders before,
+// equal to or after the other value. Invalid values order before all valid values.
+func (_a Animal) Compare(other Animal) int {
+	a, b := _a.Index(), other.Index()
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return +1
+	}
+	return 0
+}
+
+// Less tests whether the value orders before the other value.
+func (_a Animal) Less(other Animal) bool {
+	return _a.Compare(other) < 0
+}
+
+// Next returns the succeeding value in order of the enum.
+// It returns false if the value is invalid or the last value.
+func (_a Animal) Next() (Animal, bool) {
+	idx := _a.Index()
+	if idx == -1 || idx+1 == len(_AnimalValues) {
+		return Animal(0), false
+	}
+	return _AnimalValues[idx+1], true
+}
+
+// Prev returns the preceding value in order of the enum.
+// It returns false if the value is invalid or the first value.
+func (_a Animal) Prev() (Animal, bool) {
+	idx := _a.Index()
+	if idx < 1 {
+		return Animal(0), false
+	}
+	return _AnimalValues[idx-1], true
+}
+
 var (
 	_AnimalStringToValueMap = map[string]Animal{
 		_AnimalString[0:3]:   AnimalDog,
@@ -82,15 +133,20 @@ var (
 		_AnimalString[10:17]: AnimalSeaLion,
 		_AnimalString[17:24]: AnimalIceBear,
 	}
-	_AnimalLowerStringToValueMap = map[string]Animal{
-		_AnimalLowerString[0:3]:   AnimalDog,
-		_AnimalLowerString[3:6]:   AnimalCat,
-		_AnimalLowerString[6:10]:  AnimalSeal,
-		_AnimalLowerString[10:17]: AnimalSeaLion,
-		_AnimalLowerString[17:24]: AnimalIceBear,
-	}
 )
 
+// _AnimalLookupFold determines the enum value of the string regardless of its case
+// by Unicode simple folding. It scans the String values, as a fold table is only generated
+// for enums with the "ignore-case" feature.
+func _AnimalLookupFold(raw string) (Animal, bool) {
+	for idx := range _AnimalStrings {
+		if strings.EqualFold(_AnimalStrings[idx], raw) {
+			return _AnimalValues[idx], true
+		}
+	}
+	return Animal(0), false
+}
+
 // AnimalFromString determines the enum value with an exact case match.
 func AnimalFromString(raw string) (Animal, bool) {
 	v, ok := _AnimalStringToValueMap[raw]
@@ -100,13 +156,14 @@ func AnimalFromString(raw string) (Animal, bool) {
 	return v, true
 }
 
-// AnimalFromStringIgnoreCase determines the enum value with a case-insensitive match.
+// AnimalFromStringIgnoreCase determines the enum value with a case-insensitive match
+// by Unicode simple folding, regardless of the casing of raw.
 func AnimalFromStringIgnoreCase(raw string) (Animal, bool) {
 	v, ok := AnimalFromString(raw)
 	if ok {
 		return v, ok
 	}
-	v, ok = _AnimalLowerStringToValueMap[raw]
+	v, ok = _AnimalLookupFold(raw)
 	if !ok {
 		return Animal(0), false
 	}
@@ -115,10 +172,15 @@ func AnimalFromStringIgnoreCase(raw string) (Animal, bool) {
 
 // MarshalBinary implements the encoding.BinaryMarshaler interface for Animal.
 func (_a Animal) MarshalBinary() ([]byte, error) {
+	return _a.AppendBinary(nil)
+}
+
+// AppendBinary implements the encoding.BinaryAppender interface for Animal.
+func (_a Animal) AppendBinary(b []byte) ([]byte, error) {
 	if err := _a.Validate(); err != nil {
-		return nil, fmt.Errorf("Cannot marshal value %q as Animal. %w", _a, err)
+		return b, fmt.Errorf("Cannot marshal value %q as Animal. %w", _a, err)
 	}
-	return []byte(_a.String()), nil
+	return append(b, _a.String()...), nil
 }
 
 // UnmarshalBinary implements the encoding.BinaryUnmarshaler interface for Animal.
@@ -131,37 +193,7 @@ func (_a *Animal) UnmarshalBinary(text []byte) error {
 	var ok bool
 	*_a, ok = AnimalFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a Animal", str)
-	}
-	return nil
-}
-
-// MarshalGQL implements the graphql.Marshaler interface for Animal.
-func (_a Animal) MarshalGQL(w io.Writer) {
-	fmt.Fprint(w, strconv.Quote(_a.String()))
-}
-
-// UnmarshalGQL implements the graphql.Unmarshaler interface for Animal.
-func (_a *Animal) UnmarshalGQL(value interface{}) error {
-	var str string
-	switch v := value.(type) {
-	case []byte:
-		str = string(v)
-	case string:
-		str = v
-	case fmt.Stringer:
-		str = v.String()
-	default:
-		return fmt.Errorf("invalid value of Animal: %[1]T(%[1]v)", value)
-	}
-	if len(str) == 0 {
-		return fmt.Errorf("Animal cannot be derived from empty string")
-	}
-
-	var ok bool
-	*_a, ok = AnimalFromString(str)
-	if !ok {
-		return fmt.Errorf("Value %q does not represent a Animal", str)
+		return _enumer.NewParseError("Animal", str, AnimalStrings())
 	}
 	return nil
 }
@@ -187,7 +219,7 @@ func (_a *Animal) UnmarshalJSON(data []byte) error {
 	var ok bool
 	*_a, ok = AnimalFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a Animal", str)
+		return _enumer.NewParseError("Animal", str, AnimalStrings())
 	}
 	return nil
 }
@@ -220,17 +252,22 @@ func (_a *Animal) Scan(value interface{}) error {
 	var ok bool
 	*_a, ok = AnimalFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a Animal", str)
+		return _enumer.NewParseError("Animal", str, AnimalStrings())
 	}
 	return nil
 }
 
 // MarshalText implements the encoding.TextMarshaler interface for Animal.
 func (_a Animal) MarshalText() ([]byte, error) {
+	return _a.AppendText(nil)
+}
+
+// AppendText implements the encoding.TextAppender interface for Animal.
+func (_a Animal) AppendText(b []byte) ([]byte, error) {
 	if err := _a.Validate(); err != nil {
-		return nil, fmt.Errorf("Cannot marshal value %q as Animal. %w", _a, err)
+		return b, fmt.Errorf("Cannot marshal value %q as Animal. %w", _a, err)
 	}
-	return []byte(_a.String()), nil
+	return append(b, _a.String()...), nil
 }
 
 // UnmarshalText implements the encoding.TextUnmarshaler interface for Animal.
@@ -243,7 +280,7 @@ func (_a *Animal) UnmarshalText(text []byte) error {
 	var ok bool
 	*_a, ok = AnimalFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a Animal", str)
+		return _enumer.NewParseError("Animal", str, AnimalStrings())
 	}
 	return nil
 }
@@ -270,14 +307,40 @@ func (_a *Animal) UnmarshalYAML(n *yaml.Node) error {
 	var ok bool
 	*_a, ok = AnimalFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a Animal", str)
+		return _enumer.NewParseError("Animal", str, AnimalStrings())
 	}
 	return nil
 }
 
+var _AnimalCompletions = [5]string{
+	"Dog",
+	"Cat",
+	"Seal",
+	"SeaLion",
+	"IceBear",
+}
+
+// AnimalUsage returns a usage hint of all String values of the enum, less the deprecated values,
+// e.g. for flag descriptions.
+func AnimalUsage() string {
+	return "one of: " + strings.Join(_AnimalStrings[:], "|")
+}
+
+// AnimalCompletions returns the completion candidates of the enum matching the given prefix,
+// less the deprecated values. Each candidate is formatted as "<value>" or "<value>\t<description>",
+// as expected by shell completions, e.g. of cobra's RegisterFlagCompletionFunc.
+func AnimalCompletions(toComplete string) []string {
+	out := make([]string, 0, len(_AnimalCompletions))
+	for _, c := range _AnimalCompletions {
+		if strings.HasPrefix(c, toComplete) {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
 const (
-	_BirdString      = "ALBATROSSHUMMING_BIRDDARWINS_FINCHOSTRICHKING_FISHER"
-	_BirdLowerString = "albatrosshumming_birddarwins_finchostrichking_fisher"
+	_BirdString = "ALBATROSSHUMMING_BIRDDARWINS_FINCHOSTRICHKING_FISHER"
 )
 
 var (
@@ -309,6 +372,11 @@ func BirdStrings() []string {
 	return cp[:]
 }
 
+// Values returns all values of the enum.
+func (Bird) Values() []Bird {
+	return BirdValues()
+}
+
 // IsValid tests whether the value is a valid enum value.
 func (_b Bird) IsValid() bool {
 	return _b >= 0 && _b <= 4
@@ -333,6 +401,54 @@ func (_b Bird) String() string {
 	return _BirdStrings[idx]
 }
 
+// Index returns the position of the value within the ordered values of the enum
+// (ignoring alternative values) or -1 if the value is invalid.
+func (_b Bird) Index() int {
+	if !_b.IsValid() {
+		return -1
+	}
+	idx := int(_b)
+	return idx
+}
+
+// Compare returns -1, 0 or +1 depending on whether the value orders before,
+// equal to or after the other value. Invalid values order before all valid values.
+func (_b Bird) Compare(other Bird) int {
+	a, b := _b.Index(), other.Index()
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return +1
+	}
+	return 0
+}
+
+// Less tests whether the value orders before the other value.
+func (_b Bird) Less(other Bird) bool {
+	return _b.Compare(other) < 0
+}
+
+// Next returns the succeeding value in order of the enum.
+// It returns false if the value is invalid or the last value.
+func (_b Bird) Next() (Bird, bool) {
+	idx := _b.Index()
+	if idx == -1 || idx+1 == len(_BirdValues) {
+		return Bird(0), false
+	}
+	return _BirdValues[idx+1], true
+}
+
+// Prev returns the preceding value in order of the enum.
+// It returns false if the value is invalid or the first value.
+func (_b Bird) Prev() (Bird, bool) {
+	idx := _b.Index()
+	if idx < 1 {
+		return Bird(0), false
+	}
+	return _BirdValues[idx-1], true
+}
+
 var (
 	_BirdStringToValueMap = map[string]Bird{
 		_BirdString[0:9]:   BirdAlbatross,
@@ -341,15 +457,20 @@ var (
 		_BirdString[34:41]: BirdOstrich,
 		_BirdString[41:52]: BirdKingFisher,
 	}
-	_BirdLowerStringToValueMap = map[string]Bird{
-		_BirdLowerString[0:9]:   BirdAlbatross,
-		_BirdLowerString[9:21]:  BirdHummingBird,
-		_BirdLowerString[21:34]: BirdDarwinsFinch,
-		_BirdLowerString[34:41]: BirdOstrich,
-		_BirdLowerString[41:52]: BirdKingFisher,
-	}
 )
 
+// _BirdLookupFold determines the enum value of the string regardless of its case
+// by Unicode simple folding. It scans the String values, as a fold table is only generated
+// for enums with the "ignore-case" feature.
+func _BirdLookupFold(raw string) (Bird, bool) {
+	for idx := range _BirdStrings {
+		if strings.EqualFold(_BirdStrings[idx], raw) {
+			return _BirdValues[idx], true
+		}
+	}
+	return Bird(0), false
+}
+
 // BirdFromString determines the enum value with an exact case match.
 func BirdFromString(raw string) (Bird, bool) {
 	v, ok := _BirdStringToValueMap[raw]
@@ -359,13 +480,14 @@ func BirdFromString(raw string) (Bird, bool) {
 	return v, true
 }
 
-// BirdFromStringIgnoreCase determines the enum value with a case-insensitive match.
+// BirdFromStringIgnoreCase determines the enum value with a case-insensitive match
+// by Unicode simple folding, regardless of the casing of raw.
 func BirdFromStringIgnoreCase(raw string) (Bird, bool) {
 	v, ok := BirdFromString(raw)
 	if ok {
 		return v, ok
 	}
-	v, ok = _BirdLowerStringToValueMap[raw]
+	v, ok = _BirdLookupFold(raw)
 	if !ok {
 		return Bird(0), false
 	}
@@ -374,10 +496,15 @@ func BirdFromStringIgnoreCase(raw string) (Bird, bool) {
 
 // MarshalBinary implements the encoding.BinaryMarshaler interface for Bird.
 func (_b Bird) MarshalBinary() ([]byte, error) {
+	return _b.AppendBinary(nil)
+}
+
+// AppendBinary implements the encoding.BinaryAppender interface for Bird.
+func (_b Bird) AppendBinary(b []byte) ([]byte, error) {
 	if err := _b.Validate(); err != nil {
-		return nil, fmt.Errorf("Cannot marshal value %q as Bird. %w", _b, err)
+		return b, fmt.Errorf("Cannot marshal value %q as Bird. %w", _b, err)
 	}
-	return []byte(_b.String()), nil
+	return append(b, _b.String()...), nil
 }
 
 // UnmarshalBinary implements the encoding.BinaryUnmarshaler interface for Bird.
@@ -390,37 +517,7 @@ func (_b *Bird) UnmarshalBinary(text []byte) error {
 	var ok bool
 	*_b, ok = BirdFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a Bird", str)
-	}
-	return nil
-}
-
-// MarshalGQL implements the graphql.Marshaler interface for Bird.
-func (_b Bird) MarshalGQL(w io.Writer) {
-	fmt.Fprint(w, strconv.Quote(_b.String()))
-}
-
-// UnmarshalGQL implements the graphql.Unmarshaler interface for Bird.
-func (_b *Bird) UnmarshalGQL(value interface{}) error {
-	var str string
-	switch v := value.(type) {
-	case []byte:
-		str = string(v)
-	case string:
-		str = v
-	case fmt.Stringer:
-		str = v.String()
-	default:
-		return fmt.Errorf("invalid value of Bird: %[1]T(%[1]v)", value)
-	}
-	if len(str) == 0 {
-		return fmt.Errorf("Bird cannot be derived from empty string")
-	}
-
-	var ok bool
-	*_b, ok = BirdFromString(str)
-	if !ok {
-		return fmt.Errorf("Value %q does not represent a Bird", str)
+		return _enumer.NewParseError("Bird", str, BirdStrings())
 	}
 	return nil
 }
@@ -446,7 +543,7 @@ func (_b *Bird) UnmarshalJSON(data []byte) error {
 	var ok bool
 	*_b, ok = BirdFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a Bird", str)
+		return _enumer.NewParseError("Bird", str, BirdStrings())
 	}
 	return nil
 }
@@ -479,17 +576,22 @@ func (_b *Bird) Scan(value interface{}) error {
 	var ok bool
 	*_b, ok = BirdFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a Bird", str)
+		return _enumer.NewParseError("Bird", str, BirdStrings())
 	}
 	return nil
 }
 
 // MarshalText implements the encoding.TextMarshaler interface for Bird.
 func (_b Bird) MarshalText() ([]byte, error) {
+	return _b.AppendText(nil)
+}
+
+// AppendText implements the encoding.TextAppender interface for Bird.
+func (_b Bird) AppendText(b []byte) ([]byte, error) {
 	if err := _b.Validate(); err != nil {
-		return nil, fmt.Errorf("Cannot marshal value %q as Bird. %w", _b, err)
+		return b, fmt.Errorf("Cannot marshal value %q as Bird. %w", _b, err)
 	}
-	return []byte(_b.String()), nil
+	return append(b, _b.String()...), nil
 }
 
 // UnmarshalText implements the encoding.TextUnmarshaler interface for Bird.
@@ -502,7 +604,7 @@ func (_b *Bird) UnmarshalText(text []byte) error {
 	var ok bool
 	*_b, ok = BirdFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a Bird", str)
+		return _enumer.NewParseError("Bird", str, BirdStrings())
 	}
 	return nil
 }
@@ -529,14 +631,40 @@ func (_b *Bird) UnmarshalYAML(n *yaml.Node) error {
 	var ok bool
 	*_b, ok = BirdFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a Bird", str)
+		return _enumer.NewParseError("Bird", str, BirdStrings())
 	}
 	return nil
 }
 
+var _BirdCompletions = [5]string{
+	"ALBATROSS",
+	"HUMMING_BIRD",
+	"DARWINS_FINCH",
+	"OSTRICH",
+	"KING_FISHER",
+}
+
+// BirdUsage returns a usage hint of all String values of the enum, less the deprecated values,
+// e.g. for flag descriptions.
+func BirdUsage() string {
+	return "one of: " + strings.Join(_BirdStrings[:], "|")
+}
+
+// BirdCompletions returns the completion candidates of the enum matching the given prefix,
+// less the deprecated values. Each candidate is formatted as "<value>" or "<value>\t<description>",
+// as expected by shell completions, e.g. of cobra's RegisterFlagCompletionFunc.
+func BirdCompletions(toComplete string) []string {
+	out := make([]string, 0, len(_BirdCompletions))
+	for _, c := range _BirdCompletions {
+		if strings.HasPrefix(c, toComplete) {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
 const (
-	_FishString      = "giant_grouperhagfishreedfishbowfincatfishhorn_shark"
-	_FishLowerString = "giant_grouperhagfishreedfishbowfincatfishhorn_shark"
+	_FishString = "giant_grouperhagfishreedfishbowfincatfishhorn_shark"
 )
 
 var (
@@ -569,6 +697,11 @@ func FishStrings() []string {
 	return cp[:]
 }
 
+// Values returns all values of the enum.
+func (Fish) Values() []Fish {
+	return FishValues()
+}
+
 // IsValid tests whether the value is a valid enum value.
 func (_f Fish) IsValid() bool {
 	return _f >= 0 && _f <= 5
@@ -593,6 +726,54 @@ func (_f Fish) String() string {
 	return _FishStrings[idx]
 }
 
+// Index returns the position of the value within the ordered values of the enum
+// (ignoring alternative values) or -1 if the value is invalid.
+func (_f Fish) Index() int {
+	if !_f.IsValid() {
+		return -1
+	}
+	idx := int(_f)
+	return idx
+}
+
+// Compare returns -1, 0 or +1 depending on whether the value orders before,
+// equal to or after the other value. Invalid values order before all valid values.
+func (_f Fish) Compare(other Fish) int {
+	a, b := _f.Index(), other.Index()
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return +1
+	}
+	return 0
+}
+
+// Less tests whether the value orders before the other value.
+func (_f Fish) Less(other Fish) bool {
+	return _f.Compare(other) < 0
+}
+
+// Next returns the succeeding value in order of the enum.
+// It returns false if the value is invalid or the last value.
+func (_f Fish) Next() (Fish, bool) {
+	idx := _f.Index()
+	if idx == -1 || idx+1 == len(_FishValues) {
+		return Fish(0), false
+	}
+	return _FishValues[idx+1], true
+}
+
+// Prev returns the preceding value in order of the enum.
+// It returns false if the value is invalid or the first value.
+func (_f Fish) Prev() (Fish, bool) {
+	idx := _f.Index()
+	if idx < 1 {
+		return Fish(0), false
+	}
+	return _FishValues[idx-1], true
+}
+
 var (
 	_FishStringToValueMap = map[string]Fish{
 		_FishString[0:13]:  FishGiantGrouper,
@@ -602,16 +783,20 @@ var (
 		_FishString[34:41]: FishCatfish,
 		_FishString[41:51]: FishHornShark,
 	}
-	_FishLowerStringToValueMap = map[string]Fish{
-		_FishLowerString[0:13]:  FishGiantGrouper,
-		_FishLowerString[13:20]: FishHagfish,
-		_FishLowerString[20:28]: FishReedfish,
-		_FishLowerString[28:34]: FishBowfin,
-		_FishLowerString[34:41]: FishCatfish,
-		_FishLowerString[41:51]: FishHornShark,
-	}
 )
 
+// _FishLookupFold determines the enum value of the string regardless of its case
+// by Unicode simple folding. It scans the String values, as a fold table is only generated
+// for enums with the "ignore-case" feature.
+func _FishLookupFold(raw string) (Fish, bool) {
+	for idx := range _FishStrings {
+		if strings.EqualFold(_FishStrings[idx], raw) {
+			return _FishValues[idx], true
+		}
+	}
+	return Fish(0), false
+}
+
 // FishFromString determines the enum value with an exact case match.
 func FishFromString(raw string) (Fish, bool) {
 	v, ok := _FishStringToValueMap[raw]
@@ -621,13 +806,14 @@ func FishFromString(raw string) (Fish, bool) {
 	return v, true
 }
 
-// FishFromStringIgnoreCase determines the enum value with a case-insensitive match.
+// FishFromStringIgnoreCase determines the enum value with a case-insensitive match
+// by Unicode simple folding, regardless of the casing of raw.
 func FishFromStringIgnoreCase(raw string) (Fish, bool) {
 	v, ok := FishFromString(raw)
 	if ok {
 		return v, ok
 	}
-	v, ok = _FishLowerStringToValueMap[raw]
+	v, ok = _FishLookupFold(raw)
 	if !ok {
 		return Fish(0), false
 	}
@@ -636,10 +822,15 @@ func FishFromStringIgnoreCase(raw string) (Fish, bool) {
 
 // MarshalBinary implements the encoding.BinaryMarshaler interface for Fish.
 func (_f Fish) MarshalBinary() ([]byte, error) {
+	return _f.AppendBinary(nil)
+}
+
+// AppendBinary implements the encoding.BinaryAppender interface for Fish.
+func (_f Fish) AppendBinary(b []byte) ([]byte, error) {
 	if err := _f.Validate(); err != nil {
-		return nil, fmt.Errorf("Cannot marshal value %q as Fish. %w", _f, err)
+		return b, fmt.Errorf("Cannot marshal value %q as Fish. %w", _f, err)
 	}
-	return []byte(_f.String()), nil
+	return append(b, _f.String()...), nil
 }
 
 // UnmarshalBinary implements the encoding.BinaryUnmarshaler interface for Fish.
@@ -652,37 +843,7 @@ func (_f *Fish) UnmarshalBinary(text []byte) error {
 	var ok bool
 	*_f, ok = FishFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a Fish", str)
-	}
-	return nil
-}
-
-// MarshalGQL implements the graphql.Marshaler interface for Fish.
-func (_f Fish) MarshalGQL(w io.Writer) {
-	fmt.Fprint(w, strconv.Quote(_f.String()))
-}
-
-// UnmarshalGQL implements the graphql.Unmarshaler interface for Fish.
-func (_f *Fish) UnmarshalGQL(value interface{}) error {
-	var str string
-	switch v := value.(type) {
-	case []byte:
-		str = string(v)
-	case string:
-		str = v
-	case fmt.Stringer:
-		str = v.String()
-	default:
-		return fmt.Errorf("invalid value of Fish: %[1]T(%[1]v)", value)
-	}
-	if len(str) == 0 {
-		return fmt.Errorf("Fish cannot be derived from empty string")
-	}
-
-	var ok bool
-	*_f, ok = FishFromString(str)
-	if !ok {
-		return fmt.Errorf("Value %q does not represent a Fish", str)
+		return _enumer.NewParseError("Fish", str, FishStrings())
 	}
 	return nil
 }
@@ -708,7 +869,7 @@ func (_f *Fish) UnmarshalJSON(data []byte) error {
 	var ok bool
 	*_f, ok = FishFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a Fish", str)
+		return _enumer.NewParseError("Fish", str, FishStrings())
 	}
 	return nil
 }
@@ -741,17 +902,22 @@ func (_f *Fish) Scan(value interface{}) error {
 	var ok bool
 	*_f, ok = FishFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a Fish", str)
+		return _enumer.NewParseError("Fish", str, FishStrings())
 	}
 	return nil
 }
 
 // MarshalText implements the encoding.TextMarshaler interface for Fish.
 func (_f Fish) MarshalText() ([]byte, error) {
+	return _f.AppendText(nil)
+}
+
+// AppendText implements the encoding.TextAppender interface for Fish.
+func (_f Fish) AppendText(b []byte) ([]byte, error) {
 	if err := _f.Validate(); err != nil {
-		return nil, fmt.Errorf("Cannot marshal value %q as Fish. %w", _f, err)
+		return b, fmt.Errorf("Cannot marshal value %q as Fish. %w", _f, err)
 	}
-	return []byte(_f.String()), nil
+	return append(b, _f.String()...), nil
 }
 
 // UnmarshalText implements the encoding.TextUnmarshaler interface for Fish.
@@ -764,7 +930,7 @@ func (_f *Fish) UnmarshalText(text []byte) error {
 	var ok bool
 	*_f, ok = FishFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a Fish", str)
+		return _enumer.NewParseError("Fish", str, FishStrings())
 	}
 	return nil
 }
@@ -791,14 +957,41 @@ func (_f *Fish) UnmarshalYAML(n *yaml.Node) error {
 	var ok bool
 	*_f, ok = FishFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a Fish", str)
+		return _enumer.NewParseError("Fish", str, FishStrings())
 	}
 	return nil
 }
 
+var _FishCompletions = [6]string{
+	"giant_grouper",
+	"hagfish",
+	"reedfish",
+	"bowfin",
+	"catfish",
+	"horn_shark",
+}
+
+// FishUsage returns a usage hint of all String values of the enum, less the deprecated values,
+// e.g. for flag descriptions.
+func FishUsage() string {
+	return "one of: " + strings.Join(_FishStrings[:], "|")
+}
+
+// FishCompletions returns the completion candidates of the enum matching the given prefix,
+// less the deprecated values. Each candidate is formatted as "<value>" or "<value>\t<description>",
+// as expected by shell completions, e.g. of cobra's RegisterFlagCompletionFunc.
+func FishCompletions(toComplete string) []string {
+	out := make([]string, 0, len(_FishCompletions))
+	for _, c := range _FishCompletions {
+		if strings.HasPrefix(c, toComplete) {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
 const (
-	_MammalString      = "BUMBLEBEE-BATBLUE-WHALEBOWHEAD-WHALE"
-	_MammalLowerString = "bumblebee-batblue-whalebowhead-whale"
+	_MammalString = "BUMBLEBEE-BATBLUE-WHALEBOWHEAD-WHALE"
 )
 
 var (
@@ -828,6 +1021,11 @@ func MammalStrings() []string {
 	return cp[:]
 }
 
+// Values returns all values of the enum.
+func (Mammal) Values() []Mammal {
+	return MammalValues()
+}
+
 // IsValid tests whether the value is a valid enum value.
 func (_m Mammal) IsValid() bool {
 	return _m >= 0 && _m <= 2
@@ -852,19 +1050,74 @@ func (_m Mammal) String() string {
 	return _MammalStrings[idx]
 }
 
+// Index returns the position of the value within the ordered values of the enum
+// (ignoring alternative values) or -1 if the value is invalid.
+func (_m Mammal) Index() int {
+	if !_m.IsValid() {
+		return -1
+	}
+	idx := int(_m)
+	return idx
+}
+
+// Compare returns -1, 0 or +1 depending on whether the value orders before,
+// equal to or after the other value. Invalid values order before all valid values.
+func (_m Mammal) Compare(other Mammal) int {
+	a, b := _m.Index(), other.Index()
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return +1
+	}
+	return 0
+}
+
+// Less tests whether the value orders before the other value.
+func (_m Mammal) Less(other Mammal) bool {
+	return _m.Compare(other) < 0
+}
+
+// Next returns the succeeding value in order of the enum.
+// It returns false if the value is invalid or the last value.
+func (_m Mammal) Next() (Mammal, bool) {
+	idx := _m.Index()
+	if idx == -1 || idx+1 == len(_MammalValues) {
+		return Mammal(0), false
+	}
+	return _MammalValues[idx+1], true
+}
+
+// Prev returns the preceding value in order of the enum.
+// It returns false if the value is invalid or the first value.
+func (_m Mammal) Prev() (Mammal, bool) {
+	idx := _m.Index()
+	if idx < 1 {
+		return Mammal(0), false
+	}
+	return _MammalValues[idx-1], true
+}
+
 var (
 	_MammalStringToValueMap = map[string]Mammal{
 		_MammalString[0:13]:  MammalBumblebeeBat,
 		_MammalString[13:23]: MammalBlueWhale,
 		_MammalString[23:36]: MammalBowheadWhale,
 	}
-	_MammalLowerStringToValueMap = map[string]Mammal{
-		_MammalLowerString[0:13]:  MammalBumblebeeBat,
-		_MammalLowerString[13:23]: MammalBlueWhale,
-		_MammalLowerString[23:36]: MammalBowheadWhale,
-	}
 )
 
+// _MammalLookupFold determines the enum value of the string regardless of its case
+// by Unicode simple folding. It scans the String values, as a fold table is only generated
+// for enums with the "ignore-case" feature.
+func _MammalLookupFold(raw string) (Mammal, bool) {
+	for idx := range _MammalStrings {
+		if strings.EqualFold(_MammalStrings[idx], raw) {
+			return _MammalValues[idx], true
+		}
+	}
+	return Mammal(0), false
+}
+
 // MammalFromString determines the enum value with an exact case match.
 func MammalFromString(raw string) (Mammal, bool) {
 	v, ok := _MammalStringToValueMap[raw]
@@ -874,13 +1127,14 @@ func MammalFromString(raw string) (Mammal, bool) {
 	return v, true
 }
 
-// MammalFromStringIgnoreCase determines the enum value with a case-insensitive match.
+// MammalFromStringIgnoreCase determines the enum value with a case-insensitive match
+// by Unicode simple folding, regardless of the casing of raw.
 func MammalFromStringIgnoreCase(raw string) (Mammal, bool) {
 	v, ok := MammalFromString(raw)
 	if ok {
 		return v, ok
 	}
-	v, ok = _MammalLowerStringToValueMap[raw]
+	v, ok = _MammalLookupFold(raw)
 	if !ok {
 		return Mammal(0), false
 	}
@@ -889,10 +1143,15 @@ func MammalFromStringIgnoreCase(raw string) (Mammal, bool) {
 
 // MarshalBinary implements the encoding.BinaryMarshaler interface for Mammal.
 func (_m Mammal) MarshalBinary() ([]byte, error) {
+	return _m.AppendBinary(nil)
+}
+
+// AppendBinary implements the encoding.BinaryAppender interface for Mammal.
+func (_m Mammal) AppendBinary(b []byte) ([]byte, error) {
 	if err := _m.Validate(); err != nil {
-		return nil, fmt.Errorf("Cannot marshal value %q as Mammal. %w", _m, err)
+		return b, fmt.Errorf("Cannot marshal value %q as Mammal. %w", _m, err)
 	}
-	return []byte(_m.String()), nil
+	return append(b, _m.String()...), nil
 }
 
 // UnmarshalBinary implements the encoding.BinaryUnmarshaler interface for Mammal.
@@ -905,37 +1164,7 @@ func (_m *Mammal) UnmarshalBinary(text []byte) error {
 	var ok bool
 	*_m, ok = MammalFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a Mammal", str)
-	}
-	return nil
-}
-
-// MarshalGQL implements the graphql.Marshaler interface for Mammal.
-func (_m Mammal) MarshalGQL(w io.Writer) {
-	fmt.Fprint(w, strconv.Quote(_m.String()))
-}
-
-// UnmarshalGQL implements the graphql.Unmarshaler interface for Mammal.
-func (_m *Mammal) UnmarshalGQL(value interface{}) error {
-	var str string
-	switch v := value.(type) {
-	case []byte:
-		str = string(v)
-	case string:
-		str = v
-	case fmt.Stringer:
-		str = v.String()
-	default:
-		return fmt.Errorf("invalid value of Mammal: %[1]T(%[1]v)", value)
-	}
-	if len(str) == 0 {
-		return fmt.Errorf("Mammal cannot be derived from empty string")
-	}
-
-	var ok bool
-	*_m, ok = MammalFromString(str)
-	if !ok {
-		return fmt.Errorf("Value %q does not represent a Mammal", str)
+		return _enumer.NewParseError("Mammal", str, MammalStrings())
 	}
 	return nil
 }
@@ -961,7 +1190,7 @@ func (_m *Mammal) UnmarshalJSON(data []byte) error {
 	var ok bool
 	*_m, ok = MammalFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a Mammal", str)
+		return _enumer.NewParseError("Mammal", str, MammalStrings())
 	}
 	return nil
 }
@@ -994,17 +1223,22 @@ func (_m *Mammal) Scan(value interface{}) error {
 	var ok bool
 	*_m, ok = MammalFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a Mammal", str)
+		return _enumer.NewParseError("Mammal", str, MammalStrings())
 	}
 	return nil
 }
 
 // MarshalText implements the encoding.TextMarshaler interface for Mammal.
 func (_m Mammal) MarshalText() ([]byte, error) {
+	return _m.AppendText(nil)
+}
+
+// AppendText implements the encoding.TextAppender interface for Mammal.
+func (_m Mammal) AppendText(b []byte) ([]byte, error) {
 	if err := _m.Validate(); err != nil {
-		return nil, fmt.Errorf("Cannot marshal value %q as Mammal. %w", _m, err)
+		return b, fmt.Errorf("Cannot marshal value %q as Mammal. %w", _m, err)
 	}
-	return []byte(_m.String()), nil
+	return append(b, _m.String()...), nil
 }
 
 // UnmarshalText implements the encoding.TextUnmarshaler interface for Mammal.
@@ -1017,7 +1251,7 @@ func (_m *Mammal) UnmarshalText(text []byte) error {
 	var ok bool
 	*_m, ok = MammalFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a Mammal", str)
+		return _enumer.NewParseError("Mammal", str, MammalStrings())
 	}
 	return nil
 }
@@ -1044,14 +1278,38 @@ func (_m *Mammal) UnmarshalYAML(n *yaml.Node) error {
 	var ok bool
 	*_m, ok = MammalFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a Mammal", str)
+		return _enumer.NewParseError("Mammal", str, MammalStrings())
 	}
 	return nil
 }
 
+var _MammalCompletions = [3]string{
+	"BUMBLEBEE-BAT",
+	"BLUE-WHALE",
+	"BOWHEAD-WHALE",
+}
+
+// MammalUsage returns a usage hint of all String values of the enum, less the deprecated values,
+// e.g. for flag descriptions.
+func MammalUsage() string {
+	return "one of: " + strings.Join(_MammalStrings[:], "|")
+}
+
+// MammalCompletions returns the completion candidates of the enum matching the given prefix,
+// less the deprecated values. Each candidate is formatted as "<value>" or "<value>\t<description>",
+// as expected by shell completions, e.g. of cobra's RegisterFlagCompletionFunc.
+func MammalCompletions(toComplete string) []string {
+	out := make([]string, 0, len(_MammalCompletions))
+	for _, c := range _MammalCompletions {
+		if strings.HasPrefix(c, toComplete) {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
 const (
-	_ReptileString      = "saltwaterCrocodilebeardedDragonchameleoncomodoDragon"
-	_ReptileLowerString = "saltwatercrocodilebeardeddragonchameleoncomododragon"
+	_ReptileString = "saltwaterCrocodilebeardedDragonchameleoncomodoDragon"
 )
 
 var (
@@ -1082,6 +1340,11 @@ func ReptileStrings() []string {
 	return cp[:]
 }
 
+// Values returns all values of the enum.
+func (Reptile) Values() []Reptile {
+	return ReptileValues()
+}
+
 // IsValid tests whether the value is a valid enum value.
 func (_r Reptile) IsValid() bool {
 	return _r >= 0 && _r <= 3
@@ -1106,6 +1369,54 @@ func (_r Reptile) String() string {
 	return _ReptileStrings[idx]
 }
 
+// Index returns the position of the value within the ordered values of the enum
+// (ignoring alternative values) or -1 if the value is invalid.
+func (_r Reptile) Index() int {
+	if !_r.IsValid() {
+		return -1
+	}
+	idx := int(_r)
+	return idx
+}
+
+// Compare returns -1, 0 or +1 depending on whether the value orders before,
+// equal to or after the other value. Invalid values order before all valid values.
+func (_r Reptile) Compare(other Reptile) int {
+	a, b := _r.Index(), other.Index()
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return +1
+	}
+	return 0
+}
+
+// Less tests whether the value orders before the other value.
+func (_r Reptile) Less(other Reptile) bool {
+	return _r.Compare(other) < 0
+}
+
+// Next returns the succeeding value in order of the enum.
+// It returns false if the value is invalid or the last value.
+func (_r Reptile) Next() (Reptile, bool) {
+	idx := _r.Index()
+	if idx == -1 || idx+1 == len(_ReptileValues) {
+		return Reptile(0), false
+	}
+	return _ReptileValues[idx+1], true
+}
+
+// Prev returns the preceding value in order of the enum.
+// It returns false if the value is invalid or the first value.
+func (_r Reptile) Prev() (Reptile, bool) {
+	idx := _r.Index()
+	if idx < 1 {
+		return Reptile(0), false
+	}
+	return _ReptileValues[idx-1], true
+}
+
 var (
 	_ReptileStringToValueMap = map[string]Reptile{
 		_ReptileString[0:18]:  ReptileSaltwaterCrocodile,
@@ -1113,14 +1424,20 @@ var (
 		_ReptileString[31:40]: ReptileChameleon,
 		_ReptileString[40:52]: ReptileComodoDragon,
 	}
-	_ReptileLowerStringToValueMap = map[string]Reptile{
-		_ReptileLowerString[0:18]:  ReptileSaltwaterCrocodile,
-		_ReptileLowerString[18:31]: ReptileBeardedDragon,
-		_ReptileLowerString[31:40]: ReptileChameleon,
-		_ReptileLowerString[40:52]: ReptileComodoDragon,
-	}
 )
 
+// _ReptileLookupFold determines the enum value of the string regardless of its case
+// by Unicode simple folding. It scans the String values, as a fold table is only generated
+// for enums with the "ignore-case" feature.
+func _ReptileLookupFold(raw string) (Reptile, bool) {
+	for idx := range _ReptileStrings {
+		if strings.EqualFold(_ReptileStrings[idx], raw) {
+			return _ReptileValues[idx], true
+		}
+	}
+	return Reptile(0), false
+}
+
 // ReptileFromString determines the enum value with an exact case match.
 func ReptileFromString(raw string) (Reptile, bool) {
 	v, ok := _ReptileStringToValueMap[raw]
@@ -1130,13 +1447,14 @@ func ReptileFromString(raw string) (Reptile, bool) {
 	return v, true
 }
 
-// ReptileFromStringIgnoreCase determines the enum value with a case-insensitive match.
+// ReptileFromStringIgnoreCase determines the enum value with a case-insensitive match
+// by Unicode simple folding, regardless of the casing of raw.
 func ReptileFromStringIgnoreCase(raw string) (Reptile, bool) {
 	v, ok := ReptileFromString(raw)
 	if ok {
 		return v, ok
 	}
-	v, ok = _ReptileLowerStringToValueMap[raw]
+	v, ok = _ReptileLookupFold(raw)
 	if !ok {
 		return Reptile(0), false
 	}
@@ -1145,10 +1463,15 @@ func ReptileFromStringIgnoreCase(raw string) (Reptile, bool) {
 
 // MarshalBinary implements the encoding.BinaryMarshaler interface for Reptile.
 func (_r Reptile) MarshalBinary() ([]byte, error) {
+	return _r.AppendBinary(nil)
+}
+
+// AppendBinary implements the encoding.BinaryAppender interface for Reptile.
+func (_r Reptile) AppendBinary(b []byte) ([]byte, error) {
 	if err := _r.Validate(); err != nil {
-		return nil, fmt.Errorf("Cannot marshal value %q as Reptile. %w", _r, err)
+		return b, fmt.Errorf("Cannot marshal value %q as Reptile. %w", _r, err)
 	}
-	return []byte(_r.String()), nil
+	return append(b, _r.String()...), nil
 }
 
 // UnmarshalBinary implements the encoding.BinaryUnmarshaler interface for Reptile.
@@ -1161,37 +1484,7 @@ func (_r *Reptile) UnmarshalBinary(text []byte) error {
 	var ok bool
 	*_r, ok = ReptileFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a Reptile", str)
-	}
-	return nil
-}
-
-// MarshalGQL implements the graphql.Marshaler interface for Reptile.
-func (_r Reptile) MarshalGQL(w io.Writer) {
-	fmt.Fprint(w, strconv.Quote(_r.String()))
-}
-
-// UnmarshalGQL implements the graphql.Unmarshaler interface for Reptile.
-func (_r *Reptile) UnmarshalGQL(value interface{}) error {
-	var str string
-	switch v := value.(type) {
-	case []byte:
-		str = string(v)
-	case string:
-		str = v
-	case fmt.Stringer:
-		str = v.String()
-	default:
-		return fmt.Errorf("invalid value of Reptile: %[1]T(%[1]v)", value)
-	}
-	if len(str) == 0 {
-		return fmt.Errorf("Reptile cannot be derived from empty string")
-	}
-
-	var ok bool
-	*_r, ok = ReptileFromString(str)
-	if !ok {
-		return fmt.Errorf("Value %q does not represent a Reptile", str)
+		return _enumer.NewParseError("Reptile", str, ReptileStrings())
 	}
 	return nil
 }
@@ -1217,7 +1510,7 @@ func (_r *Reptile) UnmarshalJSON(data []byte) error {
 	var ok bool
 	*_r, ok = ReptileFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a Reptile", str)
+		return _enumer.NewParseError("Reptile", str, ReptileStrings())
 	}
 	return nil
 }
@@ -1250,17 +1543,22 @@ func (_r *Reptile) Scan(value interface{}) error {
 	var ok bool
 	*_r, ok = ReptileFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a Reptile", str)
+		return _enumer.NewParseError("Reptile", str, ReptileStrings())
 	}
 	return nil
 }
 
 // MarshalText implements the encoding.TextMarshaler interface for Reptile.
 func (_r Reptile) MarshalText() ([]byte, error) {
+	return _r.AppendText(nil)
+}
+
+// AppendText implements the encoding.TextAppender interface for Reptile.
+func (_r Reptile) AppendText(b []byte) ([]byte, error) {
 	if err := _r.Validate(); err != nil {
-		return nil, fmt.Errorf("Cannot marshal value %q as Reptile. %w", _r, err)
+		return b, fmt.Errorf("Cannot marshal value %q as Reptile. %w", _r, err)
 	}
-	return []byte(_r.String()), nil
+	return append(b, _r.String()...), nil
 }
 
 // UnmarshalText implements the encoding.TextUnmarshaler interface for Reptile.
@@ -1273,7 +1571,7 @@ func (_r *Reptile) UnmarshalText(text []byte) error {
 	var ok bool
 	*_r, ok = ReptileFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a Reptile", str)
+		return _enumer.NewParseError("Reptile", str, ReptileStrings())
 	}
 	return nil
 }
@@ -1300,7 +1598,33 @@ func (_r *Reptile) UnmarshalYAML(n *yaml.Node) error {
 	var ok bool
 	*_r, ok = ReptileFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a Reptile", str)
+		return _enumer.NewParseError("Reptile", str, ReptileStrings())
 	}
 	return nil
 }
+
+var _ReptileCompletions = [4]string{
+	"saltwaterCrocodile",
+	"beardedDragon",
+	"chameleon",
+	"comodoDragon",
+}
+
+// ReptileUsage returns a usage hint of all String values of the enum, less the deprecated values,
+// e.g. for flag descriptions.
+func ReptileUsage() string {
+	return "one of: " + strings.Join(_ReptileStrings[:], "|")
+}
+
+// ReptileCompletions returns the completion candidates of the enum matching the given prefix,
+// less the deprecated values. Each candidate is formatted as "<value>" or "<value>\t<description>",
+// as expected by shell completions, e.g. of cobra's RegisterFlagCompletionFunc.
+func ReptileCompletions(toComplete string) []string {
+	out := make([]string, 0, len(_ReptileCompletions))
+	for _, c := range _ReptileCompletions {
+		if strings.HasPrefix(c, toComplete) {
+			out = append(out, c)
+		}
+	}
+	return out
+}
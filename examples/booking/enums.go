@@ -1,13 +1,17 @@
 package booking
 
 // BookingState is an indicator for bookings.
-//go:enum -from=booking.csv
+//go:enum -from=booking.csv -serializers=graphql,sql,yaml
 type BookingState uint
 
 // BookingStateWithConfig will have its own configuration.
 //go:enum -from=booking.csv -serializers=json,yaml -support=undefined
 type BookingStateWithConfig uint
 
+// BookingStateMachine declares its permitted state transitions in a CSV file.
+//go:enum -from=booking.csv -transitions=booking_transitions.csv -serializers=flag,sql,yaml
+type BookingStateMachine uint
+
 // BookingStateWithConstants will have a subset (compared to CSV source)
 // of explicitly defined constants.
 //go:enum -from=booking.csv
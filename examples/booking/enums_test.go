@@ -86,7 +86,7 @@ func TestEnums(t *testing.T) {
 			}
 		})
 		t.Run("Missing Serializers", func(t *testing.T) {
-			utils.AssertMissingSerializationInterfacesFor[BookingState](t, []string{"binary", "graphql", "json", "sql", "text"})
+			utils.AssertMissingSerializationInterfacesFor[BookingState](t, []string{"binary", "json", "text"})
 		})
 		t.Run("Serialization", func(t *testing.T) {
 			cfg := utils.TestConfig{}
@@ -101,7 +101,7 @@ func TestEnums(t *testing.T) {
 				{From: "Deleted", Enum: toPtr(5), Expected: utils.Expected{AsSerialized: "Deleted"}},
 			}
 			for idx, tC := range testCases {
-				serializers := []string{"yaml"}
+				serializers := []string{"graphql", "sql", "yaml"}
 				utils.AssertSerializationInterfacesFor[BookingState](t, idx, tC, cfg, serializers)
 			}
 		})
@@ -188,6 +188,44 @@ func TestEnums(t *testing.T) {
 			}
 		})
 	})
+	t.Run("BookingStateMachine", func(t *testing.T) {
+		t.Run("Transitions", func(t *testing.T) {
+			require.Equal(t, []BookingStateMachine{1, 2, 3, 4}, BookingStateMachine(0).Transitions())
+			require.Equal(t, []BookingStateMachine{5}, BookingStateMachine(3).Transitions())
+			require.Empty(t, BookingStateMachine(5).Transitions())
+			require.Empty(t, BookingStateMachine(6).Transitions())
+
+			require.True(t, BookingStateMachine(0).CanTransitionTo(1))
+			require.True(t, BookingStateMachine(4).CanTransitionTo(5))
+			require.False(t, BookingStateMachine(0).CanTransitionTo(5))
+			require.False(t, BookingStateMachine(5).CanTransitionTo(0))
+			require.False(t, BookingStateMachine(6).CanTransitionTo(0))
+			require.Zero(t, testing.AllocsPerRun(10, func() {
+				BookingStateMachine(0).CanTransitionTo(4)
+				BookingStateMachine(5).IsTerminal()
+			}))
+		})
+		t.Run("Completions", func(t *testing.T) {
+			require.Equal(t, "one of: Created|Unavailable|Failed|Canceled|NotFound|Deleted", BookingStateMachineUsage())
+			require.Equal(t, []string{
+				"Created\tThe booking was created successfully",
+				"Canceled\tThe booking was canceled",
+			}, BookingStateMachineCompletions("C"))
+		})
+		t.Run("Terminal States", func(t *testing.T) {
+			require.False(t, BookingStateMachine(0).IsTerminal())
+			require.False(t, BookingStateMachine(1).IsTerminal())
+			require.True(t, BookingStateMachine(5).IsTerminal())
+			require.False(t, BookingStateMachine(6).IsTerminal())
+		})
+		t.Run("Diagrams", func(t *testing.T) {
+			require.Contains(t, BookingStateMachineTransitionsDOT(), "digraph BookingStateMachine {")
+			require.Contains(t, BookingStateMachineTransitionsDOT(), "\t\"Created\" -> \"Unavailable\";\n")
+			require.Contains(t, BookingStateMachineTransitionsMermaid(), "\t[*] --> s0\n")
+			require.Contains(t, BookingStateMachineTransitionsMermaid(), "\ts0 --> s1\n")
+			require.Contains(t, BookingStateMachineTransitionsMermaid(), "\ts5 --> [*]\n")
+		})
+	})
 	t.Run("BookingStateWithConstants", func(t *testing.T) {
 		t.Run("Value Sets", func(t *testing.T) {
 			require.Equal(t,
@@ -250,7 +288,7 @@ func TestEnums(t *testing.T) {
 			}
 		})
 		t.Run("Missing Serializers", func(t *testing.T) {
-			utils.AssertMissingSerializationInterfacesFor[BookingStateWithConstants](t, []string{"binary", "graphql", "json", "sql", "text"})
+			utils.AssertMissingSerializationInterfacesFor[BookingStateWithConstants](t, []string{"binary", "graphql", "json", "text"})
 		})
 		t.Run("Serialization", func(t *testing.T) {
 			cfg := utils.TestConfig{}
@@ -265,7 +303,7 @@ func TestEnums(t *testing.T) {
 				{From: "Deleted", Enum: toPtr(5), Expected: utils.Expected{AsSerialized: "Deleted"}},
 			}
 			for idx, tC := range testCases {
-				serializers := []string{"yaml"}
+				serializers := []string{"sql", "yaml"}
 				utils.AssertSerializationInterfacesFor[BookingStateWithConstants](t, idx, tC, cfg, serializers)
 			}
 		})
@@ -3,18 +3,21 @@
 package booking
 
 import (
+	"database/sql/driver"
 	"encoding/json"
-	"errors"
 	"fmt"
+	_enumer "github.com/mvrahden/go-enumer/enum"
+	"io"
+	"strconv"
+	"strings"
 )
 
 var (
-	ErrNoValidEnum = errors.New("not a valid enum")
+	ErrNoValidEnum = _enumer.ErrNoValidEnum
 )
 
 const (
-	_BookingStateString      = "CreatedUnavailableFailedCanceledNotFoundDeleted"
-	_BookingStateLowerString = "createdunavailablefailedcancelednotfounddeleted"
+	_BookingStateString = "CreatedUnavailableFailedCanceledNotFoundDeleted"
 )
 
 var (
@@ -68,6 +71,54 @@ func (_b BookingState) String() string {
 	return _BookingStateStrings[idx]
 }
 
+// Index returns the position of the value within the ordered values of the enum
+// (ignoring alternative values) or -1 if the value is invalid.
+func (_b BookingState) Index() int {
+	if !_b.IsValid() {
+		return -1
+	}
+	idx := int(_b)
+	return idx
+}
+
+// Compare returns -1, 0 or +1 depending on whether the value orders before,
+// equal to or after the other value. Invalid values order before all valid values.
+func (_b BookingState) Compare(other BookingState) int {
+	a, b := _b.Index(), other.Index()
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return +1
+	}
+	return 0
+}
+
+// Less tests whether the value orders before the other value.
+func (_b BookingState) Less(other BookingState) bool {
+	return _b.Compare(other) < 0
+}
+
+// Next returns the succeeding value in order of the enum.
+// It returns false if the value is invalid or the last value.
+func (_b BookingState) Next() (BookingState, bool) {
+	idx := _b.Index()
+	if idx == -1 || idx+1 == len(_BookingStateValues) {
+		return BookingState(0), false
+	}
+	return _BookingStateValues[idx+1], true
+}
+
+// Prev returns the preceding value in order of the enum.
+// It returns false if the value is invalid or the first value.
+func (_b BookingState) Prev() (BookingState, bool) {
+	idx := _b.Index()
+	if idx < 1 {
+		return BookingState(0), false
+	}
+	return _BookingStateValues[idx-1], true
+}
+
 // GetDescription returns the "description" of the enum value.
 func (_b BookingState) GetDescription() string {
 	if !_b.IsValid() {
@@ -87,16 +138,47 @@ var (
 		_BookingStateString[32:40]: 4,
 		_BookingStateString[40:47]: 5,
 	}
-	_BookingStateLowerStringToValueMap = map[string]BookingState{
-		_BookingStateLowerString[0:7]:   0,
-		_BookingStateLowerString[7:18]:  1,
-		_BookingStateLowerString[18:24]: 2,
-		_BookingStateLowerString[24:32]: 3,
-		_BookingStateLowerString[32:40]: 4,
-		_BookingStateLowerString[40:47]: 5,
+)
+
+var (
+	_BookingStateFoldOffsets = [13]uint16{0, 0, 0, 0, 0, 0, 0, 1, 3, 5, 5, 5, 6}
+	_BookingStateFoldTable   = [6]struct {
+		s string
+		v BookingState
+	}{
+		{_BookingStateString[18:24], 2},
+		{_BookingStateString[0:7], 0},
+		{_BookingStateString[40:47], 5},
+		{_BookingStateString[24:32], 3},
+		{_BookingStateString[32:40], 4},
+		{_BookingStateString[7:18], 1},
 	}
 )
 
+// _BookingStateLookupFold determines the enum value of the string regardless of the case
+// of its ASCII letters. It searches the entries of the fold table, whose strings
+// are as long as the string.
+func _BookingStateLookupFold(raw string) (BookingState, bool) {
+	n := len(raw)
+	if n+1 >= len(_BookingStateFoldOffsets) {
+		return BookingState(0), false
+	}
+	lo, hi := int(_BookingStateFoldOffsets[n]), int(_BookingStateFoldOffsets[n+1])
+	for lo < hi {
+		m := int(uint(lo+hi) >> 1)
+		e := &_BookingStateFoldTable[m]
+		switch c := _enumer.CompareFoldASCII(e.s, raw); {
+		case c == 0:
+			return e.v, true
+		case c < 0:
+			lo = m + 1
+		default:
+			hi = m
+		}
+	}
+	return BookingState(0), false
+}
+
 // BookingStateFromString determines the enum value with an exact case match.
 func BookingStateFromString(raw string) (BookingState, bool) {
 	v, ok := _BookingStateStringToValueMap[raw]
@@ -106,19 +188,83 @@ func BookingStateFromString(raw string) (BookingState, bool) {
 	return v, true
 }
 
-// BookingStateFromStringIgnoreCase determines the enum value with a case-insensitive match.
+// BookingStateFromStringIgnoreCase determines the enum value with a case-insensitive match
+// of the ASCII letters, regardless of the casing of raw.
 func BookingStateFromStringIgnoreCase(raw string) (BookingState, bool) {
 	v, ok := BookingStateFromString(raw)
 	if ok {
 		return v, ok
 	}
-	v, ok = _BookingStateLowerStringToValueMap[raw]
+	v, ok = _BookingStateLookupFold(raw)
 	if !ok {
 		return BookingState(0), false
 	}
 	return v, true
 }
 
+// MarshalGQL implements the graphql.Marshaler interface for BookingState.
+func (_b BookingState) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(_b.String()))
+}
+
+// UnmarshalGQL implements the graphql.Unmarshaler interface for BookingState.
+func (_b *BookingState) UnmarshalGQL(value interface{}) error {
+	var str string
+	switch v := value.(type) {
+	case []byte:
+		str = string(v)
+	case string:
+		str = v
+	case fmt.Stringer:
+		str = v.String()
+	default:
+		return fmt.Errorf("invalid value of BookingState: %[1]T(%[1]v)", value)
+	}
+	if len(str) == 0 {
+		return fmt.Errorf("BookingState cannot be derived from empty string")
+	}
+
+	var ok bool
+	*_b, ok = BookingStateFromStringIgnoreCase(str)
+	if !ok {
+		return _enumer.NewParseError("BookingState", str, BookingStateStrings())
+	}
+	return nil
+}
+
+// Value implements the sql/driver.Valuer interface for BookingState.
+func (_b BookingState) Value() (driver.Value, error) {
+	if err := _b.Validate(); err != nil {
+		return nil, fmt.Errorf("Cannot serialize value %q as BookingState. %w", _b, err)
+	}
+	return _b.String(), nil
+}
+
+// Scan implements the sql/driver.Scanner interface for BookingState.
+func (_b *BookingState) Scan(value interface{}) error {
+	var str string
+	switch v := value.(type) {
+	case []byte:
+		str = string(v)
+	case string:
+		str = v
+	case fmt.Stringer:
+		str = v.String()
+	default:
+		return fmt.Errorf("invalid value of BookingState: %[1]T(%[1]v)", value)
+	}
+	if len(str) == 0 {
+		return fmt.Errorf("BookingState cannot be derived from empty string")
+	}
+
+	var ok bool
+	*_b, ok = BookingStateFromStringIgnoreCase(str)
+	if !ok {
+		return _enumer.NewParseError("BookingState", str, BookingStateStrings())
+	}
+	return nil
+}
+
 // MarshalYAML implements a YAML Marshaler for BookingState.
 func (_b BookingState) MarshalYAML() (interface{}, error) {
 	if err := _b.Validate(); err != nil {
@@ -140,19 +286,404 @@ func (_b *BookingState) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	var ok bool
 	*_b, ok = BookingStateFromStringIgnoreCase(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a BookingState", str)
+		return _enumer.NewParseError("BookingState", str, BookingStateStrings())
 	}
 	return nil
 }
 
 // Values returns a slice of all String values of the enum.
+// It implements the ent EnumValues interface. Declare the ent field e.g. as follows:
+//
+//	field.Enum("booking_state").GoType(BookingState(0))
 func (BookingState) Values() []string {
 	return BookingStateStrings()
 }
 
+var _BookingStateCompletions = [6]string{
+	"Created\tThe booking was created successfully",
+	"Unavailable\tThe booking was not available",
+	"Failed\tThe booking failed",
+	"Canceled\tThe booking was canceled",
+	"NotFound\tThe booking was not found",
+	"Deleted\tThe booking was deleted",
+}
+
+// BookingStateUsage returns a usage hint of all String values of the enum, less the deprecated values,
+// e.g. for flag descriptions.
+func BookingStateUsage() string {
+	return "one of: " + strings.Join(_BookingStateStrings[:], "|")
+}
+
+// BookingStateCompletions returns the completion candidates of the enum matching the given prefix,
+// less the deprecated values. Each candidate is formatted as "<value>" or "<value>\t<description>",
+// as expected by shell completions, e.g. of cobra's RegisterFlagCompletionFunc.
+func BookingStateCompletions(toComplete string) []string {
+	out := make([]string, 0, len(_BookingStateCompletions))
+	for _, c := range _BookingStateCompletions {
+		if strings.HasPrefix(c, toComplete) {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
 const (
-	_BookingStateWithConfigString      = "CreatedUnavailableFailedCanceledNotFoundDeleted"
-	_BookingStateWithConfigLowerString = "createdunavailablefailedcancelednotfounddeleted"
+	_BookingStateMachineString = "CreatedUnavailableFailedCanceledNotFoundDeleted"
+)
+
+var (
+	_BookingStateMachineValues         = [6]BookingStateMachine{0, 1, 2, 3, 4, 5}
+	_BookingStateMachineStrings        = [6]string{_BookingStateMachineString[0:7], _BookingStateMachineString[7:18], _BookingStateMachineString[18:24], _BookingStateMachineString[24:32], _BookingStateMachineString[32:40], _BookingStateMachineString[40:47]}
+	_BookingStateMachineAdditionalData = [6]struct {
+		Description string
+	}{
+		{"The booking was created successfully"},
+		{"The booking was not available"},
+		{"The booking failed"},
+		{"The booking was canceled"},
+		{"The booking was not found"},
+		{"The booking was deleted"},
+	}
+)
+
+// BookingStateMachineValues returns all values of the enum.
+func BookingStateMachineValues() []BookingStateMachine {
+	cp := _BookingStateMachineValues
+	return cp[:]
+}
+
+// BookingStateMachineStrings returns a slice of all String values of the enum.
+func BookingStateMachineStrings() []string {
+	cp := _BookingStateMachineStrings
+	return cp[:]
+}
+
+// IsValid tests whether the value is a valid enum value.
+func (_b BookingStateMachine) IsValid() bool {
+	return _b >= 0 && _b <= 5
+}
+
+// Validate whether the value is within the range of enum values.
+func (_b BookingStateMachine) Validate() error {
+	if !_b.IsValid() {
+		return fmt.Errorf("BookingStateMachine(%d) is %w", _b, ErrNoValidEnum)
+	}
+	return nil
+}
+
+// String returns the string of the enum value.
+// If the enum value is invalid, it will produce a string
+// of the following pattern BookingStateMachine(%d) instead.
+func (_b BookingStateMachine) String() string {
+	if !_b.IsValid() {
+		return fmt.Sprintf("BookingStateMachine(%d)", _b)
+	}
+	idx := uint(_b)
+	return _BookingStateMachineStrings[idx]
+}
+
+// Index returns the position of the value within the ordered values of the enum
+// (ignoring alternative values) or -1 if the value is invalid.
+func (_b BookingStateMachine) Index() int {
+	if !_b.IsValid() {
+		return -1
+	}
+	idx := int(_b)
+	return idx
+}
+
+// Compare returns -1, 0 or +1 depending on whether the value orders before,
+// equal to or after the other value. Invalid values order before all valid values.
+func (_b BookingStateMachine) Compare(other BookingStateMachine) int {
+	a, b := _b.Index(), other.Index()
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return +1
+	}
+	return 0
+}
+
+// Less tests whether the value orders before the other value.
+func (_b BookingStateMachine) Less(other BookingStateMachine) bool {
+	return _b.Compare(other) < 0
+}
+
+// Next returns the succeeding value in order of the enum.
+// It returns false if the value is invalid or the last value.
+func (_b BookingStateMachine) Next() (BookingStateMachine, bool) {
+	idx := _b.Index()
+	if idx == -1 || idx+1 == len(_BookingStateMachineValues) {
+		return BookingStateMachine(0), false
+	}
+	return _BookingStateMachineValues[idx+1], true
+}
+
+// Prev returns the preceding value in order of the enum.
+// It returns false if the value is invalid or the first value.
+func (_b BookingStateMachine) Prev() (BookingStateMachine, bool) {
+	idx := _b.Index()
+	if idx < 1 {
+		return BookingStateMachine(0), false
+	}
+	return _BookingStateMachineValues[idx-1], true
+}
+
+// GetDescription returns the "description" of the enum value.
+func (_b BookingStateMachine) GetDescription() string {
+	if !_b.IsValid() {
+		panic(fmt.Errorf("Forbidden access to additional enum data of %q. err: %w", _b, ErrNoValidEnum))
+	}
+	idx := uint(_b)
+	d := _BookingStateMachineAdditionalData[idx]
+	return d.Description
+}
+
+var (
+	_BookingStateMachineStringToValueMap = map[string]BookingStateMachine{
+		_BookingStateMachineString[0:7]:   0,
+		_BookingStateMachineString[7:18]:  1,
+		_BookingStateMachineString[18:24]: 2,
+		_BookingStateMachineString[24:32]: 3,
+		_BookingStateMachineString[32:40]: 4,
+		_BookingStateMachineString[40:47]: 5,
+	}
+)
+
+var (
+	_BookingStateMachineFoldOffsets = [13]uint16{0, 0, 0, 0, 0, 0, 0, 1, 3, 5, 5, 5, 6}
+	_BookingStateMachineFoldTable   = [6]struct {
+		s string
+		v BookingStateMachine
+	}{
+		{_BookingStateMachineString[18:24], 2},
+		{_BookingStateMachineString[0:7], 0},
+		{_BookingStateMachineString[40:47], 5},
+		{_BookingStateMachineString[24:32], 3},
+		{_BookingStateMachineString[32:40], 4},
+		{_BookingStateMachineString[7:18], 1},
+	}
+)
+
+// _BookingStateMachineLookupFold determines the enum value of the string regardless of the case
+// of its ASCII letters. It searches the entries of the fold table, whose strings
+// are as long as the string.
+func _BookingStateMachineLookupFold(raw string) (BookingStateMachine, bool) {
+	n := len(raw)
+	if n+1 >= len(_BookingStateMachineFoldOffsets) {
+		return BookingStateMachine(0), false
+	}
+	lo, hi := int(_BookingStateMachineFoldOffsets[n]), int(_BookingStateMachineFoldOffsets[n+1])
+	for lo < hi {
+		m := int(uint(lo+hi) >> 1)
+		e := &_BookingStateMachineFoldTable[m]
+		switch c := _enumer.CompareFoldASCII(e.s, raw); {
+		case c == 0:
+			return e.v, true
+		case c < 0:
+			lo = m + 1
+		default:
+			hi = m
+		}
+	}
+	return BookingStateMachine(0), false
+}
+
+// BookingStateMachineFromString determines the enum value with an exact case match.
+func BookingStateMachineFromString(raw string) (BookingStateMachine, bool) {
+	v, ok := _BookingStateMachineStringToValueMap[raw]
+	if !ok {
+		return BookingStateMachine(0), false
+	}
+	return v, true
+}
+
+// BookingStateMachineFromStringIgnoreCase determines the enum value with a case-insensitive match
+// of the ASCII letters, regardless of the casing of raw.
+func BookingStateMachineFromStringIgnoreCase(raw string) (BookingStateMachine, bool) {
+	v, ok := BookingStateMachineFromString(raw)
+	if ok {
+		return v, ok
+	}
+	v, ok = _BookingStateMachineLookupFold(raw)
+	if !ok {
+		return BookingStateMachine(0), false
+	}
+	return v, true
+}
+
+// Set implements the flag.Value interface for BookingStateMachine.
+// It also satisfies the pflag.Value interface (github.com/spf13/pflag) along with Type.
+func (_b *BookingStateMachine) Set(value string) error {
+	v, ok := BookingStateMachineFromStringIgnoreCase(value)
+	if !ok {
+		return fmt.Errorf("%w (allowed values: %s)", _enumer.NewParseError("BookingStateMachine", value, BookingStateMachineStrings()), strings.Join(BookingStateMachineStrings(), ", "))
+	}
+	*_b = v
+	return nil
+}
+
+// Type returns the name of the value type for the pflag.Value interface (github.com/spf13/pflag).
+func (BookingStateMachine) Type() string {
+	return "BookingStateMachine"
+}
+
+// Decode implements the envconfig.Decoder interface (github.com/kelseyhightower/envconfig) for BookingStateMachine.
+func (_b *BookingStateMachine) Decode(value string) error {
+	return _b.Set(value)
+}
+
+// Value implements the sql/driver.Valuer interface for BookingStateMachine.
+func (_b BookingStateMachine) Value() (driver.Value, error) {
+	if err := _b.Validate(); err != nil {
+		return nil, fmt.Errorf("Cannot serialize value %q as BookingStateMachine. %w", _b, err)
+	}
+	return _b.String(), nil
+}
+
+// Scan implements the sql/driver.Scanner interface for BookingStateMachine.
+func (_b *BookingStateMachine) Scan(value interface{}) error {
+	var str string
+	switch v := value.(type) {
+	case []byte:
+		str = string(v)
+	case string:
+		str = v
+	case fmt.Stringer:
+		str = v.String()
+	default:
+		return fmt.Errorf("invalid value of BookingStateMachine: %[1]T(%[1]v)", value)
+	}
+	if len(str) == 0 {
+		return fmt.Errorf("BookingStateMachine cannot be derived from empty string")
+	}
+
+	var ok bool
+	*_b, ok = BookingStateMachineFromStringIgnoreCase(str)
+	if !ok {
+		return _enumer.NewParseError("BookingStateMachine", str, BookingStateMachineStrings())
+	}
+	return nil
+}
+
+// MarshalYAML implements a YAML Marshaler for BookingStateMachine.
+func (_b BookingStateMachine) MarshalYAML() (interface{}, error) {
+	if err := _b.Validate(); err != nil {
+		return nil, fmt.Errorf("Cannot marshal value %q as BookingStateMachine. %w", _b, err)
+	}
+	return _b.String(), nil
+}
+
+// UnmarshalYAML implements a YAML Unmarshaler for BookingStateMachine.
+func (_b *BookingStateMachine) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var str string
+	if err := unmarshal(&str); err != nil {
+		return err
+	}
+	if len(str) == 0 {
+		return fmt.Errorf("BookingStateMachine cannot be derived from empty string")
+	}
+
+	var ok bool
+	*_b, ok = BookingStateMachineFromStringIgnoreCase(str)
+	if !ok {
+		return _enumer.NewParseError("BookingStateMachine", str, BookingStateMachineStrings())
+	}
+	return nil
+}
+
+// Values returns a slice of all String values of the enum.
+// It implements the ent EnumValues interface. Declare the ent field e.g. as follows:
+//
+//	field.Enum("booking_state_machine").GoType(BookingStateMachine(0))
+func (BookingStateMachine) Values() []string {
+	return BookingStateMachineStrings()
+}
+
+var _BookingStateMachineCompletions = [6]string{
+	"Created\tThe booking was created successfully",
+	"Unavailable\tThe booking was not available",
+	"Failed\tThe booking failed",
+	"Canceled\tThe booking was canceled",
+	"NotFound\tThe booking was not found",
+	"Deleted\tThe booking was deleted",
+}
+
+// BookingStateMachineUsage returns a usage hint of all String values of the enum, less the deprecated values,
+// e.g. for flag descriptions.
+func BookingStateMachineUsage() string {
+	return "one of: " + strings.Join(_BookingStateMachineStrings[:], "|")
+}
+
+// BookingStateMachineCompletions returns the completion candidates of the enum matching the given prefix,
+// less the deprecated values. Each candidate is formatted as "<value>" or "<value>\t<description>",
+// as expected by shell completions, e.g. of cobra's RegisterFlagCompletionFunc.
+func BookingStateMachineCompletions(toComplete string) []string {
+	out := make([]string, 0, len(_BookingStateMachineCompletions))
+	for _, c := range _BookingStateMachineCompletions {
+		if strings.HasPrefix(c, toComplete) {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+// CanTransitionTo tests whether the enum can transition to the next value.
+func (_b BookingStateMachine) CanTransitionTo(next BookingStateMachine) bool {
+	switch _b {
+	case 0:
+		return next == 1 || next == 2 || next == 3 || next == 4
+	case 1:
+		return next == 5
+	case 2:
+		return next == 5
+	case 3:
+		return next == 5
+	case 4:
+		return next == 5
+	}
+	return false
+}
+
+// Transitions returns all values the enum can transition to.
+func (_b BookingStateMachine) Transitions() []BookingStateMachine {
+	switch _b {
+	case 0:
+		return []BookingStateMachine{1, 2, 3, 4}
+	case 1:
+		return []BookingStateMachine{5}
+	case 2:
+		return []BookingStateMachine{5}
+	case 3:
+		return []BookingStateMachine{5}
+	case 4:
+		return []BookingStateMachine{5}
+	}
+	return nil
+}
+
+// IsTerminal tests whether the enum is a valid value which cannot transition to any other value.
+func (_b BookingStateMachine) IsTerminal() bool {
+	switch _b {
+	case 0, 1, 2, 3, 4:
+		return false
+	}
+	return _b.IsValid()
+}
+
+// BookingStateMachineTransitionsDOT returns the state machine of the enum as Graphviz DOT graph.
+func BookingStateMachineTransitionsDOT() string {
+	return "digraph BookingStateMachine {\n\t\"Created\" [shape=doublecircle];\n\t\"Created\" -> \"Unavailable\";\n\t\"Created\" -> \"Failed\";\n\t\"Created\" -> \"Canceled\";\n\t\"Created\" -> \"NotFound\";\n\t\"Unavailable\" -> \"Deleted\";\n\t\"Failed\" -> \"Deleted\";\n\t\"Canceled\" -> \"Deleted\";\n\t\"NotFound\" -> \"Deleted\";\n}\n"
+}
+
+// BookingStateMachineTransitionsMermaid returns the state machine of the enum as Mermaid state diagram.
+func BookingStateMachineTransitionsMermaid() string {
+	return "stateDiagram-v2\n\tstate \"Created\" as s0\n\tstate \"Unavailable\" as s1\n\tstate \"Failed\" as s2\n\tstate \"Canceled\" as s3\n\tstate \"NotFound\" as s4\n\tstate \"Deleted\" as s5\n\t[*] --> s0\n\ts0 --> s1\n\ts0 --> s2\n\ts0 --> s3\n\ts0 --> s4\n\ts1 --> s5\n\ts2 --> s5\n\ts3 --> s5\n\ts4 --> s5\n\ts5 --> [*]\n"
+}
+
+const (
+	_BookingStateWithConfigString = "CreatedUnavailableFailedCanceledNotFoundDeleted"
 )
 
 var (
@@ -182,6 +713,11 @@ func BookingStateWithConfigStrings() []string {
 	return cp[:]
 }
 
+// Values returns all values of the enum.
+func (BookingStateWithConfig) Values() []BookingStateWithConfig {
+	return BookingStateWithConfigValues()
+}
+
 // IsValid tests whether the value is a valid enum value.
 func (_b BookingStateWithConfig) IsValid() bool {
 	return _b >= 0 && _b <= 5
@@ -206,6 +742,54 @@ func (_b BookingStateWithConfig) String() string {
 	return _BookingStateWithConfigStrings[idx]
 }
 
+// Index returns the position of the value within the ordered values of the enum
+// (ignoring alternative values) or -1 if the value is invalid.
+func (_b BookingStateWithConfig) Index() int {
+	if !_b.IsValid() {
+		return -1
+	}
+	idx := int(_b)
+	return idx
+}
+
+// Compare returns -1, 0 or +1 depending on whether the value orders before,
+// equal to or after the other value. Invalid values order before all valid values.
+func (_b BookingStateWithConfig) Compare(other BookingStateWithConfig) int {
+	a, b := _b.Index(), other.Index()
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return +1
+	}
+	return 0
+}
+
+// Less tests whether the value orders before the other value.
+func (_b BookingStateWithConfig) Less(other BookingStateWithConfig) bool {
+	return _b.Compare(other) < 0
+}
+
+// Next returns the succeeding value in order of the enum.
+// It returns false if the value is invalid or the last value.
+func (_b BookingStateWithConfig) Next() (BookingStateWithConfig, bool) {
+	idx := _b.Index()
+	if idx == -1 || idx+1 == len(_BookingStateWithConfigValues) {
+		return BookingStateWithConfig(0), false
+	}
+	return _BookingStateWithConfigValues[idx+1], true
+}
+
+// Prev returns the preceding value in order of the enum.
+// It returns false if the value is invalid or the first value.
+func (_b BookingStateWithConfig) Prev() (BookingStateWithConfig, bool) {
+	idx := _b.Index()
+	if idx < 1 {
+		return BookingStateWithConfig(0), false
+	}
+	return _BookingStateWithConfigValues[idx-1], true
+}
+
 // GetDescription returns the "description" of the enum value.
 func (_b BookingStateWithConfig) GetDescription() string {
 	if !_b.IsValid() {
@@ -225,16 +809,20 @@ var (
 		_BookingStateWithConfigString[32:40]: 4,
 		_BookingStateWithConfigString[40:47]: 5,
 	}
-	_BookingStateWithConfigLowerStringToValueMap = map[string]BookingStateWithConfig{
-		_BookingStateWithConfigLowerString[0:7]:   0,
-		_BookingStateWithConfigLowerString[7:18]:  1,
-		_BookingStateWithConfigLowerString[18:24]: 2,
-		_BookingStateWithConfigLowerString[24:32]: 3,
-		_BookingStateWithConfigLowerString[32:40]: 4,
-		_BookingStateWithConfigLowerString[40:47]: 5,
-	}
 )
 
+// _BookingStateWithConfigLookupFold determines the enum value of the string regardless of its case
+// by Unicode simple folding. It scans the String values, as a fold table is only generated
+// for enums with the "ignore-case" feature.
+func _BookingStateWithConfigLookupFold(raw string) (BookingStateWithConfig, bool) {
+	for idx := range _BookingStateWithConfigStrings {
+		if strings.EqualFold(_BookingStateWithConfigStrings[idx], raw) {
+			return _BookingStateWithConfigValues[idx], true
+		}
+	}
+	return BookingStateWithConfig(0), false
+}
+
 // BookingStateWithConfigFromString determines the enum value with an exact case match.
 func BookingStateWithConfigFromString(raw string) (BookingStateWithConfig, bool) {
 	if len(raw) == 0 {
@@ -247,7 +835,8 @@ func BookingStateWithConfigFromString(raw string) (BookingStateWithConfig, bool)
 	return v, true
 }
 
-// BookingStateWithConfigFromStringIgnoreCase determines the enum value with a case-insensitive match.
+// BookingStateWithConfigFromStringIgnoreCase determines the enum value with a case-insensitive match
+// by Unicode simple folding, regardless of the casing of raw.
 func BookingStateWithConfigFromStringIgnoreCase(raw string) (BookingStateWithConfig, bool) {
 	if len(raw) == 0 {
 		return BookingStateWithConfig(0), true
@@ -256,7 +845,7 @@ func BookingStateWithConfigFromStringIgnoreCase(raw string) (BookingStateWithCon
 	if ok {
 		return v, ok
 	}
-	v, ok = _BookingStateWithConfigLowerStringToValueMap[raw]
+	v, ok = _BookingStateWithConfigLookupFold(raw)
 	if !ok {
 		return BookingStateWithConfig(0), false
 	}
@@ -281,7 +870,7 @@ func (_b *BookingStateWithConfig) UnmarshalJSON(data []byte) error {
 	var ok bool
 	*_b, ok = BookingStateWithConfigFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a BookingStateWithConfig", str)
+		return _enumer.NewParseError("BookingStateWithConfig", str, BookingStateWithConfigStrings())
 	}
 	return nil
 }
@@ -304,14 +893,41 @@ func (_b *BookingStateWithConfig) UnmarshalYAML(unmarshal func(interface{}) erro
 	var ok bool
 	*_b, ok = BookingStateWithConfigFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a BookingStateWithConfig", str)
+		return _enumer.NewParseError("BookingStateWithConfig", str, BookingStateWithConfigStrings())
 	}
 	return nil
 }
 
+var _BookingStateWithConfigCompletions = [6]string{
+	"Created\tThe booking was created successfully",
+	"Unavailable\tThe booking was not available",
+	"Failed\tThe booking failed",
+	"Canceled\tThe booking was canceled",
+	"NotFound\tThe booking was not found",
+	"Deleted\tThe booking was deleted",
+}
+
+// BookingStateWithConfigUsage returns a usage hint of all String values of the enum, less the deprecated values,
+// e.g. for flag descriptions.
+func BookingStateWithConfigUsage() string {
+	return "one of: " + strings.Join(_BookingStateWithConfigStrings[:], "|")
+}
+
+// BookingStateWithConfigCompletions returns the completion candidates of the enum matching the given prefix,
+// less the deprecated values. Each candidate is formatted as "<value>" or "<value>\t<description>",
+// as expected by shell completions, e.g. of cobra's RegisterFlagCompletionFunc.
+func BookingStateWithConfigCompletions(toComplete string) []string {
+	out := make([]string, 0, len(_BookingStateWithConfigCompletions))
+	for _, c := range _BookingStateWithConfigCompletions {
+		if strings.HasPrefix(c, toComplete) {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
 const (
-	_BookingStateWithConstantsString      = "CreatedUnavailableFailedCanceledNotFoundDeleted"
-	_BookingStateWithConstantsLowerString = "createdunavailablefailedcancelednotfounddeleted"
+	_BookingStateWithConstantsString = "CreatedUnavailableFailedCanceledNotFoundDeleted"
 )
 
 var (
@@ -365,6 +981,54 @@ func (_b BookingStateWithConstants) String() string {
 	return _BookingStateWithConstantsStrings[idx]
 }
 
+// Index returns the position of the value within the ordered values of the enum
+// (ignoring alternative values) or -1 if the value is invalid.
+func (_b BookingStateWithConstants) Index() int {
+	if !_b.IsValid() {
+		return -1
+	}
+	idx := int(_b)
+	return idx
+}
+
+// Compare returns -1, 0 or +1 depending on whether the value orders before,
+// equal to or after the other value. Invalid values order before all valid values.
+func (_b BookingStateWithConstants) Compare(other BookingStateWithConstants) int {
+	a, b := _b.Index(), other.Index()
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return +1
+	}
+	return 0
+}
+
+// Less tests whether the value orders before the other value.
+func (_b BookingStateWithConstants) Less(other BookingStateWithConstants) bool {
+	return _b.Compare(other) < 0
+}
+
+// Next returns the succeeding value in order of the enum.
+// It returns false if the value is invalid or the last value.
+func (_b BookingStateWithConstants) Next() (BookingStateWithConstants, bool) {
+	idx := _b.Index()
+	if idx == -1 || idx+1 == len(_BookingStateWithConstantsValues) {
+		return BookingStateWithConstants(0), false
+	}
+	return _BookingStateWithConstantsValues[idx+1], true
+}
+
+// Prev returns the preceding value in order of the enum.
+// It returns false if the value is invalid or the first value.
+func (_b BookingStateWithConstants) Prev() (BookingStateWithConstants, bool) {
+	idx := _b.Index()
+	if idx < 1 {
+		return BookingStateWithConstants(0), false
+	}
+	return _BookingStateWithConstantsValues[idx-1], true
+}
+
 // GetDescription returns the "description" of the enum value.
 func (_b BookingStateWithConstants) GetDescription() string {
 	if !_b.IsValid() {
@@ -384,16 +1048,47 @@ var (
 		_BookingStateWithConstantsString[32:40]: 4,
 		_BookingStateWithConstantsString[40:47]: 5,
 	}
-	_BookingStateWithConstantsLowerStringToValueMap = map[string]BookingStateWithConstants{
-		_BookingStateWithConstantsLowerString[0:7]:   0,
-		_BookingStateWithConstantsLowerString[7:18]:  1,
-		_BookingStateWithConstantsLowerString[18:24]: 2,
-		_BookingStateWithConstantsLowerString[24:32]: 3,
-		_BookingStateWithConstantsLowerString[32:40]: 4,
-		_BookingStateWithConstantsLowerString[40:47]: 5,
+)
+
+var (
+	_BookingStateWithConstantsFoldOffsets = [13]uint16{0, 0, 0, 0, 0, 0, 0, 1, 3, 5, 5, 5, 6}
+	_BookingStateWithConstantsFoldTable   = [6]struct {
+		s string
+		v BookingStateWithConstants
+	}{
+		{_BookingStateWithConstantsString[18:24], 2},
+		{_BookingStateWithConstantsString[0:7], 0},
+		{_BookingStateWithConstantsString[40:47], 5},
+		{_BookingStateWithConstantsString[24:32], 3},
+		{_BookingStateWithConstantsString[32:40], 4},
+		{_BookingStateWithConstantsString[7:18], 1},
 	}
 )
 
+// _BookingStateWithConstantsLookupFold determines the enum value of the string regardless of the case
+// of its ASCII letters. It searches the entries of the fold table, whose strings
+// are as long as the string.
+func _BookingStateWithConstantsLookupFold(raw string) (BookingStateWithConstants, bool) {
+	n := len(raw)
+	if n+1 >= len(_BookingStateWithConstantsFoldOffsets) {
+		return BookingStateWithConstants(0), false
+	}
+	lo, hi := int(_BookingStateWithConstantsFoldOffsets[n]), int(_BookingStateWithConstantsFoldOffsets[n+1])
+	for lo < hi {
+		m := int(uint(lo+hi) >> 1)
+		e := &_BookingStateWithConstantsFoldTable[m]
+		switch c := _enumer.CompareFoldASCII(e.s, raw); {
+		case c == 0:
+			return e.v, true
+		case c < 0:
+			lo = m + 1
+		default:
+			hi = m
+		}
+	}
+	return BookingStateWithConstants(0), false
+}
+
 // BookingStateWithConstantsFromString determines the enum value with an exact case match.
 func BookingStateWithConstantsFromString(raw string) (BookingStateWithConstants, bool) {
 	v, ok := _BookingStateWithConstantsStringToValueMap[raw]
@@ -403,19 +1098,53 @@ func BookingStateWithConstantsFromString(raw string) (BookingStateWithConstants,
 	return v, true
 }
 
-// BookingStateWithConstantsFromStringIgnoreCase determines the enum value with a case-insensitive match.
+// BookingStateWithConstantsFromStringIgnoreCase determines the enum value with a case-insensitive match
+// of the ASCII letters, regardless of the casing of raw.
 func BookingStateWithConstantsFromStringIgnoreCase(raw string) (BookingStateWithConstants, bool) {
 	v, ok := BookingStateWithConstantsFromString(raw)
 	if ok {
 		return v, ok
 	}
-	v, ok = _BookingStateWithConstantsLowerStringToValueMap[raw]
+	v, ok = _BookingStateWithConstantsLookupFold(raw)
 	if !ok {
 		return BookingStateWithConstants(0), false
 	}
 	return v, true
 }
 
+// Value implements the sql/driver.Valuer interface for BookingStateWithConstants.
+func (_b BookingStateWithConstants) Value() (driver.Value, error) {
+	if err := _b.Validate(); err != nil {
+		return nil, fmt.Errorf("Cannot serialize value %q as BookingStateWithConstants. %w", _b, err)
+	}
+	return _b.String(), nil
+}
+
+// Scan implements the sql/driver.Scanner interface for BookingStateWithConstants.
+func (_b *BookingStateWithConstants) Scan(value interface{}) error {
+	var str string
+	switch v := value.(type) {
+	case []byte:
+		str = string(v)
+	case string:
+		str = v
+	case fmt.Stringer:
+		str = v.String()
+	default:
+		return fmt.Errorf("invalid value of BookingStateWithConstants: %[1]T(%[1]v)", value)
+	}
+	if len(str) == 0 {
+		return fmt.Errorf("BookingStateWithConstants cannot be derived from empty string")
+	}
+
+	var ok bool
+	*_b, ok = BookingStateWithConstantsFromStringIgnoreCase(str)
+	if !ok {
+		return _enumer.NewParseError("BookingStateWithConstants", str, BookingStateWithConstantsStrings())
+	}
+	return nil
+}
+
 // MarshalYAML implements a YAML Marshaler for BookingStateWithConstants.
 func (_b BookingStateWithConstants) MarshalYAML() (interface{}, error) {
 	if err := _b.Validate(); err != nil {
@@ -437,12 +1166,43 @@ func (_b *BookingStateWithConstants) UnmarshalYAML(unmarshal func(interface{}) e
 	var ok bool
 	*_b, ok = BookingStateWithConstantsFromStringIgnoreCase(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a BookingStateWithConstants", str)
+		return _enumer.NewParseError("BookingStateWithConstants", str, BookingStateWithConstantsStrings())
 	}
 	return nil
 }
 
 // Values returns a slice of all String values of the enum.
+// It implements the ent EnumValues interface. Declare the ent field e.g. as follows:
+//
+//	field.Enum("booking_state_with_constants").GoType(BookingStateWithConstants(0))
 func (BookingStateWithConstants) Values() []string {
 	return BookingStateWithConstantsStrings()
 }
+
+var _BookingStateWithConstantsCompletions = [6]string{
+	"Created\tThe booking was created successfully",
+	"Unavailable\tThe booking was not available",
+	"Failed\tThe booking failed",
+	"Canceled\tThe booking was canceled",
+	"NotFound\tThe booking was not found",
+	"Deleted\tThe booking was deleted",
+}
+
+// BookingStateWithConstantsUsage returns a usage hint of all String values of the enum, less the deprecated values,
+// e.g. for flag descriptions.
+func BookingStateWithConstantsUsage() string {
+	return "one of: " + strings.Join(_BookingStateWithConstantsStrings[:], "|")
+}
+
+// BookingStateWithConstantsCompletions returns the completion candidates of the enum matching the given prefix,
+// less the deprecated values. Each candidate is formatted as "<value>" or "<value>\t<description>",
+// as expected by shell completions, e.g. of cobra's RegisterFlagCompletionFunc.
+func BookingStateWithConstantsCompletions(toComplete string) []string {
+	out := make([]string, 0, len(_BookingStateWithConstantsCompletions))
+	for _, c := range _BookingStateWithConstantsCompletions {
+		if strings.HasPrefix(c, toComplete) {
+			out = append(out, c)
+		}
+	}
+	return out
+}
@@ -5,6 +5,7 @@ package colors
 import (
 	"errors"
 	"fmt"
+	"strings"
 )
 
 var (
@@ -12,8 +13,7 @@ var (
 )
 
 const (
-	_ColorString      = "BlackWhiteRedLimeBlueYellowCyanMagentaSilverGrayGreyMaroonOliveGreenPurpleTealNavy"
-	_ColorLowerString = "blackwhiteredlimeblueyellowcyanmagentasilvergraygreymaroonolivegreenpurpletealnavy"
+	_ColorString = "BlackWhiteRedLimeBlueYellowCyanMagentaSilverGrayGreyMaroonOliveGreenPurpleTealNavy"
 )
 
 var (
@@ -56,6 +56,11 @@ func ColorStrings() []string {
 	return cp[:]
 }
 
+// Values returns all values of the enum.
+func (Color) Values() []Color {
+	return ColorValues()
+}
+
 // IsValid tests whether the value is a valid enum value.
 func (_c Color) IsValid() bool {
 	return _c >= 0 && _c <= 15
@@ -80,6 +85,54 @@ func (_c Color) String() string {
 	return _ColorStrings[idx]
 }
 
+// Index returns the position of the value within the ordered values of the enum
+// (ignoring alternative values) or -1 if the value is invalid.
+func (_c Color) Index() int {
+	if !_c.IsValid() {
+		return -1
+	}
+	idx := int(_c)
+	return idx
+}
+
+// Compare returns -1, 0 or +1 depending on whether the value orders before,
+// equal to or after the other value. Invalid values order before all valid values.
+func (_c Color) Compare(other Color) int {
+	a, b := _c.Index(), other.Index()
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return +1
+	}
+	return 0
+}
+
+// Less tests whether the value orders before the other value.
+func (_c Color) Less(other Color) bool {
+	return _c.Compare(other) < 0
+}
+
+// Next returns the succeeding value in order of the enum.
+// It returns false if the value is invalid or the last value.
+func (_c Color) Next() (Color, bool) {
+	idx := _c.Index()
+	if idx == -1 || idx+1 == len(_ColorValues) {
+		return Color(0), false
+	}
+	return _ColorValues[idx+1], true
+}
+
+// Prev returns the preceding value in order of the enum.
+// It returns false if the value is invalid or the first value.
+func (_c Color) Prev() (Color, bool) {
+	idx := _c.Index()
+	if idx < 1 {
+		return Color(0), false
+	}
+	return _ColorValues[idx-1], true
+}
+
 // GetRed returns the "red" of the enum value.
 func (_c Color) GetRed() uint8 {
 	if !_c.IsValid() {
@@ -140,27 +193,23 @@ var (
 		_ColorString[74:78]: 14,
 		_ColorString[78:82]: 15,
 	}
-	_ColorLowerStringToValueMap = map[string]Color{
-		_ColorLowerString[0:5]:   0,
-		_ColorLowerString[5:10]:  1,
-		_ColorLowerString[10:13]: 2,
-		_ColorLowerString[13:17]: 3,
-		_ColorLowerString[17:21]: 4,
-		_ColorLowerString[21:27]: 5,
-		_ColorLowerString[27:31]: 6,
-		_ColorLowerString[31:38]: 7,
-		_ColorLowerString[38:44]: 8,
-		_ColorLowerString[44:48]: 9,
-		_ColorLowerString[48:52]: 9,
-		_ColorLowerString[52:58]: 10,
-		_ColorLowerString[58:63]: 11,
-		_ColorLowerString[63:68]: 12,
-		_ColorLowerString[68:74]: 13,
-		_ColorLowerString[74:78]: 14,
-		_ColorLowerString[78:82]: 15,
-	}
 )
 
+// _ColorLookupFold determines the enum value of the string regardless of its case
+// by Unicode simple folding. It scans the String values, as a fold table is only generated
+// for enums with the "ignore-case" feature.
+func _ColorLookupFold(raw string) (Color, bool) {
+	for idx := range _ColorStrings {
+		if strings.EqualFold(_ColorStrings[idx], raw) {
+			return _ColorValues[idx], true
+		}
+	}
+	if strings.EqualFold(_ColorString[48:52], raw) {
+		return 9, true
+	}
+	return Color(0), false
+}
+
 // ColorFromString determines the enum value with an exact case match.
 func ColorFromString(raw string) (Color, bool) {
 	v, ok := _ColorStringToValueMap[raw]
@@ -170,15 +219,54 @@ func ColorFromString(raw string) (Color, bool) {
 	return v, true
 }
 
-// ColorFromStringIgnoreCase determines the enum value with a case-insensitive match.
+// ColorFromStringIgnoreCase determines the enum value with a case-insensitive match
+// by Unicode simple folding, regardless of the casing of raw.
 func ColorFromStringIgnoreCase(raw string) (Color, bool) {
 	v, ok := ColorFromString(raw)
 	if ok {
 		return v, ok
 	}
-	v, ok = _ColorLowerStringToValueMap[raw]
+	v, ok = _ColorLookupFold(raw)
 	if !ok {
 		return Color(0), false
 	}
 	return v, true
 }
+
+var _ColorCompletions = [16]string{
+	"Black",
+	"White",
+	"Red",
+	"Lime",
+	"Blue",
+	"Yellow",
+	"Cyan",
+	"Magenta",
+	"Silver",
+	"Gray",
+	"Maroon",
+	"Olive",
+	"Green",
+	"Purple",
+	"Teal",
+	"Navy",
+}
+
+// ColorUsage returns a usage hint of all String values of the enum, less the deprecated values,
+// e.g. for flag descriptions.
+func ColorUsage() string {
+	return "one of: " + strings.Join(_ColorStrings[:], "|")
+}
+
+// ColorCompletions returns the completion candidates of the enum matching the given prefix,
+// less the deprecated values. Each candidate is formatted as "<value>" or "<value>\t<description>",
+// as expected by shell completions, e.g. of cobra's RegisterFlagCompletionFunc.
+func ColorCompletions(toComplete string) []string {
+	out := make([]string, 0, len(_ColorCompletions))
+	for _, c := range _ColorCompletions {
+		if strings.HasPrefix(c, toComplete) {
+			out = append(out, c)
+		}
+	}
+	return out
+}
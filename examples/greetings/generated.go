@@ -5,22 +5,23 @@ package greetings
 import (
 	"database/sql/driver"
 	"encoding/json"
-	"errors"
+	"encoding/xml"
 	"fmt"
+	"github.com/fxamacker/cbor/v2"
+	_enumer "github.com/mvrahden/go-enumer/enum"
+	"github.com/vmihailenco/msgpack/v5"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/bsontype"
 	"go.mongodb.org/mongo-driver/x/bsonx/bsoncore"
-	"io"
-	"strconv"
+	"strings"
 )
 
 var (
-	ErrNoValidEnum = errors.New("not a valid enum")
+	ErrNoValidEnum = _enumer.ErrNoValidEnum
 )
 
 const (
-	_GreetingString      = "Россия中國日本한국ČeskáRepublika𝜋"
-	_GreetingLowerString = "россия中國日本한국českárepublika𝜋"
+	_GreetingString = "Россия中國日本한국ČeskáRepublika𝜋"
 )
 
 var (
@@ -80,6 +81,54 @@ func (_g Greeting) String() string {
 	return _GreetingStrings[idx]
 }
 
+// Index returns the position of the value within the ordered values of the enum
+// (ignoring alternative values) or -1 if the value is invalid.
+func (_g Greeting) Index() int {
+	if !_g.IsValid() || _g == 0 {
+		return -1
+	}
+	idx := int(_g) - 1
+	return idx
+}
+
+// Compare returns -1, 0 or +1 depending on whether the value orders before,
+// equal to or after the other value. Invalid values order before all valid values.
+func (_g Greeting) Compare(other Greeting) int {
+	a, b := _g.Index(), other.Index()
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return +1
+	}
+	return 0
+}
+
+// Less tests whether the value orders before the other value.
+func (_g Greeting) Less(other Greeting) bool {
+	return _g.Compare(other) < 0
+}
+
+// Next returns the succeeding value in order of the enum.
+// It returns false if the value is invalid or the last value.
+func (_g Greeting) Next() (Greeting, bool) {
+	idx := _g.Index()
+	if idx == -1 || idx+1 == len(_GreetingValues) {
+		return Greeting(0), false
+	}
+	return _GreetingValues[idx+1], true
+}
+
+// Prev returns the preceding value in order of the enum.
+// It returns false if the value is invalid or the first value.
+func (_g Greeting) Prev() (Greeting, bool) {
+	idx := _g.Index()
+	if idx < 1 {
+		return Greeting(0), false
+	}
+	return _GreetingValues[idx-1], true
+}
+
 var (
 	_GreetingStringToValueMap = map[string]Greeting{
 		_GreetingString[0:12]:  GreetingРоссия,
@@ -89,16 +138,20 @@ var (
 		_GreetingString[30:46]: GreetingČeskáRepublika,
 		_GreetingString[46:50]: Greeting𝜋,
 	}
-	_GreetingLowerStringToValueMap = map[string]Greeting{
-		_GreetingLowerString[0:12]:  GreetingРоссия,
-		_GreetingLowerString[12:18]: Greeting中國,
-		_GreetingLowerString[18:24]: Greeting日本,
-		_GreetingLowerString[24:30]: Greeting한국,
-		_GreetingLowerString[30:46]: GreetingČeskáRepublika,
-		_GreetingLowerString[46:50]: Greeting𝜋,
-	}
 )
 
+// _GreetingLookupFold determines the enum value of the string regardless of its case
+// by Unicode simple folding. It scans the String values, as a fold table is only generated
+// for enums with the "ignore-case" feature.
+func _GreetingLookupFold(raw string) (Greeting, bool) {
+	for idx := range _GreetingStrings {
+		if strings.EqualFold(_GreetingStrings[idx], raw) {
+			return _GreetingValues[idx], true
+		}
+	}
+	return Greeting(0), false
+}
+
 // GreetingFromString determines the enum value with an exact case match.
 func GreetingFromString(raw string) (Greeting, bool) {
 	if len(raw) == 0 {
@@ -111,7 +164,8 @@ func GreetingFromString(raw string) (Greeting, bool) {
 	return v, true
 }
 
-// GreetingFromStringIgnoreCase determines the enum value with a case-insensitive match.
+// GreetingFromStringIgnoreCase determines the enum value with a case-insensitive match
+// by Unicode simple folding, regardless of the casing of raw.
 func GreetingFromStringIgnoreCase(raw string) (Greeting, bool) {
 	if len(raw) == 0 {
 		return Greeting(0), true
@@ -120,7 +174,7 @@ func GreetingFromStringIgnoreCase(raw string) (Greeting, bool) {
 	if ok {
 		return v, ok
 	}
-	v, ok = _GreetingLowerStringToValueMap[raw]
+	v, ok = _GreetingLookupFold(raw)
 	if !ok {
 		return Greeting(0), false
 	}
@@ -129,10 +183,15 @@ func GreetingFromStringIgnoreCase(raw string) (Greeting, bool) {
 
 // MarshalBinary implements the encoding.BinaryMarshaler interface for Greeting.
 func (_g Greeting) MarshalBinary() ([]byte, error) {
+	return _g.AppendBinary(nil)
+}
+
+// AppendBinary implements the encoding.BinaryAppender interface for Greeting.
+func (_g Greeting) AppendBinary(b []byte) ([]byte, error) {
 	if err := _g.Validate(); err != nil {
-		return nil, fmt.Errorf("Cannot marshal value %q as Greeting. %w", _g, err)
+		return b, fmt.Errorf("Cannot marshal value %q as Greeting. %w", _g, err)
 	}
-	return []byte(_g.String()), nil
+	return append(b, _g.String()...), nil
 }
 
 // UnmarshalBinary implements the encoding.BinaryUnmarshaler interface for Greeting.
@@ -142,7 +201,7 @@ func (_g *Greeting) UnmarshalBinary(text []byte) error {
 	var ok bool
 	*_g, ok = GreetingFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a Greeting", str)
+		return _enumer.NewParseError("Greeting", str, GreetingStrings())
 	}
 	return nil
 }
@@ -170,35 +229,30 @@ func (_g *Greeting) UnmarshalBSONValue(t bsontype.Type, data []byte) error {
 
 	*_g, ok = GreetingFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a Greeting", str)
+		return _enumer.NewParseError("Greeting", str, GreetingStrings())
 	}
 	return nil
 }
 
-// MarshalGQL implements the graphql.Marshaler interface for Greeting.
-func (_g Greeting) MarshalGQL(w io.Writer) {
-	fmt.Fprint(w, strconv.Quote(_g.String()))
+// MarshalCBOR implements the cbor.Marshaler interface for Greeting.
+func (_g Greeting) MarshalCBOR() ([]byte, error) {
+	if err := _g.Validate(); err != nil {
+		return nil, fmt.Errorf("Cannot marshal value %q as Greeting. %w", _g, err)
+	}
+	return cbor.Marshal(_g.String())
 }
 
-// UnmarshalGQL implements the graphql.Unmarshaler interface for Greeting.
-func (_g *Greeting) UnmarshalGQL(value interface{}) error {
+// UnmarshalCBOR implements the cbor.Unmarshaler interface for Greeting.
+func (_g *Greeting) UnmarshalCBOR(data []byte) error {
 	var str string
-	switch v := value.(type) {
-	case nil:
-	case []byte:
-		str = string(v)
-	case string:
-		str = v
-	case fmt.Stringer:
-		str = v.String()
-	default:
-		return fmt.Errorf("invalid value of Greeting: %[1]T(%[1]v)", value)
+	if err := cbor.Unmarshal(data, &str); err != nil {
+		return fmt.Errorf("Greeting should be a string, got %q", data)
 	}
 
 	var ok bool
 	*_g, ok = GreetingFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a Greeting", str)
+		return _enumer.NewParseError("Greeting", str, GreetingStrings())
 	}
 	return nil
 }
@@ -221,7 +275,30 @@ func (_g *Greeting) UnmarshalJSON(data []byte) error {
 	var ok bool
 	*_g, ok = GreetingFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a Greeting", str)
+		return _enumer.NewParseError("Greeting", str, GreetingStrings())
+	}
+	return nil
+}
+
+// MarshalMsgpack implements the msgpack.Marshaler interface for Greeting.
+func (_g Greeting) MarshalMsgpack() ([]byte, error) {
+	if err := _g.Validate(); err != nil {
+		return nil, fmt.Errorf("Cannot marshal value %q as Greeting. %w", _g, err)
+	}
+	return msgpack.Marshal(_g.String())
+}
+
+// UnmarshalMsgpack implements the msgpack.Unmarshaler interface for Greeting.
+func (_g *Greeting) UnmarshalMsgpack(data []byte) error {
+	var str string
+	if err := msgpack.Unmarshal(data, &str); err != nil {
+		return fmt.Errorf("Greeting should be a string, got %q", data)
+	}
+
+	var ok bool
+	*_g, ok = GreetingFromString(str)
+	if !ok {
+		return _enumer.NewParseError("Greeting", str, GreetingStrings())
 	}
 	return nil
 }
@@ -255,17 +332,22 @@ func (_g *Greeting) Scan(value interface{}) error {
 	var ok bool
 	*_g, ok = GreetingFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a Greeting", str)
+		return _enumer.NewParseError("Greeting", str, GreetingStrings())
 	}
 	return nil
 }
 
 // MarshalText implements the encoding.TextMarshaler interface for Greeting.
 func (_g Greeting) MarshalText() ([]byte, error) {
+	return _g.AppendText(nil)
+}
+
+// AppendText implements the encoding.TextAppender interface for Greeting.
+func (_g Greeting) AppendText(b []byte) ([]byte, error) {
 	if err := _g.Validate(); err != nil {
-		return nil, fmt.Errorf("Cannot marshal value %q as Greeting. %w", _g, err)
+		return b, fmt.Errorf("Cannot marshal value %q as Greeting. %w", _g, err)
 	}
-	return []byte(_g.String()), nil
+	return append(b, _g.String()...), nil
 }
 
 // UnmarshalText implements the encoding.TextUnmarshaler interface for Greeting.
@@ -275,7 +357,53 @@ func (_g *Greeting) UnmarshalText(text []byte) error {
 	var ok bool
 	*_g, ok = GreetingFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a Greeting", str)
+		return _enumer.NewParseError("Greeting", str, GreetingStrings())
+	}
+	return nil
+}
+
+// MarshalXML implements the xml.Marshaler interface for Greeting.
+func (_g Greeting) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if err := _g.Validate(); err != nil {
+		return fmt.Errorf("Cannot marshal value %q as Greeting. %w", _g, err)
+	}
+	return e.EncodeElement(_g.String(), start)
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface for Greeting.
+func (_g *Greeting) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var str string
+	if err := d.DecodeElement(&str, &start); err != nil {
+		return err
+	}
+
+	var ok bool
+	*_g, ok = GreetingFromString(str)
+	if !ok {
+		return _enumer.NewParseError("Greeting", str, GreetingStrings())
+	}
+	return nil
+}
+
+// MarshalXMLAttr implements the xml.MarshalerAttr interface for Greeting.
+func (_g Greeting) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	if err := _g.Validate(); err != nil {
+		return xml.Attr{}, fmt.Errorf("Cannot marshal value %q as Greeting. %w", _g, err)
+	}
+	if _g == 0 {
+		return xml.Attr{}, nil // an empty name omits the attribute
+	}
+	return xml.Attr{Name: name, Value: _g.String()}, nil
+}
+
+// UnmarshalXMLAttr implements the xml.UnmarshalerAttr interface for Greeting.
+func (_g *Greeting) UnmarshalXMLAttr(attr xml.Attr) error {
+	str := attr.Value
+
+	var ok bool
+	*_g, ok = GreetingFromString(str)
+	if !ok {
+		return _enumer.NewParseError("Greeting", str, GreetingStrings())
 	}
 	return nil
 }
@@ -298,19 +426,49 @@ func (_g *Greeting) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	var ok bool
 	*_g, ok = GreetingFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a Greeting", str)
+		return _enumer.NewParseError("Greeting", str, GreetingStrings())
 	}
 	return nil
 }
 
 // Values returns a slice of all String values of the enum.
+// It implements the ent EnumValues interface. Declare the ent field e.g. as follows:
+//
+//	field.Enum("greeting").GoType(Greeting(0))
 func (Greeting) Values() []string {
 	return GreetingStrings()
 }
 
+var _GreetingCompletions = [6]string{
+	"Россия",
+	"中國",
+	"日本",
+	"한국",
+	"ČeskáRepublika",
+	"𝜋",
+}
+
+// GreetingUsage returns a usage hint of all String values of the enum, less the deprecated values,
+// e.g. for flag descriptions.
+func GreetingUsage() string {
+	return "one of: " + strings.Join(_GreetingStrings[:], "|")
+}
+
+// GreetingCompletions returns the completion candidates of the enum matching the given prefix,
+// less the deprecated values. Each candidate is formatted as "<value>" or "<value>\t<description>",
+// as expected by shell completions, e.g. of cobra's RegisterFlagCompletionFunc.
+func GreetingCompletions(toComplete string) []string {
+	out := make([]string, 0, len(_GreetingCompletions))
+	for _, c := range _GreetingCompletions {
+		if strings.HasPrefix(c, toComplete) {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
 const (
-	_GreetingWithDefaultString      = "WorldРоссия中國日本한국ČeskáRepublika𝜋"
-	_GreetingWithDefaultLowerString = "worldроссия中國日本한국českárepublika𝜋"
+	_GreetingWithDefaultString = "WorldРоссия中國日本한국ČeskáRepublika𝜋"
 )
 
 var (
@@ -368,6 +526,54 @@ func (_g GreetingWithDefault) String() string {
 	return _GreetingWithDefaultStrings[idx]
 }
 
+// Index returns the position of the value within the ordered values of the enum
+// (ignoring alternative values) or -1 if the value is invalid.
+func (_g GreetingWithDefault) Index() int {
+	if !_g.IsValid() {
+		return -1
+	}
+	idx := int(_g)
+	return idx
+}
+
+// Compare returns -1, 0 or +1 depending on whether the value orders before,
+// equal to or after the other value. Invalid values order before all valid values.
+func (_g GreetingWithDefault) Compare(other GreetingWithDefault) int {
+	a, b := _g.Index(), other.Index()
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return +1
+	}
+	return 0
+}
+
+// Less tests whether the value orders before the other value.
+func (_g GreetingWithDefault) Less(other GreetingWithDefault) bool {
+	return _g.Compare(other) < 0
+}
+
+// Next returns the succeeding value in order of the enum.
+// It returns false if the value is invalid or the last value.
+func (_g GreetingWithDefault) Next() (GreetingWithDefault, bool) {
+	idx := _g.Index()
+	if idx == -1 || idx+1 == len(_GreetingWithDefaultValues) {
+		return GreetingWithDefault(0), false
+	}
+	return _GreetingWithDefaultValues[idx+1], true
+}
+
+// Prev returns the preceding value in order of the enum.
+// It returns false if the value is invalid or the first value.
+func (_g GreetingWithDefault) Prev() (GreetingWithDefault, bool) {
+	idx := _g.Index()
+	if idx < 1 {
+		return GreetingWithDefault(0), false
+	}
+	return _GreetingWithDefaultValues[idx-1], true
+}
+
 var (
 	_GreetingWithDefaultStringToValueMap = map[string]GreetingWithDefault{
 		_GreetingWithDefaultString[0:5]:   GreetingWithDefaultWorld,
@@ -378,17 +584,20 @@ var (
 		_GreetingWithDefaultString[35:51]: GreetingWithDefaultČeskáRepublika,
 		_GreetingWithDefaultString[51:55]: GreetingWithDefault𝜋,
 	}
-	_GreetingWithDefaultLowerStringToValueMap = map[string]GreetingWithDefault{
-		_GreetingWithDefaultLowerString[0:5]:   GreetingWithDefaultWorld,
-		_GreetingWithDefaultLowerString[5:17]:  GreetingWithDefaultРоссия,
-		_GreetingWithDefaultLowerString[17:23]: GreetingWithDefault中國,
-		_GreetingWithDefaultLowerString[23:29]: GreetingWithDefault日本,
-		_GreetingWithDefaultLowerString[29:35]: GreetingWithDefault한국,
-		_GreetingWithDefaultLowerString[35:51]: GreetingWithDefaultČeskáRepublika,
-		_GreetingWithDefaultLowerString[51:55]: GreetingWithDefault𝜋,
-	}
 )
 
+// _GreetingWithDefaultLookupFold determines the enum value of the string regardless of its case
+// by Unicode simple folding. It scans the String values, as a fold table is only generated
+// for enums with the "ignore-case" feature.
+func _GreetingWithDefaultLookupFold(raw string) (GreetingWithDefault, bool) {
+	for idx := range _GreetingWithDefaultStrings {
+		if strings.EqualFold(_GreetingWithDefaultStrings[idx], raw) {
+			return _GreetingWithDefaultValues[idx], true
+		}
+	}
+	return GreetingWithDefault(0), false
+}
+
 // GreetingWithDefaultFromString determines the enum value with an exact case match.
 func GreetingWithDefaultFromString(raw string) (GreetingWithDefault, bool) {
 	if len(raw) == 0 {
@@ -401,7 +610,8 @@ func GreetingWithDefaultFromString(raw string) (GreetingWithDefault, bool) {
 	return v, true
 }
 
-// GreetingWithDefaultFromStringIgnoreCase determines the enum value with a case-insensitive match.
+// GreetingWithDefaultFromStringIgnoreCase determines the enum value with a case-insensitive match
+// by Unicode simple folding, regardless of the casing of raw.
 func GreetingWithDefaultFromStringIgnoreCase(raw string) (GreetingWithDefault, bool) {
 	if len(raw) == 0 {
 		return GreetingWithDefault(0), true
@@ -410,7 +620,7 @@ func GreetingWithDefaultFromStringIgnoreCase(raw string) (GreetingWithDefault, b
 	if ok {
 		return v, ok
 	}
-	v, ok = _GreetingWithDefaultLowerStringToValueMap[raw]
+	v, ok = _GreetingWithDefaultLookupFold(raw)
 	if !ok {
 		return GreetingWithDefault(0), false
 	}
@@ -419,10 +629,15 @@ func GreetingWithDefaultFromStringIgnoreCase(raw string) (GreetingWithDefault, b
 
 // MarshalBinary implements the encoding.BinaryMarshaler interface for GreetingWithDefault.
 func (_g GreetingWithDefault) MarshalBinary() ([]byte, error) {
+	return _g.AppendBinary(nil)
+}
+
+// AppendBinary implements the encoding.BinaryAppender interface for GreetingWithDefault.
+func (_g GreetingWithDefault) AppendBinary(b []byte) ([]byte, error) {
 	if err := _g.Validate(); err != nil {
-		return nil, fmt.Errorf("Cannot marshal value %q as GreetingWithDefault. %w", _g, err)
+		return b, fmt.Errorf("Cannot marshal value %q as GreetingWithDefault. %w", _g, err)
 	}
-	return []byte(_g.String()), nil
+	return append(b, _g.String()...), nil
 }
 
 // UnmarshalBinary implements the encoding.BinaryUnmarshaler interface for GreetingWithDefault.
@@ -432,7 +647,7 @@ func (_g *GreetingWithDefault) UnmarshalBinary(text []byte) error {
 	var ok bool
 	*_g, ok = GreetingWithDefaultFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a GreetingWithDefault", str)
+		return _enumer.NewParseError("GreetingWithDefault", str, GreetingWithDefaultStrings())
 	}
 	return nil
 }
@@ -457,35 +672,30 @@ func (_g *GreetingWithDefault) UnmarshalBSONValue(t bsontype.Type, data []byte)
 
 	*_g, ok = GreetingWithDefaultFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a GreetingWithDefault", str)
+		return _enumer.NewParseError("GreetingWithDefault", str, GreetingWithDefaultStrings())
 	}
 	return nil
 }
 
-// MarshalGQL implements the graphql.Marshaler interface for GreetingWithDefault.
-func (_g GreetingWithDefault) MarshalGQL(w io.Writer) {
-	fmt.Fprint(w, strconv.Quote(_g.String()))
+// MarshalCBOR implements the cbor.Marshaler interface for GreetingWithDefault.
+func (_g GreetingWithDefault) MarshalCBOR() ([]byte, error) {
+	if err := _g.Validate(); err != nil {
+		return nil, fmt.Errorf("Cannot marshal value %q as GreetingWithDefault. %w", _g, err)
+	}
+	return cbor.Marshal(_g.String())
 }
 
-// UnmarshalGQL implements the graphql.Unmarshaler interface for GreetingWithDefault.
-func (_g *GreetingWithDefault) UnmarshalGQL(value interface{}) error {
+// UnmarshalCBOR implements the cbor.Unmarshaler interface for GreetingWithDefault.
+func (_g *GreetingWithDefault) UnmarshalCBOR(data []byte) error {
 	var str string
-	switch v := value.(type) {
-	case nil:
-	case []byte:
-		str = string(v)
-	case string:
-		str = v
-	case fmt.Stringer:
-		str = v.String()
-	default:
-		return fmt.Errorf("invalid value of GreetingWithDefault: %[1]T(%[1]v)", value)
+	if err := cbor.Unmarshal(data, &str); err != nil {
+		return fmt.Errorf("GreetingWithDefault should be a string, got %q", data)
 	}
 
 	var ok bool
 	*_g, ok = GreetingWithDefaultFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a GreetingWithDefault", str)
+		return _enumer.NewParseError("GreetingWithDefault", str, GreetingWithDefaultStrings())
 	}
 	return nil
 }
@@ -508,7 +718,30 @@ func (_g *GreetingWithDefault) UnmarshalJSON(data []byte) error {
 	var ok bool
 	*_g, ok = GreetingWithDefaultFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a GreetingWithDefault", str)
+		return _enumer.NewParseError("GreetingWithDefault", str, GreetingWithDefaultStrings())
+	}
+	return nil
+}
+
+// MarshalMsgpack implements the msgpack.Marshaler interface for GreetingWithDefault.
+func (_g GreetingWithDefault) MarshalMsgpack() ([]byte, error) {
+	if err := _g.Validate(); err != nil {
+		return nil, fmt.Errorf("Cannot marshal value %q as GreetingWithDefault. %w", _g, err)
+	}
+	return msgpack.Marshal(_g.String())
+}
+
+// UnmarshalMsgpack implements the msgpack.Unmarshaler interface for GreetingWithDefault.
+func (_g *GreetingWithDefault) UnmarshalMsgpack(data []byte) error {
+	var str string
+	if err := msgpack.Unmarshal(data, &str); err != nil {
+		return fmt.Errorf("GreetingWithDefault should be a string, got %q", data)
+	}
+
+	var ok bool
+	*_g, ok = GreetingWithDefaultFromString(str)
+	if !ok {
+		return _enumer.NewParseError("GreetingWithDefault", str, GreetingWithDefaultStrings())
 	}
 	return nil
 }
@@ -539,17 +772,22 @@ func (_g *GreetingWithDefault) Scan(value interface{}) error {
 	var ok bool
 	*_g, ok = GreetingWithDefaultFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a GreetingWithDefault", str)
+		return _enumer.NewParseError("GreetingWithDefault", str, GreetingWithDefaultStrings())
 	}
 	return nil
 }
 
 // MarshalText implements the encoding.TextMarshaler interface for GreetingWithDefault.
 func (_g GreetingWithDefault) MarshalText() ([]byte, error) {
+	return _g.AppendText(nil)
+}
+
+// AppendText implements the encoding.TextAppender interface for GreetingWithDefault.
+func (_g GreetingWithDefault) AppendText(b []byte) ([]byte, error) {
 	if err := _g.Validate(); err != nil {
-		return nil, fmt.Errorf("Cannot marshal value %q as GreetingWithDefault. %w", _g, err)
+		return b, fmt.Errorf("Cannot marshal value %q as GreetingWithDefault. %w", _g, err)
 	}
-	return []byte(_g.String()), nil
+	return append(b, _g.String()...), nil
 }
 
 // UnmarshalText implements the encoding.TextUnmarshaler interface for GreetingWithDefault.
@@ -559,7 +797,50 @@ func (_g *GreetingWithDefault) UnmarshalText(text []byte) error {
 	var ok bool
 	*_g, ok = GreetingWithDefaultFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a GreetingWithDefault", str)
+		return _enumer.NewParseError("GreetingWithDefault", str, GreetingWithDefaultStrings())
+	}
+	return nil
+}
+
+// MarshalXML implements the xml.Marshaler interface for GreetingWithDefault.
+func (_g GreetingWithDefault) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if err := _g.Validate(); err != nil {
+		return fmt.Errorf("Cannot marshal value %q as GreetingWithDefault. %w", _g, err)
+	}
+	return e.EncodeElement(_g.String(), start)
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface for GreetingWithDefault.
+func (_g *GreetingWithDefault) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var str string
+	if err := d.DecodeElement(&str, &start); err != nil {
+		return err
+	}
+
+	var ok bool
+	*_g, ok = GreetingWithDefaultFromString(str)
+	if !ok {
+		return _enumer.NewParseError("GreetingWithDefault", str, GreetingWithDefaultStrings())
+	}
+	return nil
+}
+
+// MarshalXMLAttr implements the xml.MarshalerAttr interface for GreetingWithDefault.
+func (_g GreetingWithDefault) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	if err := _g.Validate(); err != nil {
+		return xml.Attr{}, fmt.Errorf("Cannot marshal value %q as GreetingWithDefault. %w", _g, err)
+	}
+	return xml.Attr{Name: name, Value: _g.String()}, nil
+}
+
+// UnmarshalXMLAttr implements the xml.UnmarshalerAttr interface for GreetingWithDefault.
+func (_g *GreetingWithDefault) UnmarshalXMLAttr(attr xml.Attr) error {
+	str := attr.Value
+
+	var ok bool
+	*_g, ok = GreetingWithDefaultFromString(str)
+	if !ok {
+		return _enumer.NewParseError("GreetingWithDefault", str, GreetingWithDefaultStrings())
 	}
 	return nil
 }
@@ -582,12 +863,44 @@ func (_g *GreetingWithDefault) UnmarshalYAML(unmarshal func(interface{}) error)
 	var ok bool
 	*_g, ok = GreetingWithDefaultFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a GreetingWithDefault", str)
+		return _enumer.NewParseError("GreetingWithDefault", str, GreetingWithDefaultStrings())
 	}
 	return nil
 }
 
 // Values returns a slice of all String values of the enum.
+// It implements the ent EnumValues interface. Declare the ent field e.g. as follows:
+//
+//	field.Enum("greeting_with_default").GoType(GreetingWithDefault(0))
 func (GreetingWithDefault) Values() []string {
 	return GreetingWithDefaultStrings()
 }
+
+var _GreetingWithDefaultCompletions = [7]string{
+	"World",
+	"Россия",
+	"中國",
+	"日本",
+	"한국",
+	"ČeskáRepublika",
+	"𝜋",
+}
+
+// GreetingWithDefaultUsage returns a usage hint of all String values of the enum, less the deprecated values,
+// e.g. for flag descriptions.
+func GreetingWithDefaultUsage() string {
+	return "one of: " + strings.Join(_GreetingWithDefaultStrings[:], "|")
+}
+
+// GreetingWithDefaultCompletions returns the completion candidates of the enum matching the given prefix,
+// less the deprecated values. Each candidate is formatted as "<value>" or "<value>\t<description>",
+// as expected by shell completions, e.g. of cobra's RegisterFlagCompletionFunc.
+func GreetingWithDefaultCompletions(toComplete string) []string {
+	out := make([]string, 0, len(_GreetingWithDefaultCompletions))
+	for _, c := range _GreetingWithDefaultCompletions {
+		if strings.HasPrefix(c, toComplete) {
+			out = append(out, c)
+		}
+	}
+	return out
+}
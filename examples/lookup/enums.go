@@ -0,0 +1,75 @@
+// Package lookup declares the same enums with each of the lookup strategies
+// of the FromString functions and with each case folding of the FromStringIgnoreCase
+// functions, in order to compare them by benchmarks.
+// The sizes of the enums range from 4 to 424 values.
+package lookup
+
+// HTTPMethodMap represents a set of 4 HTTP request methods looked up by a map.
+//go:enum -from=enums/http-methods.csv -lookup=map
+type HTTPMethodMap uint
+
+// HTTPMethodSwitch represents a set of 4 HTTP request methods looked up by a switch.
+//go:enum -from=enums/http-methods.csv -lookup=switch
+type HTTPMethodSwitch uint
+
+// HTTPMethodPerfectHash represents a set of 4 HTTP request methods looked up by a minimal perfect hash.
+//go:enum -from=enums/http-methods.csv -lookup=perfect-hash
+type HTTPMethodPerfectHash uint
+
+// PlanetMap represents a set of 8 planets looked up by a map.
+//go:enum -from=enums/planets.csv -lookup=map
+type PlanetMap uint
+
+// PlanetSwitch represents a set of 8 planets looked up by a switch.
+//go:enum -from=enums/planets.csv -lookup=switch
+type PlanetSwitch uint
+
+// PlanetPerfectHash represents a set of 8 planets looked up by a minimal perfect hash.
+//go:enum -from=enums/planets.csv -lookup=perfect-hash
+type PlanetPerfectHash uint
+
+// ColorMap represents a set of 16 colors (and an alternative value) looked up by a map.
+//go:enum -from=enums/colors.csv -lookup=map
+type ColorMap uint
+
+// ColorSwitch represents a set of 16 colors (and an alternative value) looked up by a switch.
+//go:enum -from=enums/colors.csv -lookup=switch
+type ColorSwitch uint
+
+// ColorPerfectHash represents a set of 16 colors (and an alternative value) looked up by a minimal perfect hash.
+//go:enum -from=enums/colors.csv -lookup=perfect-hash
+type ColorPerfectHash uint
+
+// CountryCodeMap represents a set of 240 3-letter codes of countries looked up by a map.
+//go:enum -from=enums/countries.csv -lookup=map
+type CountryCodeMap uint
+
+// CountryCodeSwitch represents a set of 240 3-letter codes of countries looked up by a switch.
+//go:enum -from=enums/countries.csv -lookup=switch
+type CountryCodeSwitch uint
+
+// CountryCodePerfectHash represents a set of 240 3-letter codes of countries looked up by a minimal perfect hash.
+//go:enum -from=enums/countries.csv -lookup=perfect-hash
+type CountryCodePerfectHash uint
+
+// TimezoneMap represents a set of 424 timezones looked up by a map.
+//go:enum -from=enums/timezones.csv -lookup=map
+type TimezoneMap uint
+
+// TimezoneSwitch represents a set of 424 timezones looked up by a switch.
+//go:enum -from=enums/timezones.csv -lookup=switch
+type TimezoneSwitch uint
+
+// TimezonePerfectHash represents a set of 424 timezones looked up by a minimal perfect hash.
+//go:enum -from=enums/timezones.csv -lookup=perfect-hash
+type TimezonePerfectHash uint
+
+// ColorUnicodeFold represents a set of 16 colors (and an alternative value),
+// which ignores the case by Unicode simple folding.
+//go:enum -from=enums/colors.csv -support=ignore-case,unicode-fold
+type ColorUnicodeFold uint
+
+// TimezoneUnicodeFold represents a set of 424 timezones,
+// which ignores the case by Unicode simple folding.
+//go:enum -from=enums/timezones.csv -support=ignore-case,unicode-fold
+type TimezoneUnicodeFold uint
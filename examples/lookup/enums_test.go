@@ -0,0 +1,237 @@
+package lookup
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// lookup is the pair of FromString functions of an enum.
+type lookup struct {
+	strategy         string
+	fromString       func(raw string) (uint, bool)
+	fromStringIgnore func(raw string) (uint, bool)
+}
+
+func lookupOf[T ~uint](strategy string, fromString, fromStringIgnoreCase func(raw string) (T, bool)) lookup {
+	return lookup{
+		strategy: strategy,
+		fromString: func(raw string) (uint, bool) {
+			v, ok := fromString(raw)
+			return uint(v), ok
+		},
+		fromStringIgnore: func(raw string) (uint, bool) {
+			v, ok := fromStringIgnoreCase(raw)
+			return uint(v), ok
+		},
+	}
+}
+
+var enums = []struct {
+	name    string
+	strings []string
+	lookups []lookup
+}{
+	{"HTTPMethod", HTTPMethodMapStrings(), []lookup{
+		lookupOf("map", HTTPMethodMapFromString, HTTPMethodMapFromStringIgnoreCase),
+		lookupOf("switch", HTTPMethodSwitchFromString, HTTPMethodSwitchFromStringIgnoreCase),
+		lookupOf("perfect-hash", HTTPMethodPerfectHashFromString, HTTPMethodPerfectHashFromStringIgnoreCase),
+	}},
+	{"Planet", PlanetMapStrings(), []lookup{
+		lookupOf("map", PlanetMapFromString, PlanetMapFromStringIgnoreCase),
+		lookupOf("switch", PlanetSwitchFromString, PlanetSwitchFromStringIgnoreCase),
+		lookupOf("perfect-hash", PlanetPerfectHashFromString, PlanetPerfectHashFromStringIgnoreCase),
+	}},
+	{"Color", ColorMapStrings(), []lookup{
+		lookupOf("map", ColorMapFromString, ColorMapFromStringIgnoreCase),
+		lookupOf("switch", ColorSwitchFromString, ColorSwitchFromStringIgnoreCase),
+		lookupOf("perfect-hash", ColorPerfectHashFromString, ColorPerfectHashFromStringIgnoreCase),
+	}},
+	{"CountryCode", CountryCodeMapStrings(), []lookup{
+		lookupOf("map", CountryCodeMapFromString, CountryCodeMapFromStringIgnoreCase),
+		lookupOf("switch", CountryCodeSwitchFromString, CountryCodeSwitchFromStringIgnoreCase),
+		lookupOf("perfect-hash", CountryCodePerfectHashFromString, CountryCodePerfectHashFromStringIgnoreCase),
+	}},
+	{"Timezone", TimezoneMapStrings(), []lookup{
+		lookupOf("map", TimezoneMapFromString, TimezoneMapFromStringIgnoreCase),
+		lookupOf("switch", TimezoneSwitchFromString, TimezoneSwitchFromStringIgnoreCase),
+		lookupOf("perfect-hash", TimezonePerfectHashFromString, TimezonePerfectHashFromStringIgnoreCase),
+	}},
+}
+
+// inputs returns copies of the strings, which do not share the memory of the
+// generated string constants, as it is the case for deserialized strings.
+func inputs(strs []string, fn func(s string) string) []string {
+	out := make([]string, len(strs))
+	for i, s := range strs {
+		out[i] = string([]byte(fn(s)))
+	}
+	return out
+}
+
+func identity(s string) string { return s }
+
+// mixedCase returns the string with its ASCII letters in alternating case.
+func mixedCase(s string) string {
+	b := []byte(s)
+	for i, c := range b {
+		if 'A' <= c && c <= 'Z' || 'a' <= c && c <= 'z' {
+			b[i] = c | 0x20
+			if i%2 == 1 {
+				b[i] = c &^ 0x20
+			}
+		}
+	}
+	return string(b)
+}
+
+func unknown(s string) string { return s + "?" }
+
+func TestLookupStrategies(t *testing.T) {
+	for _, e := range enums {
+		t.Run(e.name, func(t *testing.T) {
+			// hint: the map lookup serves as reference of the other strategies
+			reference := e.lookups[0]
+			for _, l := range e.lookups[1:] {
+				t.Run(l.strategy, func(t *testing.T) {
+					for _, in := range [][]string{
+						inputs(e.strings, identity),
+						inputs(e.strings, strings.ToLower),
+						inputs(e.strings, strings.ToUpper),
+						inputs(e.strings, mixedCase),
+						inputs(e.strings, unknown),
+						{"", "x", strings.Repeat("x", 64)},
+					} {
+						for _, s := range in {
+							expected, expectedOk := reference.fromString(s)
+							actual, ok := l.fromString(s)
+							require.Equal(t, expectedOk, ok, s)
+							require.Equal(t, expected, actual, s)
+
+							expected, expectedOk = reference.fromStringIgnore(s)
+							actual, ok = l.fromStringIgnore(s)
+							require.Equal(t, expectedOk, ok, s)
+							require.Equal(t, expected, actual, s)
+						}
+					}
+				})
+			}
+		})
+	}
+}
+
+func TestFromStringIgnoreCase(t *testing.T) {
+	t.Run("matches any casing", func(t *testing.T) {
+		for _, e := range enums {
+			for _, l := range e.lookups {
+				for _, fn := range []func(s string) string{strings.ToLower, strings.ToUpper, mixedCase} {
+					for idx, s := range inputs(e.strings, fn) {
+						actual, ok := l.fromStringIgnore(s)
+						require.True(t, ok, "%s %s: %q", e.name, l.strategy, s)
+						expected, _ := l.fromString(e.strings[idx])
+						require.Equal(t, expected, actual, "%s %s: %q", e.name, l.strategy, s)
+					}
+				}
+			}
+		}
+	})
+	t.Run("matches by Unicode simple folding", func(t *testing.T) {
+		for _, s := range []string{"ſilver", "SILVER", "sIlVeR"} {
+			v, ok := ColorUnicodeFoldFromStringIgnoreCase(s)
+			require.True(t, ok, s)
+			require.Equal(t, "Silver", v.String())
+		}
+		_, ok := ColorMapFromStringIgnoreCase("ſilver")
+		require.False(t, ok, "ASCII folding does not match \"ſ\" and \"s\"")
+
+		v, ok := TimezoneUnicodeFoldFromStringIgnoreCase("eUROPE/\u212Aiev") // hint: with the Kelvin sign
+		require.True(t, ok)
+		require.Equal(t, "Europe/Kiev", v.String())
+		_, ok = TimezoneMapFromStringIgnoreCase("eUROPE/\u212Aiev")
+		require.False(t, ok)
+	})
+	t.Run("does not allocate", func(t *testing.T) {
+		in := inputs(TimezoneMapStrings(), mixedCase)
+		for _, fn := range []func(raw string) (uint, bool){
+			lookupOf("map", TimezoneMapFromString, TimezoneMapFromStringIgnoreCase).fromStringIgnore,
+			lookupOf("unicode-fold", TimezoneUnicodeFoldFromString, TimezoneUnicodeFoldFromStringIgnoreCase).fromStringIgnore,
+		} {
+			allocs := testing.AllocsPerRun(10, func() {
+				for _, s := range in {
+					fn(s)
+				}
+			})
+			require.Zero(t, allocs)
+		}
+	})
+}
+
+// BenchmarkFromString compares the lookup strategies on enums of increasing sizes,
+// e.g. run `go test -bench=FromString -benchmem`.
+func BenchmarkFromString(b *testing.B) {
+	for _, e := range enums {
+		for _, bC := range []struct {
+			desc   string
+			inputs []string
+			ignore bool
+		}{
+			{"hit", inputs(e.strings, identity), false},
+			{"miss", inputs(e.strings, unknown), false},
+			{"ignore-case/lower", inputs(e.strings, strings.ToLower), true},
+			{"ignore-case/mixed", inputs(e.strings, mixedCase), true},
+		} {
+			for _, l := range e.lookups {
+				fromString := l.fromString
+				if bC.ignore {
+					fromString = l.fromStringIgnore
+				}
+				b.Run(fmt.Sprintf("%s/%d/%s/%s", e.name, len(e.strings), bC.desc, l.strategy), func(b *testing.B) {
+					b.ReportAllocs()
+					for i := 0; i < b.N; i++ {
+						fromString(bC.inputs[i%len(bC.inputs)])
+					}
+				})
+			}
+		}
+	}
+}
+
+// BenchmarkFromStringIgnoreCase compares the ASCII folding with the Unicode simple folding
+// of the case-insensitive lookup, e.g. run `go test -bench=FromStringIgnoreCase -benchmem`.
+func BenchmarkFromStringIgnoreCase(b *testing.B) {
+	for _, e := range []struct {
+		name    string
+		strings []string
+		lookups []lookup
+	}{
+		{"Color", ColorMapStrings(), []lookup{
+			lookupOf("ascii", ColorSwitchFromString, ColorSwitchFromStringIgnoreCase),
+			lookupOf("unicode", ColorUnicodeFoldFromString, ColorUnicodeFoldFromStringIgnoreCase),
+		}},
+		{"Timezone", TimezoneMapStrings(), []lookup{
+			lookupOf("ascii", TimezoneSwitchFromString, TimezoneSwitchFromStringIgnoreCase),
+			lookupOf("unicode", TimezoneUnicodeFoldFromString, TimezoneUnicodeFoldFromStringIgnoreCase),
+		}},
+	} {
+		for _, bC := range []struct {
+			desc   string
+			inputs []string
+		}{
+			{"lower", inputs(e.strings, strings.ToLower)},
+			{"upper", inputs(e.strings, strings.ToUpper)},
+			{"mixed", inputs(e.strings, mixedCase)},
+			{"miss", inputs(e.strings, unknown)},
+		} {
+			for _, l := range e.lookups {
+				b.Run(fmt.Sprintf("%s/%d/%s/%s", e.name, len(e.strings), bC.desc, l.strategy), func(b *testing.B) {
+					b.ReportAllocs()
+					for i := 0; i < b.N; i++ {
+						l.fromStringIgnore(bC.inputs[i%len(bC.inputs)])
+					}
+				})
+			}
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package ent
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mvrahden/go-enumer/examples/pills"
+	"github.com/mvrahden/go-enumer/examples/pills/ent/prescription"
+)
+
+// TestEnt asserts the round trip of enums through an ent client on SQLite.
+// The ent schema declares its fields by the field helpers exported by go-enumer,
+// i.e. field.Uint16(...).GoType(pills.PillNumeric(0)) for the numeric storage
+// and field.Enum(...).GoType(pills.PillUnsigned32(0)) for the storage by String values.
+func TestEnt(t *testing.T) {
+	ctx := context.Background()
+	db, err := sql.Open("sqlite3", "file:ent?mode=memory&_fk=1")
+	require.NoError(t, err)
+	client := NewClient(Driver(entsql.OpenDB(dialect.SQLite, db)))
+	t.Cleanup(func() { client.Close() })
+	require.NoError(t, client.Schema.Create(ctx))
+
+	t.Run("round trip", func(t *testing.T) {
+		in, err := client.Prescription.Create().
+			SetPillNumeric(pills.PillNumericIbuprofen).
+			SetPillUnsigned32(pills.PillUnsigned32VitaminC).
+			Save(ctx)
+		require.NoError(t, err)
+
+		out, err := client.Prescription.Query().
+			Where(prescription.PillUnsigned32EQ(pills.PillUnsigned32VitaminC)).
+			Only(ctx)
+		require.NoError(t, err)
+		require.Equal(t, in.ID, out.ID)
+		require.Equal(t, pills.PillNumericIbuprofen, out.PillNumeric)
+		require.Equal(t, pills.PillUnsigned32VitaminC, out.PillUnsigned32)
+
+		var numeric int64
+		var str string
+		err = db.QueryRow(`SELECT pill_numeric, pill_unsigned32 FROM prescriptions WHERE id = ?`, in.ID).Scan(&numeric, &str)
+		require.NoError(t, err)
+		require.Equal(t, int64(pills.PillNumericIbuprofen), numeric)
+		require.Equal(t, pills.PillUnsigned32VitaminC.String(), str)
+	})
+	t.Run("fail on invalid values", func(t *testing.T) {
+		_, err := client.Prescription.Create().
+			SetPillNumeric(pills.PillNumeric(42)).
+			SetPillUnsigned32(pills.PillUnsigned32VitaminC).
+			Save(ctx)
+		require.ErrorContains(t, err, pills.ErrNoValidEnum.Error()) // hint: database/sql does not wrap the error
+		_, err = client.Prescription.Create().
+			SetPillNumeric(pills.PillNumericIbuprofen).
+			SetPillUnsigned32(pills.PillUnsigned32(42)).
+			Save(ctx)
+		require.True(t, IsValidationError(err), err)
+
+		res, err := db.Exec(`INSERT INTO prescriptions (pill_numeric, pill_unsigned32) VALUES (42, 'UNKNOWN')`)
+		require.NoError(t, err)
+		id, err := res.LastInsertId()
+		require.NoError(t, err)
+		_, err = client.Prescription.Get(ctx, int(id))
+		require.ErrorIs(t, err, pills.ErrNoValidEnum)
+	})
+}
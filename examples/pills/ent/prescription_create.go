@@ -0,0 +1,207 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mvrahden/go-enumer/examples/pills"
+	"github.com/mvrahden/go-enumer/examples/pills/ent/prescription"
+)
+
+// PrescriptionCreate is the builder for creating a Prescription entity.
+type PrescriptionCreate struct {
+	config
+	mutation *PrescriptionMutation
+	hooks    []Hook
+}
+
+// SetPillNumeric sets the "pill_numeric" field.
+func (pc *PrescriptionCreate) SetPillNumeric(pn pills.PillNumeric) *PrescriptionCreate {
+	pc.mutation.SetPillNumeric(pn)
+	return pc
+}
+
+// SetPillUnsigned32 sets the "pill_unsigned32" field.
+func (pc *PrescriptionCreate) SetPillUnsigned32(pu pills.PillUnsigned32) *PrescriptionCreate {
+	pc.mutation.SetPillUnsigned32(pu)
+	return pc
+}
+
+// Mutation returns the PrescriptionMutation object of the builder.
+func (pc *PrescriptionCreate) Mutation() *PrescriptionMutation {
+	return pc.mutation
+}
+
+// Save creates the Prescription in the database.
+func (pc *PrescriptionCreate) Save(ctx context.Context) (*Prescription, error) {
+	return withHooks(ctx, pc.sqlSave, pc.mutation, pc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (pc *PrescriptionCreate) SaveX(ctx context.Context) *Prescription {
+	v, err := pc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pc *PrescriptionCreate) Exec(ctx context.Context) error {
+	_, err := pc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pc *PrescriptionCreate) ExecX(ctx context.Context) {
+	if err := pc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pc *PrescriptionCreate) check() error {
+	if _, ok := pc.mutation.PillNumeric(); !ok {
+		return &ValidationError{Name: "pill_numeric", err: errors.New(`ent: missing required field "Prescription.pill_numeric"`)}
+	}
+	if v, ok := pc.mutation.PillNumeric(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "pill_numeric", err: fmt.Errorf(`ent: validator failed for field "Prescription.pill_numeric": %w`, err)}
+		}
+	}
+	if _, ok := pc.mutation.PillUnsigned32(); !ok {
+		return &ValidationError{Name: "pill_unsigned32", err: errors.New(`ent: missing required field "Prescription.pill_unsigned32"`)}
+	}
+	if v, ok := pc.mutation.PillUnsigned32(); ok {
+		if err := prescription.PillUnsigned32Validator(v); err != nil {
+			return &ValidationError{Name: "pill_unsigned32", err: fmt.Errorf(`ent: validator failed for field "Prescription.pill_unsigned32": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (pc *PrescriptionCreate) sqlSave(ctx context.Context) (*Prescription, error) {
+	if err := pc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := pc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, pc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	pc.mutation.id = &_node.ID
+	pc.mutation.done = true
+	return _node, nil
+}
+
+func (pc *PrescriptionCreate) createSpec() (*Prescription, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Prescription{config: pc.config}
+		_spec = sqlgraph.NewCreateSpec(prescription.Table, sqlgraph.NewFieldSpec(prescription.FieldID, field.TypeInt))
+	)
+	if value, ok := pc.mutation.PillNumeric(); ok {
+		_spec.SetField(prescription.FieldPillNumeric, field.TypeUint16, value)
+		_node.PillNumeric = value
+	}
+	if value, ok := pc.mutation.PillUnsigned32(); ok {
+		_spec.SetField(prescription.FieldPillUnsigned32, field.TypeEnum, value)
+		_node.PillUnsigned32 = value
+	}
+	return _node, _spec
+}
+
+// PrescriptionCreateBulk is the builder for creating many Prescription entities in bulk.
+type PrescriptionCreateBulk struct {
+	config
+	err      error
+	builders []*PrescriptionCreate
+}
+
+// Save creates the Prescription entities in the database.
+func (pcb *PrescriptionCreateBulk) Save(ctx context.Context) ([]*Prescription, error) {
+	if pcb.err != nil {
+		return nil, pcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(pcb.builders))
+	nodes := make([]*Prescription, len(pcb.builders))
+	mutators := make([]Mutator, len(pcb.builders))
+	for i := range pcb.builders {
+		func(i int, root context.Context) {
+			builder := pcb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PrescriptionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, pcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, pcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, pcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pcb *PrescriptionCreateBulk) SaveX(ctx context.Context) []*Prescription {
+	v, err := pcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pcb *PrescriptionCreateBulk) Exec(ctx context.Context) error {
+	_, err := pcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pcb *PrescriptionCreateBulk) ExecX(ctx context.Context) {
+	if err := pcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,300 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mvrahden/go-enumer/examples/pills"
+	"github.com/mvrahden/go-enumer/examples/pills/ent/predicate"
+	"github.com/mvrahden/go-enumer/examples/pills/ent/prescription"
+)
+
+// PrescriptionUpdate is the builder for updating Prescription entities.
+type PrescriptionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PrescriptionMutation
+}
+
+// Where appends a list predicates to the PrescriptionUpdate builder.
+func (pu *PrescriptionUpdate) Where(ps ...predicate.Prescription) *PrescriptionUpdate {
+	pu.mutation.Where(ps...)
+	return pu
+}
+
+// SetPillNumeric sets the "pill_numeric" field.
+func (pu *PrescriptionUpdate) SetPillNumeric(pn pills.PillNumeric) *PrescriptionUpdate {
+	pu.mutation.ResetPillNumeric()
+	pu.mutation.SetPillNumeric(pn)
+	return pu
+}
+
+// SetNillablePillNumeric sets the "pill_numeric" field if the given value is not nil.
+func (pu *PrescriptionUpdate) SetNillablePillNumeric(pn *pills.PillNumeric) *PrescriptionUpdate {
+	if pn != nil {
+		pu.SetPillNumeric(*pn)
+	}
+	return pu
+}
+
+// AddPillNumeric adds pn to the "pill_numeric" field.
+func (pu *PrescriptionUpdate) AddPillNumeric(pn pills.PillNumeric) *PrescriptionUpdate {
+	pu.mutation.AddPillNumeric(pn)
+	return pu
+}
+
+// SetPillUnsigned32 sets the "pill_unsigned32" field.
+func (pu *PrescriptionUpdate) SetPillUnsigned32(value pills.PillUnsigned32) *PrescriptionUpdate {
+	pu.mutation.SetPillUnsigned32(value)
+	return pu
+}
+
+// SetNillablePillUnsigned32 sets the "pill_unsigned32" field if the given value is not nil.
+func (pu *PrescriptionUpdate) SetNillablePillUnsigned32(value *pills.PillUnsigned32) *PrescriptionUpdate {
+	if value != nil {
+		pu.SetPillUnsigned32(*value)
+	}
+	return pu
+}
+
+// Mutation returns the PrescriptionMutation object of the builder.
+func (pu *PrescriptionUpdate) Mutation() *PrescriptionMutation {
+	return pu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pu *PrescriptionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, pu.sqlSave, pu.mutation, pu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pu *PrescriptionUpdate) SaveX(ctx context.Context) int {
+	affected, err := pu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pu *PrescriptionUpdate) Exec(ctx context.Context) error {
+	_, err := pu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pu *PrescriptionUpdate) ExecX(ctx context.Context) {
+	if err := pu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pu *PrescriptionUpdate) check() error {
+	if v, ok := pu.mutation.PillNumeric(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "pill_numeric", err: fmt.Errorf(`ent: validator failed for field "Prescription.pill_numeric": %w`, err)}
+		}
+	}
+	if v, ok := pu.mutation.PillUnsigned32(); ok {
+		if err := prescription.PillUnsigned32Validator(v); err != nil {
+			return &ValidationError{Name: "pill_unsigned32", err: fmt.Errorf(`ent: validator failed for field "Prescription.pill_unsigned32": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (pu *PrescriptionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := pu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(prescription.Table, prescription.Columns, sqlgraph.NewFieldSpec(prescription.FieldID, field.TypeInt))
+	if ps := pu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pu.mutation.PillNumeric(); ok {
+		_spec.SetField(prescription.FieldPillNumeric, field.TypeUint16, value)
+	}
+	if value, ok := pu.mutation.AddedPillNumeric(); ok {
+		_spec.AddField(prescription.FieldPillNumeric, field.TypeUint16, value)
+	}
+	if value, ok := pu.mutation.PillUnsigned32(); ok {
+		_spec.SetField(prescription.FieldPillUnsigned32, field.TypeEnum, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, pu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{prescription.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	pu.mutation.done = true
+	return n, nil
+}
+
+// PrescriptionUpdateOne is the builder for updating a single Prescription entity.
+type PrescriptionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PrescriptionMutation
+}
+
+// SetPillNumeric sets the "pill_numeric" field.
+func (puo *PrescriptionUpdateOne) SetPillNumeric(pn pills.PillNumeric) *PrescriptionUpdateOne {
+	puo.mutation.ResetPillNumeric()
+	puo.mutation.SetPillNumeric(pn)
+	return puo
+}
+
+// SetNillablePillNumeric sets the "pill_numeric" field if the given value is not nil.
+func (puo *PrescriptionUpdateOne) SetNillablePillNumeric(pn *pills.PillNumeric) *PrescriptionUpdateOne {
+	if pn != nil {
+		puo.SetPillNumeric(*pn)
+	}
+	return puo
+}
+
+// AddPillNumeric adds pn to the "pill_numeric" field.
+func (puo *PrescriptionUpdateOne) AddPillNumeric(pn pills.PillNumeric) *PrescriptionUpdateOne {
+	puo.mutation.AddPillNumeric(pn)
+	return puo
+}
+
+// SetPillUnsigned32 sets the "pill_unsigned32" field.
+func (puo *PrescriptionUpdateOne) SetPillUnsigned32(pu pills.PillUnsigned32) *PrescriptionUpdateOne {
+	puo.mutation.SetPillUnsigned32(pu)
+	return puo
+}
+
+// SetNillablePillUnsigned32 sets the "pill_unsigned32" field if the given value is not nil.
+func (puo *PrescriptionUpdateOne) SetNillablePillUnsigned32(pu *pills.PillUnsigned32) *PrescriptionUpdateOne {
+	if pu != nil {
+		puo.SetPillUnsigned32(*pu)
+	}
+	return puo
+}
+
+// Mutation returns the PrescriptionMutation object of the builder.
+func (puo *PrescriptionUpdateOne) Mutation() *PrescriptionMutation {
+	return puo.mutation
+}
+
+// Where appends a list predicates to the PrescriptionUpdate builder.
+func (puo *PrescriptionUpdateOne) Where(ps ...predicate.Prescription) *PrescriptionUpdateOne {
+	puo.mutation.Where(ps...)
+	return puo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (puo *PrescriptionUpdateOne) Select(field string, fields ...string) *PrescriptionUpdateOne {
+	puo.fields = append([]string{field}, fields...)
+	return puo
+}
+
+// Save executes the query and returns the updated Prescription entity.
+func (puo *PrescriptionUpdateOne) Save(ctx context.Context) (*Prescription, error) {
+	return withHooks(ctx, puo.sqlSave, puo.mutation, puo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (puo *PrescriptionUpdateOne) SaveX(ctx context.Context) *Prescription {
+	node, err := puo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (puo *PrescriptionUpdateOne) Exec(ctx context.Context) error {
+	_, err := puo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (puo *PrescriptionUpdateOne) ExecX(ctx context.Context) {
+	if err := puo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (puo *PrescriptionUpdateOne) check() error {
+	if v, ok := puo.mutation.PillNumeric(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "pill_numeric", err: fmt.Errorf(`ent: validator failed for field "Prescription.pill_numeric": %w`, err)}
+		}
+	}
+	if v, ok := puo.mutation.PillUnsigned32(); ok {
+		if err := prescription.PillUnsigned32Validator(v); err != nil {
+			return &ValidationError{Name: "pill_unsigned32", err: fmt.Errorf(`ent: validator failed for field "Prescription.pill_unsigned32": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (puo *PrescriptionUpdateOne) sqlSave(ctx context.Context) (_node *Prescription, err error) {
+	if err := puo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(prescription.Table, prescription.Columns, sqlgraph.NewFieldSpec(prescription.FieldID, field.TypeInt))
+	id, ok := puo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Prescription.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := puo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, prescription.FieldID)
+		for _, f := range fields {
+			if !prescription.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != prescription.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := puo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := puo.mutation.PillNumeric(); ok {
+		_spec.SetField(prescription.FieldPillNumeric, field.TypeUint16, value)
+	}
+	if value, ok := puo.mutation.AddedPillNumeric(); ok {
+		_spec.AddField(prescription.FieldPillNumeric, field.TypeUint16, value)
+	}
+	if value, ok := puo.mutation.PillUnsigned32(); ok {
+		_spec.SetField(prescription.FieldPillUnsigned32, field.TypeEnum, value)
+	}
+	_node = &Prescription{config: puo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, puo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{prescription.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	puo.mutation.done = true
+	return _node, nil
+}
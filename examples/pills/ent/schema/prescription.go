@@ -0,0 +1,20 @@
+package schema
+
+import (
+	"entgo.io/ent"
+)
+
+// Prescription holds the schema definition for the Prescription entity.
+// Its enum fields are declared by the field helpers, which are exported
+// by go-enumer via `-ent=ent/schema` into pills_enumer.go.
+type Prescription struct {
+	ent.Schema
+}
+
+// Fields of the Prescription.
+func (Prescription) Fields() []ent.Field {
+	return []ent.Field{
+		PillNumericField("pill_numeric"),
+		PillUnsigned32Field("pill_unsigned32"),
+	}
+}
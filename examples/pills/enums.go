@@ -13,7 +13,7 @@ const (
 	PillUnsignedVitaminC
 )
 
-//go:enum
+//go:enum -support=set
 type PillUnsigned8 uint8
 
 const (
@@ -25,6 +25,19 @@ const (
 	PillUnsigned8VitaminC      PillUnsigned8 = 4
 )
 
+// PillVarint is serialized by the varint encoding of its numeric values.
+//go:enum -serializers=binary.varint,gob,json,text,yaml.v3 -support=set
+type PillVarint uint8
+
+const (
+	PillVarintPlacebo PillVarint = iota
+	PillVarintAspirin
+	PillVarintIbuprofen
+	PillVarintParacetamol
+	PillVarintAcetaminophen PillVarint = iota - 1
+	PillVarintVitaminC
+)
+
 //go:enum
 type PillUnsigned16 uint16
 
@@ -37,7 +50,21 @@ const (
 	PillUnsigned16VitaminC
 )
 
-//go:enum
+// PillNumeric is stored by its numeric values in SQL databases.
+//go:enum -serializers=binary,json,sql.int,text,yaml.v3 -support=ent,gorm,pgx,set
+type PillNumeric uint16
+
+const (
+	PillNumericPlacebo PillNumeric = iota
+	PillNumericAspirin
+	PillNumericIbuprofen
+	PillNumericParacetamol
+	PillNumericAcetaminophen PillNumeric = iota - 1
+	PillNumericVitaminC
+)
+
+// PillUnsigned32 is stored by its String values in SQL databases.
+//go:enum -support=ent,gorm,pgx
 type PillUnsigned32 uint32
 
 const (
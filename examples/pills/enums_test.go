@@ -1,11 +1,14 @@
 package pills
 
 import (
+	"bytes"
+	"encoding/gob"
 	"fmt"
 	"testing"
 
 	"github.com/mvrahden/go-enumer/pkg/utils"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
 )
 
 func TestEnums(t *testing.T) {
@@ -67,7 +70,7 @@ func TestEnums(t *testing.T) {
 				{From: "VITAMIN-C", Enum: toPtr(PillUnsignedVitaminC), Expected: utils.Expected{AsSerialized: "VITAMIN-C"}},
 			}
 			for idx, tC := range testCases {
-				serializers := []string{"binary", "graphql", "json", "sql", "text", "yaml.v3"}
+				serializers := []string{"binary", "json", "sql", "text", "yaml.v3"}
 				utils.AssertSerializationInterfacesFor[PillUnsigned](t, idx, tC, cfg, serializers)
 			}
 		})
@@ -130,7 +133,7 @@ func TestEnums(t *testing.T) {
 				{From: "VITAMIN-C", Enum: toPtr(PillAliasedVitaminC), Expected: utils.Expected{AsSerialized: "VITAMIN-C"}},
 			}
 			for idx, tC := range testCases {
-				serializers := []string{"binary", "graphql", "json", "sql", "text", "yaml.v3"}
+				serializers := []string{"binary", "json", "sql", "text", "yaml.v3"}
 				utils.AssertSerializationInterfacesFor[PillAliased](t, idx, tC, cfg, serializers)
 			}
 		})
@@ -148,6 +151,21 @@ func TestEnums(t *testing.T) {
 				utils.AssertNotSamePointer(t, _PillUnsigned8Values, PillUnsigned8Values())
 			})
 		})
+		t.Run("Set", func(t *testing.T) {
+			s := NewPillUnsigned8Set(PillUnsigned8Acetaminophen, PillUnsigned8Placebo)
+			require.Equal(t, []PillUnsigned8{PillUnsigned8Placebo, PillUnsigned8Paracetamol}, s.All())
+			require.True(t, s.Contains(PillUnsigned8Paracetamol))
+			require.Equal(t, "[PLACEBO PARACETAMOL]", s.String())
+
+			buf, err := yaml.Marshal(s)
+			require.NoError(t, err)
+			require.Equal(t, "- PLACEBO\n- PARACETAMOL\n", string(buf))
+			var fromYAML PillUnsigned8Set
+			require.NoError(t, yaml.Unmarshal([]byte("[PARACETAMOL, PLACEBO]"), &fromYAML))
+			require.Equal(t, s, fromYAML)
+			require.ErrorIs(t, yaml.Unmarshal([]byte("[ASPIRIN, UNKNOWN]"), &fromYAML), ErrNoValidEnum)
+			require.Error(t, yaml.Unmarshal([]byte("PLACEBO"), &fromYAML))
+		})
 		t.Run("Lookup", func(t *testing.T) {
 			type testCase struct {
 				enum  PillUnsigned8
@@ -193,11 +211,107 @@ func TestEnums(t *testing.T) {
 				{From: "VITAMIN-C", Enum: toPtr(PillUnsigned8VitaminC), Expected: utils.Expected{AsSerialized: "VITAMIN-C"}},
 			}
 			for idx, tC := range testCases {
-				serializers := []string{"binary", "graphql", "json", "sql", "text", "yaml.v3"}
+				serializers := []string{"binary", "json", "sql", "text", "yaml.v3"}
 				utils.AssertSerializationInterfacesFor[PillUnsigned8](t, idx, tC, cfg, serializers)
 			}
 		})
 	})
+	t.Run("PillVarint", func(t *testing.T) {
+		t.Run("Value Sets", func(t *testing.T) {
+			require.Equal(t,
+				[]string{"PLACEBO", "ASPIRIN", "IBUPROFEN", "PARACETAMOL", "VITAMIN-C"},
+				PillVarintStrings())
+			require.Equal(t,
+				[]PillVarint{PillVarintPlacebo, PillVarintAspirin, PillVarintIbuprofen, PillVarintParacetamol, PillVarintVitaminC},
+				PillVarintValues())
+			t.Run("return copies", func(t *testing.T) {
+				utils.AssertNotSamePointer(t, _PillVarintStrings, PillVarintStrings())
+				utils.AssertNotSamePointer(t, _PillVarintValues, PillVarintValues())
+			})
+		})
+		t.Run("Set", func(t *testing.T) {
+			s := NewPillVarintSet(PillVarintAcetaminophen, PillVarintPlacebo)
+			require.Equal(t, []PillVarint{PillVarintPlacebo, PillVarintParacetamol}, s.All())
+			require.True(t, s.Contains(PillVarintParacetamol))
+			require.Equal(t, "[PLACEBO PARACETAMOL]", s.String())
+
+			buf, err := yaml.Marshal(s)
+			require.NoError(t, err)
+			require.Equal(t, "- PLACEBO\n- PARACETAMOL\n", string(buf))
+			var fromYAML PillVarintSet
+			require.NoError(t, yaml.Unmarshal([]byte("[PARACETAMOL, PLACEBO]"), &fromYAML))
+			require.Equal(t, s, fromYAML)
+			require.ErrorIs(t, yaml.Unmarshal([]byte("[ASPIRIN, UNKNOWN]"), &fromYAML), ErrNoValidEnum)
+			require.Error(t, yaml.Unmarshal([]byte("PLACEBO"), &fromYAML))
+
+			buf, err = s.MarshalBinary()
+			require.NoError(t, err)
+			require.Equal(t, []byte{0x00, 0x03}, buf)
+			appended, err := s.AppendBinary([]byte{0xff})
+			require.NoError(t, err)
+			require.Equal(t, []byte{0xff, 0x00, 0x03}, appended)
+			var fromBinary PillVarintSet
+			require.NoError(t, fromBinary.UnmarshalBinary(buf))
+			require.Equal(t, s, fromBinary)
+			require.ErrorIs(t, fromBinary.UnmarshalBinary([]byte{0x01, 0x05}), ErrNoValidEnum)
+			require.Error(t, fromBinary.UnmarshalBinary([]byte{0x80}))
+
+			var gobBuf bytes.Buffer
+			require.NoError(t, gob.NewEncoder(&gobBuf).Encode(s))
+			var fromGob PillVarintSet
+			require.NoError(t, gob.NewDecoder(&gobBuf).Decode(&fromGob))
+			require.Equal(t, s, fromGob)
+		})
+		t.Run("Lookup", func(t *testing.T) {
+			type testCase struct {
+				enum  PillVarint
+				upper string
+				lower string
+			}
+			testCases := []testCase{
+				{PillVarintPlacebo, "PLACEBO", "placebo"},
+				{PillVarintAspirin, "ASPIRIN", "aspirin"},
+				{PillVarintIbuprofen, "IBUPROFEN", "ibuprofen"},
+				{PillVarintParacetamol, "PARACETAMOL", "paracetamol"},
+				{PillVarintVitaminC, "VITAMIN-C", "vitamin-c"},
+			}
+			for idx, tC := range testCases {
+				t.Run(fmt.Sprintf("Case-sensitive lookup (idx: %d %s)", idx, tC.enum), func(t *testing.T) {
+					actual, ok := PillVarintFromString(tC.upper)
+					require.True(t, ok)
+					require.Equal(t, tC.enum, actual)
+					actual, ok = PillVarintFromString(tC.lower)
+					require.False(t, ok)
+					require.Equal(t, PillVarint(0), actual)
+				})
+				t.Run(fmt.Sprintf("Case-insensitive lookup (idx: %d %s)", idx, tC.enum), func(t *testing.T) {
+					enum, ok := PillVarintFromStringIgnoreCase(tC.upper)
+					require.True(t, ok)
+					require.Equal(t, tC.enum, enum)
+					enum, ok = PillVarintFromStringIgnoreCase(tC.lower)
+					require.True(t, ok)
+					require.Equal(t, tC.enum, enum)
+				})
+			}
+		})
+		t.Run("Serialization", func(t *testing.T) {
+			cfg := utils.TestConfig{}
+			toPtr := utils.ToPointer[PillVarint]
+			testCases := []utils.TestCase{
+				{From: "", Enum: toPtr(5), Expected: utils.Expected{AsSerialized: "PillVarint(5)", IsInvalid: true}},
+				{From: "PLACEBO", Enum: toPtr(0), Expected: utils.Expected{AsSerialized: "PLACEBO"}},
+				{From: "ASPIRIN", Enum: toPtr(PillVarintAspirin), Expected: utils.Expected{AsSerialized: "ASPIRIN"}},
+				{From: "IBUPROFEN", Enum: toPtr(PillVarintIbuprofen), Expected: utils.Expected{AsSerialized: "IBUPROFEN"}},
+				{From: "PARACETAMOL", Enum: toPtr(PillVarintParacetamol), Expected: utils.Expected{AsSerialized: "PARACETAMOL"}},
+				{From: "ACETAMINOPHEN", Enum: toPtr(PillVarintAcetaminophen), Expected: utils.Expected{AsSerialized: "PARACETAMOL"}},
+				{From: "VITAMIN-C", Enum: toPtr(PillVarintVitaminC), Expected: utils.Expected{AsSerialized: "VITAMIN-C"}},
+			}
+			for idx, tC := range testCases {
+				serializers := []string{"binary.varint", "gob", "json", "text", "yaml.v3"}
+				utils.AssertSerializationInterfacesFor[PillVarint](t, idx, tC, cfg, serializers)
+			}
+		})
+	})
 	t.Run("PillUnsigned16", func(t *testing.T) {
 		t.Run("Value Sets", func(t *testing.T) {
 			require.Equal(t,
@@ -256,11 +370,93 @@ func TestEnums(t *testing.T) {
 				{From: "VITAMIN-C", Enum: toPtr(PillUnsigned16VitaminC), Expected: utils.Expected{AsSerialized: "VITAMIN-C"}},
 			}
 			for idx, tC := range testCases {
-				serializers := []string{"binary", "graphql", "json", "sql", "text", "yaml.v3"}
+				serializers := []string{"binary", "json", "sql", "text", "yaml.v3"}
 				utils.AssertSerializationInterfacesFor[PillUnsigned16](t, idx, tC, cfg, serializers)
 			}
 		})
 	})
+	t.Run("PillNumeric", func(t *testing.T) {
+		t.Run("Value Sets", func(t *testing.T) {
+			require.Equal(t,
+				[]string{"PLACEBO", "ASPIRIN", "IBUPROFEN", "PARACETAMOL", "VITAMIN-C"},
+				PillNumericStrings())
+			require.Equal(t,
+				[]PillNumeric{PillNumericPlacebo, PillNumericAspirin, PillNumericIbuprofen, PillNumericParacetamol, PillNumericVitaminC},
+				PillNumericValues())
+			t.Run("return copies", func(t *testing.T) {
+				utils.AssertNotSamePointer(t, _PillNumericStrings, PillNumericStrings())
+				utils.AssertNotSamePointer(t, _PillNumericValues, PillNumericValues())
+			})
+		})
+		t.Run("Set", func(t *testing.T) {
+			s := NewPillNumericSet(PillNumericVitaminC, PillNumericAspirin)
+			value, err := s.Value()
+			require.NoError(t, err)
+			require.Equal(t, "{1,4}", value)
+			var fromSQL PillNumericSet
+			require.NoError(t, fromSQL.Scan([]byte("{4, 1}")))
+			require.Equal(t, s, fromSQL)
+
+			value, err = PillNumericSet{}.Value()
+			require.NoError(t, err)
+			require.Equal(t, "{}", value)
+			require.NoError(t, fromSQL.Scan(value))
+			require.Zero(t, fromSQL.Len())
+
+			require.ErrorIs(t, fromSQL.Scan("{1,5}"), ErrNoValidEnum)
+			require.Error(t, fromSQL.Scan("{ASPIRIN}"))
+			require.Error(t, fromSQL.Scan("1,4"))
+		})
+		t.Run("Lookup", func(t *testing.T) {
+			type testCase struct {
+				enum  PillNumeric
+				upper string
+				lower string
+			}
+			testCases := []testCase{
+				{PillNumericPlacebo, "PLACEBO", "placebo"},
+				{PillNumericAspirin, "ASPIRIN", "aspirin"},
+				{PillNumericIbuprofen, "IBUPROFEN", "ibuprofen"},
+				{PillNumericParacetamol, "PARACETAMOL", "paracetamol"},
+				{PillNumericVitaminC, "VITAMIN-C", "vitamin-c"},
+			}
+			for idx, tC := range testCases {
+				t.Run(fmt.Sprintf("Case-sensitive lookup (idx: %d %s)", idx, tC.enum), func(t *testing.T) {
+					actual, ok := PillNumericFromString(tC.upper)
+					require.True(t, ok)
+					require.Equal(t, tC.enum, actual)
+					actual, ok = PillNumericFromString(tC.lower)
+					require.False(t, ok)
+					require.Equal(t, PillNumeric(0), actual)
+				})
+				t.Run(fmt.Sprintf("Case-insensitive lookup (idx: %d %s)", idx, tC.enum), func(t *testing.T) {
+					enum, ok := PillNumericFromStringIgnoreCase(tC.upper)
+					require.True(t, ok)
+					require.Equal(t, tC.enum, enum)
+					enum, ok = PillNumericFromStringIgnoreCase(tC.lower)
+					require.True(t, ok)
+					require.Equal(t, tC.enum, enum)
+				})
+			}
+		})
+		t.Run("Serialization", func(t *testing.T) {
+			cfg := utils.TestConfig{}
+			toPtr := utils.ToPointer[PillNumeric]
+			testCases := []utils.TestCase{
+				{From: "", Enum: toPtr(5), Expected: utils.Expected{AsSerialized: "PillNumeric(5)", IsInvalid: true}},
+				{From: "PLACEBO", Enum: toPtr(0), Expected: utils.Expected{AsSerialized: "PLACEBO"}},
+				{From: "ASPIRIN", Enum: toPtr(PillNumericAspirin), Expected: utils.Expected{AsSerialized: "ASPIRIN"}},
+				{From: "IBUPROFEN", Enum: toPtr(PillNumericIbuprofen), Expected: utils.Expected{AsSerialized: "IBUPROFEN"}},
+				{From: "PARACETAMOL", Enum: toPtr(PillNumericParacetamol), Expected: utils.Expected{AsSerialized: "PARACETAMOL"}},
+				{From: "ACETAMINOPHEN", Enum: toPtr(PillNumericAcetaminophen), Expected: utils.Expected{AsSerialized: "PARACETAMOL"}},
+				{From: "VITAMIN-C", Enum: toPtr(PillNumericVitaminC), Expected: utils.Expected{AsSerialized: "VITAMIN-C"}},
+			}
+			for idx, tC := range testCases {
+				serializers := []string{"binary", "json", "sql.int", "text", "yaml.v3"}
+				utils.AssertSerializationInterfacesFor[PillNumeric](t, idx, tC, cfg, serializers)
+			}
+		})
+	})
 	t.Run("PillUnsigned32", func(t *testing.T) {
 		t.Run("Value Sets", func(t *testing.T) {
 			require.Equal(t,
@@ -319,7 +515,7 @@ func TestEnums(t *testing.T) {
 				{From: "VITAMIN-C", Enum: toPtr(PillUnsigned32VitaminC), Expected: utils.Expected{AsSerialized: "VITAMIN-C"}},
 			}
 			for idx, tC := range testCases {
-				serializers := []string{"binary", "graphql", "json", "sql", "text", "yaml.v3"}
+				serializers := []string{"binary", "json", "sql", "text", "yaml.v3"}
 				utils.AssertSerializationInterfacesFor[PillUnsigned32](t, idx, tC, cfg, serializers)
 			}
 		})
@@ -382,7 +578,7 @@ func TestEnums(t *testing.T) {
 				{From: "VITAMIN-C", Enum: toPtr(PillUnsigned64VitaminC), Expected: utils.Expected{AsSerialized: "VITAMIN-C"}},
 			}
 			for idx, tC := range testCases {
-				serializers := []string{"binary", "graphql", "json", "sql", "text", "yaml.v3"}
+				serializers := []string{"binary", "json", "sql", "text", "yaml.v3"}
 				utils.AssertSerializationInterfacesFor[PillUnsigned64](t, idx, tC, cfg, serializers)
 			}
 		})
@@ -3,22 +3,28 @@
 package pills
 
 import (
+	"context"
 	"database/sql/driver"
+	"encoding/binary"
 	"encoding/json"
-	"errors"
 	"fmt"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	_enumer "github.com/mvrahden/go-enumer/enum"
 	"gopkg.in/yaml.v3"
-	"io"
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+	"math/bits"
 	"strconv"
+	"strings"
 )
 
 var (
-	ErrNoValidEnum = errors.New("not a valid enum")
+	ErrNoValidEnum = _enumer.ErrNoValidEnum
 )
 
 const (
-	_PillAliasedString      = "PLACEBOASPIRINIBUPROFENPARACETAMOLACETAMINOPHENVITAMIN-C"
-	_PillAliasedLowerString = "placeboaspirinibuprofenparacetamolacetaminophenvitamin-c"
+	_PillAliasedString = "PLACEBOASPIRINIBUPROFENPARACETAMOLACETAMINOPHENVITAMIN-C"
 )
 
 var (
@@ -51,6 +57,11 @@ func PillAliasedStrings() []string {
 	return cp[:]
 }
 
+// Values returns all values of the enum.
+func (PillAliased) Values() []PillAliased {
+	return PillAliasedValues()
+}
+
 // IsValid tests whether the value is a valid enum value.
 func (_p PillAliased) IsValid() bool {
 	return _p >= 0 && _p <= 4
@@ -75,6 +86,54 @@ func (_p PillAliased) String() string {
 	return _PillAliasedStrings[idx]
 }
 
+// Index returns the position of the value within the ordered values of the enum
+// (ignoring alternative values) or -1 if the value is invalid.
+func (_p PillAliased) Index() int {
+	if !_p.IsValid() {
+		return -1
+	}
+	idx := int(_p)
+	return idx
+}
+
+// Compare returns -1, 0 or +1 depending on whether the value orders before,
+// equal to or after the other value. Invalid values order before all valid values.
+func (_p PillAliased) Compare(other PillAliased) int {
+	a, b := _p.Index(), other.Index()
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return +1
+	}
+	return 0
+}
+
+// Less tests whether the value orders before the other value.
+func (_p PillAliased) Less(other PillAliased) bool {
+	return _p.Compare(other) < 0
+}
+
+// Next returns the succeeding value in order of the enum.
+// It returns false if the value is invalid or the last value.
+func (_p PillAliased) Next() (PillAliased, bool) {
+	idx := _p.Index()
+	if idx == -1 || idx+1 == len(_PillAliasedValues) {
+		return PillAliased(0), false
+	}
+	return _PillAliasedValues[idx+1], true
+}
+
+// Prev returns the preceding value in order of the enum.
+// It returns false if the value is invalid or the first value.
+func (_p PillAliased) Prev() (PillAliased, bool) {
+	idx := _p.Index()
+	if idx < 1 {
+		return PillAliased(0), false
+	}
+	return _PillAliasedValues[idx-1], true
+}
+
 var (
 	_PillAliasedStringToValueMap = map[string]PillAliased{
 		_PillAliasedString[0:7]:   PillAliasedPlacebo,
@@ -84,16 +143,23 @@ var (
 		_PillAliasedString[34:47]: PillAliasedAcetaminophen,
 		_PillAliasedString[47:56]: PillAliasedVitaminC,
 	}
-	_PillAliasedLowerStringToValueMap = map[string]PillAliased{
-		_PillAliasedLowerString[0:7]:   PillAliasedPlacebo,
-		_PillAliasedLowerString[7:14]:  PillAliasedAspirin,
-		_PillAliasedLowerString[14:23]: PillAliasedIbuprofen,
-		_PillAliasedLowerString[23:34]: PillAliasedParacetamol,
-		_PillAliasedLowerString[34:47]: PillAliasedAcetaminophen,
-		_PillAliasedLowerString[47:56]: PillAliasedVitaminC,
-	}
 )
 
+// _PillAliasedLookupFold determines the enum value of the string regardless of its case
+// by Unicode simple folding. It scans the String values, as a fold table is only generated
+// for enums with the "ignore-case" feature.
+func _PillAliasedLookupFold(raw string) (PillAliased, bool) {
+	for idx := range _PillAliasedStrings {
+		if strings.EqualFold(_PillAliasedStrings[idx], raw) {
+			return _PillAliasedValues[idx], true
+		}
+	}
+	if strings.EqualFold(_PillAliasedString[34:47], raw) {
+		return PillAliasedAcetaminophen, true
+	}
+	return PillAliased(0), false
+}
+
 // PillAliasedFromString determines the enum value with an exact case match.
 func PillAliasedFromString(raw string) (PillAliased, bool) {
 	v, ok := _PillAliasedStringToValueMap[raw]
@@ -103,13 +169,14 @@ func PillAliasedFromString(raw string) (PillAliased, bool) {
 	return v, true
 }
 
-// PillAliasedFromStringIgnoreCase determines the enum value with a case-insensitive match.
+// PillAliasedFromStringIgnoreCase determines the enum value with a case-insensitive match
+// by Unicode simple folding, regardless of the casing of raw.
 func PillAliasedFromStringIgnoreCase(raw string) (PillAliased, bool) {
 	v, ok := PillAliasedFromString(raw)
 	if ok {
 		return v, ok
 	}
-	v, ok = _PillAliasedLowerStringToValueMap[raw]
+	v, ok = _PillAliasedLookupFold(raw)
 	if !ok {
 		return PillAliased(0), false
 	}
@@ -118,10 +185,15 @@ func PillAliasedFromStringIgnoreCase(raw string) (PillAliased, bool) {
 
 // MarshalBinary implements the encoding.BinaryMarshaler interface for PillAliased.
 func (_p PillAliased) MarshalBinary() ([]byte, error) {
+	return _p.AppendBinary(nil)
+}
+
+// AppendBinary implements the encoding.BinaryAppender interface for PillAliased.
+func (_p PillAliased) AppendBinary(b []byte) ([]byte, error) {
 	if err := _p.Validate(); err != nil {
-		return nil, fmt.Errorf("Cannot marshal value %q as PillAliased. %w", _p, err)
+		return b, fmt.Errorf("Cannot marshal value %q as PillAliased. %w", _p, err)
 	}
-	return []byte(_p.String()), nil
+	return append(b, _p.String()...), nil
 }
 
 // UnmarshalBinary implements the encoding.BinaryUnmarshaler interface for PillAliased.
@@ -134,37 +206,7 @@ func (_p *PillAliased) UnmarshalBinary(text []byte) error {
 	var ok bool
 	*_p, ok = PillAliasedFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a PillAliased", str)
-	}
-	return nil
-}
-
-// MarshalGQL implements the graphql.Marshaler interface for PillAliased.
-func (_p PillAliased) MarshalGQL(w io.Writer) {
-	fmt.Fprint(w, strconv.Quote(_p.String()))
-}
-
-// UnmarshalGQL implements the graphql.Unmarshaler interface for PillAliased.
-func (_p *PillAliased) UnmarshalGQL(value interface{}) error {
-	var str string
-	switch v := value.(type) {
-	case []byte:
-		str = string(v)
-	case string:
-		str = v
-	case fmt.Stringer:
-		str = v.String()
-	default:
-		return fmt.Errorf("invalid value of PillAliased: %[1]T(%[1]v)", value)
-	}
-	if len(str) == 0 {
-		return fmt.Errorf("PillAliased cannot be derived from empty string")
-	}
-
-	var ok bool
-	*_p, ok = PillAliasedFromString(str)
-	if !ok {
-		return fmt.Errorf("Value %q does not represent a PillAliased", str)
+		return _enumer.NewParseError("PillAliased", str, PillAliasedStrings())
 	}
 	return nil
 }
@@ -190,7 +232,7 @@ func (_p *PillAliased) UnmarshalJSON(data []byte) error {
 	var ok bool
 	*_p, ok = PillAliasedFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a PillAliased", str)
+		return _enumer.NewParseError("PillAliased", str, PillAliasedStrings())
 	}
 	return nil
 }
@@ -223,17 +265,22 @@ func (_p *PillAliased) Scan(value interface{}) error {
 	var ok bool
 	*_p, ok = PillAliasedFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a PillAliased", str)
+		return _enumer.NewParseError("PillAliased", str, PillAliasedStrings())
 	}
 	return nil
 }
 
 // MarshalText implements the encoding.TextMarshaler interface for PillAliased.
 func (_p PillAliased) MarshalText() ([]byte, error) {
+	return _p.AppendText(nil)
+}
+
+// AppendText implements the encoding.TextAppender interface for PillAliased.
+func (_p PillAliased) AppendText(b []byte) ([]byte, error) {
 	if err := _p.Validate(); err != nil {
-		return nil, fmt.Errorf("Cannot marshal value %q as PillAliased. %w", _p, err)
+		return b, fmt.Errorf("Cannot marshal value %q as PillAliased. %w", _p, err)
 	}
-	return []byte(_p.String()), nil
+	return append(b, _p.String()...), nil
 }
 
 // UnmarshalText implements the encoding.TextUnmarshaler interface for PillAliased.
@@ -246,7 +293,7 @@ func (_p *PillAliased) UnmarshalText(text []byte) error {
 	var ok bool
 	*_p, ok = PillAliasedFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a PillAliased", str)
+		return _enumer.NewParseError("PillAliased", str, PillAliasedStrings())
 	}
 	return nil
 }
@@ -273,462 +320,1901 @@ func (_p *PillAliased) UnmarshalYAML(n *yaml.Node) error {
 	var ok bool
 	*_p, ok = PillAliasedFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a PillAliased", str)
+		return _enumer.NewParseError("PillAliased", str, PillAliasedStrings())
 	}
 	return nil
 }
 
+var _PillAliasedCompletions = [5]string{
+	"PLACEBO",
+	"ASPIRIN",
+	"IBUPROFEN",
+	"PARACETAMOL",
+	"VITAMIN-C",
+}
+
+// PillAliasedUsage returns a usage hint of all String values of the enum, less the deprecated values,
+// e.g. for flag descriptions.
+func PillAliasedUsage() string {
+	return "one of: " + strings.Join(_PillAliasedStrings[:], "|")
+}
+
+// PillAliasedCompletions returns the completion candidates of the enum matching the given prefix,
+// less the deprecated values. Each candidate is formatted as "<value>" or "<value>\t<description>",
+// as expected by shell completions, e.g. of cobra's RegisterFlagCompletionFunc.
+func PillAliasedCompletions(toComplete string) []string {
+	out := make([]string, 0, len(_PillAliasedCompletions))
+	for _, c := range _PillAliasedCompletions {
+		if strings.HasPrefix(c, toComplete) {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
 const (
-	_PillUnsignedString      = "PLACEBOASPIRINIBUPROFENPARACETAMOLACETAMINOPHENVITAMIN-C"
-	_PillUnsignedLowerString = "placeboaspirinibuprofenparacetamolacetaminophenvitamin-c"
+	_PillNumericString = "PLACEBOASPIRINIBUPROFENPARACETAMOLACETAMINOPHENVITAMIN-C"
 )
 
 var (
-	_PillUnsignedValues  = [5]PillUnsigned{0, 1, 2, 3, 4}
-	_PillUnsignedStrings = [5]string{_PillUnsignedString[0:7], _PillUnsignedString[7:14], _PillUnsignedString[14:23], _PillUnsignedString[23:34], _PillUnsignedString[47:56]}
+	_PillNumericValues  = [5]PillNumeric{0, 1, 2, 3, 4}
+	_PillNumericStrings = [5]string{_PillNumericString[0:7], _PillNumericString[7:14], _PillNumericString[14:23], _PillNumericString[23:34], _PillNumericString[47:56]}
 )
 
-// _PillUnsignedNoOp is a compile time assertion.
+// _PillNumericNoOp is a compile time assertion.
 // An "invalid argument/out of bounds" compiler error signifies that the enum values have changed.
-// Re-run the enumer command to generate an updated version of PillUnsigned.
-func _PillUnsignedNoOp() {
+// Re-run the enumer command to generate an updated version of PillNumeric.
+func _PillNumericNoOp() {
 	var x [1]struct{}
-	_ = x[PillUnsignedPlacebo-(0)]
-	_ = x[PillUnsignedAspirin-(1)]
-	_ = x[PillUnsignedIbuprofen-(2)]
-	_ = x[PillUnsignedParacetamol-(3)]
-	_ = x[PillUnsignedAcetaminophen-(3)]
-	_ = x[PillUnsignedVitaminC-(4)]
+	_ = x[PillNumericPlacebo-(0)]
+	_ = x[PillNumericAspirin-(1)]
+	_ = x[PillNumericIbuprofen-(2)]
+	_ = x[PillNumericParacetamol-(3)]
+	_ = x[PillNumericAcetaminophen-(3)]
+	_ = x[PillNumericVitaminC-(4)]
 }
 
-// PillUnsignedValues returns all values of the enum.
-func PillUnsignedValues() []PillUnsigned {
-	cp := _PillUnsignedValues
+// PillNumericValues returns all values of the enum.
+func PillNumericValues() []PillNumeric {
+	cp := _PillNumericValues
 	return cp[:]
 }
 
-// PillUnsignedStrings returns a slice of all String values of the enum.
-func PillUnsignedStrings() []string {
-	cp := _PillUnsignedStrings
+// PillNumericStrings returns a slice of all String values of the enum.
+func PillNumericStrings() []string {
+	cp := _PillNumericStrings
 	return cp[:]
 }
 
 // IsValid tests whether the value is a valid enum value.
-func (_p PillUnsigned) IsValid() bool {
+func (_p PillNumeric) IsValid() bool {
 	return _p >= 0 && _p <= 4
 }
 
 // Validate whether the value is within the range of enum values.
-func (_p PillUnsigned) Validate() error {
+func (_p PillNumeric) Validate() error {
 	if !_p.IsValid() {
-		return fmt.Errorf("PillUnsigned(%d) is %w", _p, ErrNoValidEnum)
+		return fmt.Errorf("PillNumeric(%d) is %w", _p, ErrNoValidEnum)
 	}
 	return nil
 }
 
 // String returns the string of the enum value.
 // If the enum value is invalid, it will produce a string
-// of the following pattern PillUnsigned(%d) instead.
-func (_p PillUnsigned) String() string {
+// of the following pattern PillNumeric(%d) instead.
+func (_p PillNumeric) String() string {
 	if !_p.IsValid() {
-		return fmt.Sprintf("PillUnsigned(%d)", _p)
+		return fmt.Sprintf("PillNumeric(%d)", _p)
 	}
 	idx := uint(_p)
-	return _PillUnsignedStrings[idx]
+	return _PillNumericStrings[idx]
 }
 
-var (
-	_PillUnsignedStringToValueMap = map[string]PillUnsigned{
-		_PillUnsignedString[0:7]:   PillUnsignedPlacebo,
-		_PillUnsignedString[7:14]:  PillUnsignedAspirin,
-		_PillUnsignedString[14:23]: PillUnsignedIbuprofen,
-		_PillUnsignedString[23:34]: PillUnsignedParacetamol,
-		_PillUnsignedString[34:47]: PillUnsignedAcetaminophen,
-		_PillUnsignedString[47:56]: PillUnsignedVitaminC,
+// Index returns the position of the value within the ordered values of the enum
+// (ignoring alternative values) or -1 if the value is invalid.
+func (_p PillNumeric) Index() int {
+	if !_p.IsValid() {
+		return -1
 	}
-	_PillUnsignedLowerStringToValueMap = map[string]PillUnsigned{
-		_PillUnsignedLowerString[0:7]:   PillUnsignedPlacebo,
-		_PillUnsignedLowerString[7:14]:  PillUnsignedAspirin,
-		_PillUnsignedLowerString[14:23]: PillUnsignedIbuprofen,
-		_PillUnsignedLowerString[23:34]: PillUnsignedParacetamol,
-		_PillUnsignedLowerString[34:47]: PillUnsignedAcetaminophen,
-		_PillUnsignedLowerString[47:56]: PillUnsignedVitaminC,
+	idx := int(_p)
+	return idx
+}
+
+// Compare returns -1, 0 or +1 depending on whether the value orders before,
+// equal to or after the other value. Invalid values order before all valid values.
+func (_p PillNumeric) Compare(other PillNumeric) int {
+	a, b := _p.Index(), other.Index()
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return +1
+	}
+	return 0
+}
+
+// Less tests whether the value orders before the other value.
+func (_p PillNumeric) Less(other PillNumeric) bool {
+	return _p.Compare(other) < 0
+}
+
+// Next returns the succeeding value in order of the enum.
+// It returns false if the value is invalid or the last value.
+func (_p PillNumeric) Next() (PillNumeric, bool) {
+	idx := _p.Index()
+	if idx == -1 || idx+1 == len(_PillNumericValues) {
+		return PillNumeric(0), false
+	}
+	return _PillNumericValues[idx+1], true
+}
+
+// Prev returns the preceding value in order of the enum.
+// It returns false if the value is invalid or the first value.
+func (_p PillNumeric) Prev() (PillNumeric, bool) {
+	idx := _p.Index()
+	if idx < 1 {
+		return PillNumeric(0), false
+	}
+	return _PillNumericValues[idx-1], true
+}
+
+var (
+	_PillNumericStringToValueMap = map[string]PillNumeric{
+		_PillNumericString[0:7]:   PillNumericPlacebo,
+		_PillNumericString[7:14]:  PillNumericAspirin,
+		_PillNumericString[14:23]: PillNumericIbuprofen,
+		_PillNumericString[23:34]: PillNumericParacetamol,
+		_PillNumericString[34:47]: PillNumericAcetaminophen,
+		_PillNumericString[47:56]: PillNumericVitaminC,
 	}
 )
 
-// PillUnsignedFromString determines the enum value with an exact case match.
-func PillUnsignedFromString(raw string) (PillUnsigned, bool) {
-	v, ok := _PillUnsignedStringToValueMap[raw]
+// _PillNumericLookupFold determines the enum value of the string regardless of its case
+// by Unicode simple folding. It scans the String values, as a fold table is only generated
+// for enums with the "ignore-case" feature.
+func _PillNumericLookupFold(raw string) (PillNumeric, bool) {
+	for idx := range _PillNumericStrings {
+		if strings.EqualFold(_PillNumericStrings[idx], raw) {
+			return _PillNumericValues[idx], true
+		}
+	}
+	if strings.EqualFold(_PillNumericString[34:47], raw) {
+		return PillNumericAcetaminophen, true
+	}
+	return PillNumeric(0), false
+}
+
+// PillNumericFromString determines the enum value with an exact case match.
+func PillNumericFromString(raw string) (PillNumeric, bool) {
+	v, ok := _PillNumericStringToValueMap[raw]
 	if !ok {
-		return PillUnsigned(0), false
+		return PillNumeric(0), false
 	}
 	return v, true
 }
 
-// PillUnsignedFromStringIgnoreCase determines the enum value with a case-insensitive match.
-func PillUnsignedFromStringIgnoreCase(raw string) (PillUnsigned, bool) {
-	v, ok := PillUnsignedFromString(raw)
+// PillNumericFromStringIgnoreCase determines the enum value with a case-insensitive match
+// by Unicode simple folding, regardless of the casing of raw.
+func PillNumericFromStringIgnoreCase(raw string) (PillNumeric, bool) {
+	v, ok := PillNumericFromString(raw)
 	if ok {
 		return v, ok
 	}
-	v, ok = _PillUnsignedLowerStringToValueMap[raw]
+	v, ok = _PillNumericLookupFold(raw)
 	if !ok {
-		return PillUnsigned(0), false
+		return PillNumeric(0), false
 	}
 	return v, true
 }
 
-// MarshalBinary implements the encoding.BinaryMarshaler interface for PillUnsigned.
-func (_p PillUnsigned) MarshalBinary() ([]byte, error) {
-	if err := _p.Validate(); err != nil {
-		return nil, fmt.Errorf("Cannot marshal value %q as PillUnsigned. %w", _p, err)
-	}
-	return []byte(_p.String()), nil
+// MarshalBinary implements the encoding.BinaryMarshaler interface for PillNumeric.
+func (_p PillNumeric) MarshalBinary() ([]byte, error) {
+	return _p.AppendBinary(nil)
 }
 
-// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface for PillUnsigned.
-func (_p *PillUnsigned) UnmarshalBinary(text []byte) error {
-	str := string(text)
-	if len(str) == 0 {
-		return fmt.Errorf("PillUnsigned cannot be derived from empty string")
-	}
-
-	var ok bool
-	*_p, ok = PillUnsignedFromString(str)
-	if !ok {
-		return fmt.Errorf("Value %q does not represent a PillUnsigned", str)
+// AppendBinary implements the encoding.BinaryAppender interface for PillNumeric.
+func (_p PillNumeric) AppendBinary(b []byte) ([]byte, error) {
+	if err := _p.Validate(); err != nil {
+		return b, fmt.Errorf("Cannot marshal value %q as PillNumeric. %w", _p, err)
 	}
-	return nil
-}
-
-// MarshalGQL implements the graphql.Marshaler interface for PillUnsigned.
-func (_p PillUnsigned) MarshalGQL(w io.Writer) {
-	fmt.Fprint(w, strconv.Quote(_p.String()))
+	return append(b, _p.String()...), nil
 }
 
-// UnmarshalGQL implements the graphql.Unmarshaler interface for PillUnsigned.
-func (_p *PillUnsigned) UnmarshalGQL(value interface{}) error {
-	var str string
-	switch v := value.(type) {
-	case []byte:
-		str = string(v)
-	case string:
-		str = v
-	case fmt.Stringer:
-		str = v.String()
-	default:
-		return fmt.Errorf("invalid value of PillUnsigned: %[1]T(%[1]v)", value)
-	}
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface for PillNumeric.
+func (_p *PillNumeric) UnmarshalBinary(text []byte) error {
+	str := string(text)
 	if len(str) == 0 {
-		return fmt.Errorf("PillUnsigned cannot be derived from empty string")
+		return fmt.Errorf("PillNumeric cannot be derived from empty string")
 	}
 
 	var ok bool
-	*_p, ok = PillUnsignedFromString(str)
+	*_p, ok = PillNumericFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a PillUnsigned", str)
+		return _enumer.NewParseError("PillNumeric", str, PillNumericStrings())
 	}
 	return nil
 }
 
-// MarshalJSON implements the json.Marshaler interface for PillUnsigned.
-func (_p PillUnsigned) MarshalJSON() ([]byte, error) {
+// MarshalJSON implements the json.Marshaler interface for PillNumeric.
+func (_p PillNumeric) MarshalJSON() ([]byte, error) {
 	if err := _p.Validate(); err != nil {
-		return nil, fmt.Errorf("Cannot marshal value %q as PillUnsigned. %w", _p, err)
+		return nil, fmt.Errorf("Cannot marshal value %q as PillNumeric. %w", _p, err)
 	}
 	return json.Marshal(_p.String())
 }
 
-// UnmarshalJSON implements the json.Unmarshaler interface for PillUnsigned.
-func (_p *PillUnsigned) UnmarshalJSON(data []byte) error {
+// UnmarshalJSON implements the json.Unmarshaler interface for PillNumeric.
+func (_p *PillNumeric) UnmarshalJSON(data []byte) error {
 	var str string
 	if err := json.Unmarshal(data, &str); err != nil {
-		return fmt.Errorf("PillUnsigned should be a string, got %q", data)
+		return fmt.Errorf("PillNumeric should be a string, got %q", data)
 	}
 	if len(str) == 0 {
-		return fmt.Errorf("PillUnsigned cannot be derived from empty string")
+		return fmt.Errorf("PillNumeric cannot be derived from empty string")
 	}
 
 	var ok bool
-	*_p, ok = PillUnsignedFromString(str)
+	*_p, ok = PillNumericFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a PillUnsigned", str)
+		return _enumer.NewParseError("PillNumeric", str, PillNumericStrings())
 	}
 	return nil
 }
 
-// Value implements the sql/driver.Valuer interface for PillUnsigned.
-func (_p PillUnsigned) Value() (driver.Value, error) {
+// Value implements the sql/driver.Valuer interface for PillNumeric.
+// The value is stored by its numeric representation.
+func (_p PillNumeric) Value() (driver.Value, error) {
 	if err := _p.Validate(); err != nil {
-		return nil, fmt.Errorf("Cannot serialize value %q as PillUnsigned. %w", _p, err)
+		return nil, fmt.Errorf("Cannot serialize value %q as PillNumeric. %w", _p, err)
 	}
-	return _p.String(), nil
+	return int64(_p), nil
 }
 
-// Scan implements the sql/driver.Scanner interface for PillUnsigned.
-func (_p *PillUnsigned) Scan(value interface{}) error {
-	var str string
+// Scan implements the sql/driver.Scanner interface for PillNumeric.
+func (_p *PillNumeric) Scan(value interface{}) error {
+	var id uint64
 	switch v := value.(type) {
+	case int64:
+		if v < 0 {
+			return fmt.Errorf("PillNumeric(%d) is %w", v, ErrNoValidEnum)
+		}
+		id = uint64(v)
 	case []byte:
-		str = string(v)
+		return _p.Scan(string(v))
 	case string:
-		str = v
-	case fmt.Stringer:
-		str = v.String()
+		parsed, err := strconv.ParseUint(v, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid value of PillNumeric: %w", err)
+		}
+		id = parsed
 	default:
-		return fmt.Errorf("invalid value of PillUnsigned: %[1]T(%[1]v)", value)
-	}
-	if len(str) == 0 {
-		return fmt.Errorf("PillUnsigned cannot be derived from empty string")
+		return fmt.Errorf("invalid value of PillNumeric: %[1]T(%[1]v)", value)
 	}
 
-	var ok bool
-	*_p, ok = PillUnsignedFromString(str)
-	if !ok {
-		return fmt.Errorf("Value %q does not represent a PillUnsigned", str)
+	v := PillNumeric(id)
+	if uint64(v) != id || !v.IsValid() {
+		return fmt.Errorf("PillNumeric(%d) is %w", id, ErrNoValidEnum)
 	}
+	*_p = v
 	return nil
 }
 
-// MarshalText implements the encoding.TextMarshaler interface for PillUnsigned.
-func (_p PillUnsigned) MarshalText() ([]byte, error) {
+// MarshalText implements the encoding.TextMarshaler interface for PillNumeric.
+func (_p PillNumeric) MarshalText() ([]byte, error) {
+	return _p.AppendText(nil)
+}
+
+// AppendText implements the encoding.TextAppender interface for PillNumeric.
+func (_p PillNumeric) AppendText(b []byte) ([]byte, error) {
 	if err := _p.Validate(); err != nil {
-		return nil, fmt.Errorf("Cannot marshal value %q as PillUnsigned. %w", _p, err)
+		return b, fmt.Errorf("Cannot marshal value %q as PillNumeric. %w", _p, err)
 	}
-	return []byte(_p.String()), nil
+	return append(b, _p.String()...), nil
 }
 
-// UnmarshalText implements the encoding.TextUnmarshaler interface for PillUnsigned.
-func (_p *PillUnsigned) UnmarshalText(text []byte) error {
+// UnmarshalText implements the encoding.TextUnmarshaler interface for PillNumeric.
+func (_p *PillNumeric) UnmarshalText(text []byte) error {
 	str := string(text)
 	if len(str) == 0 {
-		return fmt.Errorf("PillUnsigned cannot be derived from empty string")
+		return fmt.Errorf("PillNumeric cannot be derived from empty string")
 	}
 
 	var ok bool
-	*_p, ok = PillUnsignedFromString(str)
+	*_p, ok = PillNumericFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a PillUnsigned", str)
+		return _enumer.NewParseError("PillNumeric", str, PillNumericStrings())
 	}
 	return nil
 }
 
-// MarshalYAML implements a YAML Marshaler for PillUnsigned.
-func (_p PillUnsigned) MarshalYAML() (interface{}, error) {
+// MarshalYAML implements a YAML Marshaler for PillNumeric.
+func (_p PillNumeric) MarshalYAML() (interface{}, error) {
 	if err := _p.Validate(); err != nil {
-		return nil, fmt.Errorf("Cannot marshal value %q as PillUnsigned. %w", _p, err)
+		return nil, fmt.Errorf("Cannot marshal value %q as PillNumeric. %w", _p, err)
 	}
 	return _p.String(), nil
 }
 
-// UnmarshalYAML implements a YAML Unmarshaler for PillUnsigned.
-func (_p *PillUnsigned) UnmarshalYAML(n *yaml.Node) error {
+// UnmarshalYAML implements a YAML Unmarshaler for PillNumeric.
+func (_p *PillNumeric) UnmarshalYAML(n *yaml.Node) error {
 	const stringTag = "!!str"
 	if n.ShortTag() != stringTag {
-		return fmt.Errorf("PillUnsigned must be derived from a string node")
+		return fmt.Errorf("PillNumeric must be derived from a string node")
 	}
 	str := n.Value
 	if len(str) == 0 {
-		return fmt.Errorf("PillUnsigned cannot be derived from empty string")
+		return fmt.Errorf("PillNumeric cannot be derived from empty string")
 	}
 
 	var ok bool
-	*_p, ok = PillUnsignedFromString(str)
+	*_p, ok = PillNumericFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a PillUnsigned", str)
+		return _enumer.NewParseError("PillNumeric", str, PillNumericStrings())
 	}
 	return nil
 }
 
-const (
-	_PillUnsigned16String      = "PLACEBOASPIRINIBUPROFENPARACETAMOLACETAMINOPHENVITAMIN-C"
-	_PillUnsigned16LowerString = "placeboaspirinibuprofenparacetamolacetaminophenvitamin-c"
-)
+// Values returns a slice of all String values of the enum.
+// It implements the ent EnumValues interface. Declare the ent field e.g. as follows:
+//
+//	field.Uint16("pill_numeric").GoType(PillNumeric(0))
+func (PillNumeric) Values() []string {
+	return PillNumericStrings()
+}
 
-var (
-	_PillUnsigned16Values  = [5]PillUnsigned16{0, 1, 2, 3, 4}
-	_PillUnsigned16Strings = [5]string{_PillUnsigned16String[0:7], _PillUnsigned16String[7:14], _PillUnsigned16String[14:23], _PillUnsigned16String[23:34], _PillUnsigned16String[47:56]}
-)
+// GormDataType implements the gorm schema.GormDataTypeInterface for PillNumeric.
+// It is stored by its numeric values.
+func (PillNumeric) GormDataType() string {
+	return "uint"
+}
 
-// _PillUnsigned16NoOp is a compile time assertion.
-// An "invalid argument/out of bounds" compiler error signifies that the enum values have changed.
-// Re-run the enumer command to generate an updated version of PillUnsigned16.
-func _PillUnsigned16NoOp() {
-	var x [1]struct{}
-	_ = x[PillUnsigned16Placebo-(0)]
-	_ = x[PillUnsigned16Aspirin-(1)]
-	_ = x[PillUnsigned16Ibuprofen-(2)]
-	_ = x[PillUnsigned16Paracetamol-(3)]
-	_ = x[PillUnsigned16Acetaminophen-(3)]
-	_ = x[PillUnsigned16VitaminC-(4)]
+// Int64Value implements the pgtype.Int64Valuer interface for PillNumeric.
+func (_p PillNumeric) Int64Value() (pgtype.Int8, error) {
+	if err := _p.Validate(); err != nil {
+		return pgtype.Int8{}, fmt.Errorf("Cannot serialize value %q as PillNumeric. %w", _p, err)
+	}
+	return pgtype.Int8{Int64: int64(_p), Valid: true}, nil
 }
 
-// PillUnsigned16Values returns all values of the enum.
-func PillUnsigned16Values() []PillUnsigned16 {
-	cp := _PillUnsigned16Values
-	return cp[:]
+// ScanInt64 implements the pgtype.Int64Scanner interface for PillNumeric.
+func (_p *PillNumeric) ScanInt64(v pgtype.Int8) error {
+	if !v.Valid {
+		return fmt.Errorf("PillNumeric cannot be derived from NULL")
+	}
+	if v.Int64 < 0 {
+		return fmt.Errorf("PillNumeric(%d) is %w", v.Int64, ErrNoValidEnum)
+	}
+
+	id := uint64(v.Int64)
+	e := PillNumeric(id)
+	if uint64(e) != id || !e.IsValid() {
+		return fmt.Errorf("PillNumeric(%d) is %w", id, ErrNoValidEnum)
+	}
+	*_p = e
+	return nil
 }
 
-// PillUnsigned16Strings returns a slice of all String values of the enum.
-func PillUnsigned16Strings() []string {
-	cp := _PillUnsigned16Strings
-	return cp[:]
+var _PillNumericCompletions = [5]string{
+	"PLACEBO",
+	"ASPIRIN",
+	"IBUPROFEN",
+	"PARACETAMOL",
+	"VITAMIN-C",
 }
 
-// IsValid tests whether the value is a valid enum value.
-func (_p PillUnsigned16) IsValid() bool {
-	return _p >= 0 && _p <= 4
+// PillNumericUsage returns a usage hint of all String values of the enum, less the deprecated values,
+// e.g. for flag descriptions.
+func PillNumericUsage() string {
+	return "one of: " + strings.Join(_PillNumericStrings[:], "|")
 }
 
-// Validate whether the value is within the range of enum values.
-func (_p PillUnsigned16) Validate() error {
-	if !_p.IsValid() {
-		return fmt.Errorf("PillUnsigned16(%d) is %w", _p, ErrNoValidEnum)
+// PillNumericCompletions returns the completion candidates of the enum matching the given prefix,
+// less the deprecated values. Each candidate is formatted as "<value>" or "<value>\t<description>",
+// as expected by shell completions, e.g. of cobra's RegisterFlagCompletionFunc.
+func PillNumericCompletions(toComplete string) []string {
+	out := make([]string, 0, len(_PillNumericCompletions))
+	for _, c := range _PillNumericCompletions {
+		if strings.HasPrefix(c, toComplete) {
+			out = append(out, c)
+		}
 	}
-	return nil
+	return out
 }
 
-// String returns the string of the enum value.
-// If the enum value is invalid, it will produce a string
-// of the following pattern PillUnsigned16(%d) instead.
-func (_p PillUnsigned16) String() string {
-	if !_p.IsValid() {
-		return fmt.Sprintf("PillUnsigned16(%d)", _p)
+// PillNumericSet is a set of PillNumeric values backed by a bitset.
+// The zero value is an empty set.
+type PillNumericSet struct {
+	bits [1]uint64
+}
+
+// NewPillNumericSet returns a set containing the given values.
+// Invalid values are ignored.
+func NewPillNumericSet(values ...PillNumeric) PillNumericSet {
+	var _p PillNumericSet
+	_p.Add(values...)
+	return _p
+}
+
+// Add adds the values to the set. Invalid values are ignored.
+func (_p *PillNumericSet) Add(values ...PillNumeric) {
+	for _, v := range values {
+		if !v.IsValid() {
+			continue
+		}
+		idx := uint64(v) - 0
+		_p.bits[idx/64] |= 1 << (idx % 64)
 	}
-	idx := uint(_p)
-	return _PillUnsigned16Strings[idx]
 }
 
-var (
-	_PillUnsigned16StringToValueMap = map[string]PillUnsigned16{
-		_PillUnsigned16String[0:7]:   PillUnsigned16Placebo,
+// Remove removes the values from the set.
+func (_p *PillNumericSet) Remove(values ...PillNumeric) {
+	for _, v := range values {
+		if !v.IsValid() {
+			continue
+		}
+		idx := uint64(v) - 0
+		_p.bits[idx/64] &^= 1 << (idx % 64)
+	}
+}
+
+// Contains tests whether the value is an element of the set.
+func (_p PillNumericSet) Contains(v PillNumeric) bool {
+	if !v.IsValid() {
+		return false
+	}
+	idx := uint64(v) - 0
+	return _p.bits[idx/64]&(1<<(idx%64)) != 0
+}
+
+// Union returns a set of all values contained in either set.
+func (_p PillNumericSet) Union(other PillNumericSet) PillNumericSet {
+	for idx := range _p.bits {
+		_p.bits[idx] |= other.bits[idx]
+	}
+	return _p
+}
+
+// Intersect returns a set of all values contained in both sets.
+func (_p PillNumericSet) Intersect(other PillNumericSet) PillNumericSet {
+	for idx := range _p.bits {
+		_p.bits[idx] &= other.bits[idx]
+	}
+	return _p
+}
+
+// Difference returns a set of all values contained in the set, but not in the other set.
+func (_p PillNumericSet) Difference(other PillNumericSet) PillNumericSet {
+	for idx := range _p.bits {
+		_p.bits[idx] &^= other.bits[idx]
+	}
+	return _p
+}
+
+// Len returns the count of values in the set.
+func (_p PillNumericSet) Len() int {
+	var n int
+	for _, w := range _p.bits {
+		n += bits.OnesCount64(w)
+	}
+	return n
+}
+
+// All returns all values of the set in ascending order.
+func (_p PillNumericSet) All() []PillNumeric {
+	out := make([]PillNumeric, 0, _p.Len())
+	for idx, w := range _p.bits {
+		for ; w != 0; w &= w - 1 {
+			out = append(out, PillNumeric(uint64(idx*64+bits.TrailingZeros64(w))+0))
+		}
+	}
+	return out
+}
+
+// Strings returns the String values of all values of the set in ascending order.
+func (_p PillNumericSet) Strings() []string {
+	values := _p.All()
+	out := make([]string, len(values))
+	for idx, v := range values {
+		out[idx] = v.String()
+	}
+	return out
+}
+
+// String implements the Stringer interface.
+func (_p PillNumericSet) String() string {
+	return "[" + strings.Join(_p.Strings(), " ") + "]"
+}
+
+// setStrings replaces the values of the set by the values of the given String values.
+func (_p *PillNumericSet) setStrings(strs []string) error {
+	*_p = PillNumericSet{}
+	for _, str := range strs {
+		v, ok := PillNumericFromString(str)
+		if !ok {
+			return _enumer.NewParseError("PillNumeric", str, PillNumericStrings())
+		}
+		_p.Add(v)
+	}
+	return nil
+}
+
+// setJoined replaces the values of the set by the values of a comma-joined
+// list of String values.
+func (_p *PillNumericSet) setJoined(str string) error {
+	if len(str) == 0 {
+		*_p = PillNumericSet{}
+		return nil
+	}
+	return _p.setStrings(strings.Split(str, ","))
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface for PillNumericSet.
+func (_p PillNumericSet) MarshalBinary() ([]byte, error) {
+	return _p.AppendBinary(nil)
+}
+
+// AppendBinary implements the encoding.BinaryAppender interface for PillNumericSet.
+func (_p PillNumericSet) AppendBinary(b []byte) ([]byte, error) {
+	return append(b, strings.Join(_p.Strings(), ",")...), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface for PillNumericSet.
+func (_p *PillNumericSet) UnmarshalBinary(text []byte) error {
+	return _p.setJoined(string(text))
+}
+
+// MarshalJSON implements the json.Marshaler interface for PillNumericSet.
+func (_p PillNumericSet) MarshalJSON() ([]byte, error) {
+	return json.Marshal(_p.Strings())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for PillNumericSet.
+func (_p *PillNumericSet) UnmarshalJSON(data []byte) error {
+	var strs []string
+	if err := json.Unmarshal(data, &strs); err != nil {
+		return fmt.Errorf("PillNumericSet should be an array of strings, got %q", data)
+	}
+	return _p.setStrings(strs)
+}
+
+// Value implements the sql/driver.Valuer interface for PillNumericSet.
+// The set is stored as Postgres array of its numeric values, e.g. `{1,3}`.
+func (_p PillNumericSet) Value() (driver.Value, error) {
+	values := _p.All()
+	elems := make([]string, len(values))
+	for idx, v := range values {
+		elems[idx] = strconv.FormatUint(uint64(v), 10)
+	}
+	return string(_enumer.AppendArray(nil, elems)), nil
+}
+
+// Scan implements the sql/driver.Scanner interface for PillNumericSet.
+// It accepts Postgres arrays of numeric values.
+func (_p *PillNumericSet) Scan(value interface{}) error {
+	var str string
+	switch v := value.(type) {
+	case nil:
+		*_p = PillNumericSet{}
+		return nil
+	case []byte:
+		str = string(v)
+	case string:
+		str = v
+	case fmt.Stringer:
+		str = v.String()
+	default:
+		return fmt.Errorf("invalid value of PillNumericSet: %[1]T(%[1]v)", value)
+	}
+	elems, err := _enumer.ParseArray(str)
+	if err != nil {
+		return fmt.Errorf("invalid value of PillNumericSet: %w", err)
+	}
+	*_p = PillNumericSet{}
+	for _, elem := range elems {
+		id, err := strconv.ParseUint(elem, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid value of PillNumericSet: %w", err)
+		}
+		v := PillNumeric(id)
+		if uint64(v) != id || !v.IsValid() {
+			return fmt.Errorf("PillNumeric(%d) is %w", id, ErrNoValidEnum)
+		}
+		_p.Add(v)
+	}
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface for PillNumericSet.
+func (_p PillNumericSet) MarshalText() ([]byte, error) {
+	return _p.AppendText(nil)
+}
+
+// AppendText implements the encoding.TextAppender interface for PillNumericSet.
+func (_p PillNumericSet) AppendText(b []byte) ([]byte, error) {
+	return append(b, strings.Join(_p.Strings(), ",")...), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface for PillNumericSet.
+func (_p *PillNumericSet) UnmarshalText(text []byte) error {
+	return _p.setJoined(string(text))
+}
+
+// MarshalYAML implements a YAML Marshaler for PillNumericSet.
+func (_p PillNumericSet) MarshalYAML() (interface{}, error) {
+	return _p.Strings(), nil
+}
+
+// UnmarshalYAML implements a YAML Unmarshaler for PillNumericSet.
+func (_p *PillNumericSet) UnmarshalYAML(n *yaml.Node) error {
+	var strs []string
+	if err := n.Decode(&strs); err != nil {
+		return err
+	}
+	return _p.setStrings(strs)
+}
+
+const (
+	_PillUnsignedString = "PLACEBOASPIRINIBUPROFENPARACETAMOLACETAMINOPHENVITAMIN-C"
+)
+
+var (
+	_PillUnsignedValues  = [5]PillUnsigned{0, 1, 2, 3, 4}
+	_PillUnsignedStrings = [5]string{_PillUnsignedString[0:7], _PillUnsignedString[7:14], _PillUnsignedString[14:23], _PillUnsignedString[23:34], _PillUnsignedString[47:56]}
+)
+
+// _PillUnsignedNoOp is a compile time assertion.
+// An "invalid argument/out of bounds" compiler error signifies that the enum values have changed.
+// Re-run the enumer command to generate an updated version of PillUnsigned.
+func _PillUnsignedNoOp() {
+	var x [1]struct{}
+	_ = x[PillUnsignedPlacebo-(0)]
+	_ = x[PillUnsignedAspirin-(1)]
+	_ = x[PillUnsignedIbuprofen-(2)]
+	_ = x[PillUnsignedParacetamol-(3)]
+	_ = x[PillUnsignedAcetaminophen-(3)]
+	_ = x[PillUnsignedVitaminC-(4)]
+}
+
+// PillUnsignedValues returns all values of the enum.
+func PillUnsignedValues() []PillUnsigned {
+	cp := _PillUnsignedValues
+	return cp[:]
+}
+
+// PillUnsignedStrings returns a slice of all String values of the enum.
+func PillUnsignedStrings() []string {
+	cp := _PillUnsignedStrings
+	return cp[:]
+}
+
+// Values returns all values of the enum.
+func (PillUnsigned) Values() []PillUnsigned {
+	return PillUnsignedValues()
+}
+
+// IsValid tests whether the value is a valid enum value.
+func (_p PillUnsigned) IsValid() bool {
+	return _p >= 0 && _p <= 4
+}
+
+// Validate whether the value is within the range of enum values.
+func (_p PillUnsigned) Validate() error {
+	if !_p.IsValid() {
+		return fmt.Errorf("PillUnsigned(%d) is %w", _p, ErrNoValidEnum)
+	}
+	return nil
+}
+
+// String returns the string of the enum value.
+// If the enum value is invalid, it will produce a string
+// of the following pattern PillUnsigned(%d) instead.
+func (_p PillUnsigned) String() string {
+	if !_p.IsValid() {
+		return fmt.Sprintf("PillUnsigned(%d)", _p)
+	}
+	idx := uint(_p)
+	return _PillUnsignedStrings[idx]
+}
+
+// Index returns the position of the value within the ordered values of the enum
+// (ignoring alternative values) or -1 if the value is invalid.
+func (_p PillUnsigned) Index() int {
+	if !_p.IsValid() {
+		return -1
+	}
+	idx := int(_p)
+	return idx
+}
+
+// Compare returns -1, 0 or +1 depending on whether the value orders before,
+// equal to or after the other value. Invalid values order before all valid values.
+func (_p PillUnsigned) Compare(other PillUnsigned) int {
+	a, b := _p.Index(), other.Index()
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return +1
+	}
+	return 0
+}
+
+// Less tests whether the value orders before the other value.
+func (_p PillUnsigned) Less(other PillUnsigned) bool {
+	return _p.Compare(other) < 0
+}
+
+// Next returns the succeeding value in order of the enum.
+// It returns false if the value is invalid or the last value.
+func (_p PillUnsigned) Next() (PillUnsigned, bool) {
+	idx := _p.Index()
+	if idx == -1 || idx+1 == len(_PillUnsignedValues) {
+		return PillUnsigned(0), false
+	}
+	return _PillUnsignedValues[idx+1], true
+}
+
+// Prev returns the preceding value in order of the enum.
+// It returns false if the value is invalid or the first value.
+func (_p PillUnsigned) Prev() (PillUnsigned, bool) {
+	idx := _p.Index()
+	if idx < 1 {
+		return PillUnsigned(0), false
+	}
+	return _PillUnsignedValues[idx-1], true
+}
+
+var (
+	_PillUnsignedStringToValueMap = map[string]PillUnsigned{
+		_PillUnsignedString[0:7]:   PillUnsignedPlacebo,
+		_PillUnsignedString[7:14]:  PillUnsignedAspirin,
+		_PillUnsignedString[14:23]: PillUnsignedIbuprofen,
+		_PillUnsignedString[23:34]: PillUnsignedParacetamol,
+		_PillUnsignedString[34:47]: PillUnsignedAcetaminophen,
+		_PillUnsignedString[47:56]: PillUnsignedVitaminC,
+	}
+)
+
+// _PillUnsignedLookupFold determines the enum value of the string regardless of its case
+// by Unicode simple folding. It scans the String values, as a fold table is only generated
+// for enums with the "ignore-case" feature.
+func _PillUnsignedLookupFold(raw string) (PillUnsigned, bool) {
+	for idx := range _PillUnsignedStrings {
+		if strings.EqualFold(_PillUnsignedStrings[idx], raw) {
+			return _PillUnsignedValues[idx], true
+		}
+	}
+	if strings.EqualFold(_PillUnsignedString[34:47], raw) {
+		return PillUnsignedAcetaminophen, true
+	}
+	return PillUnsigned(0), false
+}
+
+// PillUnsignedFromString determines the enum value with an exact case match.
+func PillUnsignedFromString(raw string) (PillUnsigned, bool) {
+	v, ok := _PillUnsignedStringToValueMap[raw]
+	if !ok {
+		return PillUnsigned(0), false
+	}
+	return v, true
+}
+
+// PillUnsignedFromStringIgnoreCase determines the enum value with a case-insensitive match
+// by Unicode simple folding, regardless of the casing of raw.
+func PillUnsignedFromStringIgnoreCase(raw string) (PillUnsigned, bool) {
+	v, ok := PillUnsignedFromString(raw)
+	if ok {
+		return v, ok
+	}
+	v, ok = _PillUnsignedLookupFold(raw)
+	if !ok {
+		return PillUnsigned(0), false
+	}
+	return v, true
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface for PillUnsigned.
+func (_p PillUnsigned) MarshalBinary() ([]byte, error) {
+	return _p.AppendBinary(nil)
+}
+
+// AppendBinary implements the encoding.BinaryAppender interface for PillUnsigned.
+func (_p PillUnsigned) AppendBinary(b []byte) ([]byte, error) {
+	if err := _p.Validate(); err != nil {
+		return b, fmt.Errorf("Cannot marshal value %q as PillUnsigned. %w", _p, err)
+	}
+	return append(b, _p.String()...), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface for PillUnsigned.
+func (_p *PillUnsigned) UnmarshalBinary(text []byte) error {
+	str := string(text)
+	if len(str) == 0 {
+		return fmt.Errorf("PillUnsigned cannot be derived from empty string")
+	}
+
+	var ok bool
+	*_p, ok = PillUnsignedFromString(str)
+	if !ok {
+		return _enumer.NewParseError("PillUnsigned", str, PillUnsignedStrings())
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface for PillUnsigned.
+func (_p PillUnsigned) MarshalJSON() ([]byte, error) {
+	if err := _p.Validate(); err != nil {
+		return nil, fmt.Errorf("Cannot marshal value %q as PillUnsigned. %w", _p, err)
+	}
+	return json.Marshal(_p.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for PillUnsigned.
+func (_p *PillUnsigned) UnmarshalJSON(data []byte) error {
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return fmt.Errorf("PillUnsigned should be a string, got %q", data)
+	}
+	if len(str) == 0 {
+		return fmt.Errorf("PillUnsigned cannot be derived from empty string")
+	}
+
+	var ok bool
+	*_p, ok = PillUnsignedFromString(str)
+	if !ok {
+		return _enumer.NewParseError("PillUnsigned", str, PillUnsignedStrings())
+	}
+	return nil
+}
+
+// Value implements the sql/driver.Valuer interface for PillUnsigned.
+func (_p PillUnsigned) Value() (driver.Value, error) {
+	if err := _p.Validate(); err != nil {
+		return nil, fmt.Errorf("Cannot serialize value %q as PillUnsigned. %w", _p, err)
+	}
+	return _p.String(), nil
+}
+
+// Scan implements the sql/driver.Scanner interface for PillUnsigned.
+func (_p *PillUnsigned) Scan(value interface{}) error {
+	var str string
+	switch v := value.(type) {
+	case []byte:
+		str = string(v)
+	case string:
+		str = v
+	case fmt.Stringer:
+		str = v.String()
+	default:
+		return fmt.Errorf("invalid value of PillUnsigned: %[1]T(%[1]v)", value)
+	}
+	if len(str) == 0 {
+		return fmt.Errorf("PillUnsigned cannot be derived from empty string")
+	}
+
+	var ok bool
+	*_p, ok = PillUnsignedFromString(str)
+	if !ok {
+		return _enumer.NewParseError("PillUnsigned", str, PillUnsignedStrings())
+	}
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface for PillUnsigned.
+func (_p PillUnsigned) MarshalText() ([]byte, error) {
+	return _p.AppendText(nil)
+}
+
+// AppendText implements the encoding.TextAppender interface for PillUnsigned.
+func (_p PillUnsigned) AppendText(b []byte) ([]byte, error) {
+	if err := _p.Validate(); err != nil {
+		return b, fmt.Errorf("Cannot marshal value %q as PillUnsigned. %w", _p, err)
+	}
+	return append(b, _p.String()...), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface for PillUnsigned.
+func (_p *PillUnsigned) UnmarshalText(text []byte) error {
+	str := string(text)
+	if len(str) == 0 {
+		return fmt.Errorf("PillUnsigned cannot be derived from empty string")
+	}
+
+	var ok bool
+	*_p, ok = PillUnsignedFromString(str)
+	if !ok {
+		return _enumer.NewParseError("PillUnsigned", str, PillUnsignedStrings())
+	}
+	return nil
+}
+
+// MarshalYAML implements a YAML Marshaler for PillUnsigned.
+func (_p PillUnsigned) MarshalYAML() (interface{}, error) {
+	if err := _p.Validate(); err != nil {
+		return nil, fmt.Errorf("Cannot marshal value %q as PillUnsigned. %w", _p, err)
+	}
+	return _p.String(), nil
+}
+
+// UnmarshalYAML implements a YAML Unmarshaler for PillUnsigned.
+func (_p *PillUnsigned) UnmarshalYAML(n *yaml.Node) error {
+	const stringTag = "!!str"
+	if n.ShortTag() != stringTag {
+		return fmt.Errorf("PillUnsigned must be derived from a string node")
+	}
+	str := n.Value
+	if len(str) == 0 {
+		return fmt.Errorf("PillUnsigned cannot be derived from empty string")
+	}
+
+	var ok bool
+	*_p, ok = PillUnsignedFromString(str)
+	if !ok {
+		return _enumer.NewParseError("PillUnsigned", str, PillUnsignedStrings())
+	}
+	return nil
+}
+
+var _PillUnsignedCompletions = [5]string{
+	"PLACEBO",
+	"ASPIRIN",
+	"IBUPROFEN",
+	"PARACETAMOL",
+	"VITAMIN-C",
+}
+
+// PillUnsignedUsage returns a usage hint of all String values of the enum, less the deprecated values,
+// e.g. for flag descriptions.
+func PillUnsignedUsage() string {
+	return "one of: " + strings.Join(_PillUnsignedStrings[:], "|")
+}
+
+// PillUnsignedCompletions returns the completion candidates of the enum matching the given prefix,
+// less the deprecated values. Each candidate is formatted as "<value>" or "<value>\t<description>",
+// as expected by shell completions, e.g. of cobra's RegisterFlagCompletionFunc.
+func PillUnsignedCompletions(toComplete string) []string {
+	out := make([]string, 0, len(_PillUnsignedCompletions))
+	for _, c := range _PillUnsignedCompletions {
+		if strings.HasPrefix(c, toComplete) {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+const (
+	_PillUnsigned16String = "PLACEBOASPIRINIBUPROFENPARACETAMOLACETAMINOPHENVITAMIN-C"
+)
+
+var (
+	_PillUnsigned16Values  = [5]PillUnsigned16{0, 1, 2, 3, 4}
+	_PillUnsigned16Strings = [5]string{_PillUnsigned16String[0:7], _PillUnsigned16String[7:14], _PillUnsigned16String[14:23], _PillUnsigned16String[23:34], _PillUnsigned16String[47:56]}
+)
+
+// _PillUnsigned16NoOp is a compile time assertion.
+// An "invalid argument/out of bounds" compiler error signifies that the enum values have changed.
+// Re-run the enumer command to generate an updated version of PillUnsigned16.
+func _PillUnsigned16NoOp() {
+	var x [1]struct{}
+	_ = x[PillUnsigned16Placebo-(0)]
+	_ = x[PillUnsigned16Aspirin-(1)]
+	_ = x[PillUnsigned16Ibuprofen-(2)]
+	_ = x[PillUnsigned16Paracetamol-(3)]
+	_ = x[PillUnsigned16Acetaminophen-(3)]
+	_ = x[PillUnsigned16VitaminC-(4)]
+}
+
+// PillUnsigned16Values returns all values of the enum.
+func PillUnsigned16Values() []PillUnsigned16 {
+	cp := _PillUnsigned16Values
+	return cp[:]
+}
+
+// PillUnsigned16Strings returns a slice of all String values of the enum.
+func PillUnsigned16Strings() []string {
+	cp := _PillUnsigned16Strings
+	return cp[:]
+}
+
+// Values returns all values of the enum.
+func (PillUnsigned16) Values() []PillUnsigned16 {
+	return PillUnsigned16Values()
+}
+
+// IsValid tests whether the value is a valid enum value.
+func (_p PillUnsigned16) IsValid() bool {
+	return _p >= 0 && _p <= 4
+}
+
+// Validate whether the value is within the range of enum values.
+func (_p PillUnsigned16) Validate() error {
+	if !_p.IsValid() {
+		return fmt.Errorf("PillUnsigned16(%d) is %w", _p, ErrNoValidEnum)
+	}
+	return nil
+}
+
+// String returns the string of the enum value.
+// If the enum value is invalid, it will produce a string
+// of the following pattern PillUnsigned16(%d) instead.
+func (_p PillUnsigned16) String() string {
+	if !_p.IsValid() {
+		return fmt.Sprintf("PillUnsigned16(%d)", _p)
+	}
+	idx := uint(_p)
+	return _PillUnsigned16Strings[idx]
+}
+
+// Index returns the position of the value within the ordered values of the enum
+// (ignoring alternative values) or -1 if the value is invalid.
+func (_p PillUnsigned16) Index() int {
+	if !_p.IsValid() {
+		return -1
+	}
+	idx := int(_p)
+	return idx
+}
+
+// Compare returns -1, 0 or +1 depending on whether the value orders before,
+// equal to or after the other value. Invalid values order before all valid values.
+func (_p PillUnsigned16) Compare(other PillUnsigned16) int {
+	a, b := _p.Index(), other.Index()
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return +1
+	}
+	return 0
+}
+
+// Less tests whether the value orders before the other value.
+func (_p PillUnsigned16) Less(other PillUnsigned16) bool {
+	return _p.Compare(other) < 0
+}
+
+// Next returns the succeeding value in order of the enum.
+// It returns false if the value is invalid or the last value.
+func (_p PillUnsigned16) Next() (PillUnsigned16, bool) {
+	idx := _p.Index()
+	if idx == -1 || idx+1 == len(_PillUnsigned16Values) {
+		return PillUnsigned16(0), false
+	}
+	return _PillUnsigned16Values[idx+1], true
+}
+
+// Prev returns the preceding value in order of the enum.
+// It returns false if the value is invalid or the first value.
+func (_p PillUnsigned16) Prev() (PillUnsigned16, bool) {
+	idx := _p.Index()
+	if idx < 1 {
+		return PillUnsigned16(0), false
+	}
+	return _PillUnsigned16Values[idx-1], true
+}
+
+var (
+	_PillUnsigned16StringToValueMap = map[string]PillUnsigned16{
+		_PillUnsigned16String[0:7]:   PillUnsigned16Placebo,
 		_PillUnsigned16String[7:14]:  PillUnsigned16Aspirin,
 		_PillUnsigned16String[14:23]: PillUnsigned16Ibuprofen,
 		_PillUnsigned16String[23:34]: PillUnsigned16Paracetamol,
 		_PillUnsigned16String[34:47]: PillUnsigned16Acetaminophen,
 		_PillUnsigned16String[47:56]: PillUnsigned16VitaminC,
 	}
-	_PillUnsigned16LowerStringToValueMap = map[string]PillUnsigned16{
-		_PillUnsigned16LowerString[0:7]:   PillUnsigned16Placebo,
-		_PillUnsigned16LowerString[7:14]:  PillUnsigned16Aspirin,
-		_PillUnsigned16LowerString[14:23]: PillUnsigned16Ibuprofen,
-		_PillUnsigned16LowerString[23:34]: PillUnsigned16Paracetamol,
-		_PillUnsigned16LowerString[34:47]: PillUnsigned16Acetaminophen,
-		_PillUnsigned16LowerString[47:56]: PillUnsigned16VitaminC,
+)
+
+// _PillUnsigned16LookupFold determines the enum value of the string regardless of its case
+// by Unicode simple folding. It scans the String values, as a fold table is only generated
+// for enums with the "ignore-case" feature.
+func _PillUnsigned16LookupFold(raw string) (PillUnsigned16, bool) {
+	for idx := range _PillUnsigned16Strings {
+		if strings.EqualFold(_PillUnsigned16Strings[idx], raw) {
+			return _PillUnsigned16Values[idx], true
+		}
+	}
+	if strings.EqualFold(_PillUnsigned16String[34:47], raw) {
+		return PillUnsigned16Acetaminophen, true
+	}
+	return PillUnsigned16(0), false
+}
+
+// PillUnsigned16FromString determines the enum value with an exact case match.
+func PillUnsigned16FromString(raw string) (PillUnsigned16, bool) {
+	v, ok := _PillUnsigned16StringToValueMap[raw]
+	if !ok {
+		return PillUnsigned16(0), false
+	}
+	return v, true
+}
+
+// PillUnsigned16FromStringIgnoreCase determines the enum value with a case-insensitive match
+// by Unicode simple folding, regardless of the casing of raw.
+func PillUnsigned16FromStringIgnoreCase(raw string) (PillUnsigned16, bool) {
+	v, ok := PillUnsigned16FromString(raw)
+	if ok {
+		return v, ok
+	}
+	v, ok = _PillUnsigned16LookupFold(raw)
+	if !ok {
+		return PillUnsigned16(0), false
+	}
+	return v, true
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface for PillUnsigned16.
+func (_p PillUnsigned16) MarshalBinary() ([]byte, error) {
+	return _p.AppendBinary(nil)
+}
+
+// AppendBinary implements the encoding.BinaryAppender interface for PillUnsigned16.
+func (_p PillUnsigned16) AppendBinary(b []byte) ([]byte, error) {
+	if err := _p.Validate(); err != nil {
+		return b, fmt.Errorf("Cannot marshal value %q as PillUnsigned16. %w", _p, err)
+	}
+	return append(b, _p.String()...), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface for PillUnsigned16.
+func (_p *PillUnsigned16) UnmarshalBinary(text []byte) error {
+	str := string(text)
+	if len(str) == 0 {
+		return fmt.Errorf("PillUnsigned16 cannot be derived from empty string")
+	}
+
+	var ok bool
+	*_p, ok = PillUnsigned16FromString(str)
+	if !ok {
+		return _enumer.NewParseError("PillUnsigned16", str, PillUnsigned16Strings())
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface for PillUnsigned16.
+func (_p PillUnsigned16) MarshalJSON() ([]byte, error) {
+	if err := _p.Validate(); err != nil {
+		return nil, fmt.Errorf("Cannot marshal value %q as PillUnsigned16. %w", _p, err)
+	}
+	return json.Marshal(_p.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for PillUnsigned16.
+func (_p *PillUnsigned16) UnmarshalJSON(data []byte) error {
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return fmt.Errorf("PillUnsigned16 should be a string, got %q", data)
+	}
+	if len(str) == 0 {
+		return fmt.Errorf("PillUnsigned16 cannot be derived from empty string")
+	}
+
+	var ok bool
+	*_p, ok = PillUnsigned16FromString(str)
+	if !ok {
+		return _enumer.NewParseError("PillUnsigned16", str, PillUnsigned16Strings())
+	}
+	return nil
+}
+
+// Value implements the sql/driver.Valuer interface for PillUnsigned16.
+func (_p PillUnsigned16) Value() (driver.Value, error) {
+	if err := _p.Validate(); err != nil {
+		return nil, fmt.Errorf("Cannot serialize value %q as PillUnsigned16. %w", _p, err)
+	}
+	return _p.String(), nil
+}
+
+// Scan implements the sql/driver.Scanner interface for PillUnsigned16.
+func (_p *PillUnsigned16) Scan(value interface{}) error {
+	var str string
+	switch v := value.(type) {
+	case []byte:
+		str = string(v)
+	case string:
+		str = v
+	case fmt.Stringer:
+		str = v.String()
+	default:
+		return fmt.Errorf("invalid value of PillUnsigned16: %[1]T(%[1]v)", value)
+	}
+	if len(str) == 0 {
+		return fmt.Errorf("PillUnsigned16 cannot be derived from empty string")
+	}
+
+	var ok bool
+	*_p, ok = PillUnsigned16FromString(str)
+	if !ok {
+		return _enumer.NewParseError("PillUnsigned16", str, PillUnsigned16Strings())
+	}
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface for PillUnsigned16.
+func (_p PillUnsigned16) MarshalText() ([]byte, error) {
+	return _p.AppendText(nil)
+}
+
+// AppendText implements the encoding.TextAppender interface for PillUnsigned16.
+func (_p PillUnsigned16) AppendText(b []byte) ([]byte, error) {
+	if err := _p.Validate(); err != nil {
+		return b, fmt.Errorf("Cannot marshal value %q as PillUnsigned16. %w", _p, err)
+	}
+	return append(b, _p.String()...), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface for PillUnsigned16.
+func (_p *PillUnsigned16) UnmarshalText(text []byte) error {
+	str := string(text)
+	if len(str) == 0 {
+		return fmt.Errorf("PillUnsigned16 cannot be derived from empty string")
+	}
+
+	var ok bool
+	*_p, ok = PillUnsigned16FromString(str)
+	if !ok {
+		return _enumer.NewParseError("PillUnsigned16", str, PillUnsigned16Strings())
+	}
+	return nil
+}
+
+// MarshalYAML implements a YAML Marshaler for PillUnsigned16.
+func (_p PillUnsigned16) MarshalYAML() (interface{}, error) {
+	if err := _p.Validate(); err != nil {
+		return nil, fmt.Errorf("Cannot marshal value %q as PillUnsigned16. %w", _p, err)
+	}
+	return _p.String(), nil
+}
+
+// UnmarshalYAML implements a YAML Unmarshaler for PillUnsigned16.
+func (_p *PillUnsigned16) UnmarshalYAML(n *yaml.Node) error {
+	const stringTag = "!!str"
+	if n.ShortTag() != stringTag {
+		return fmt.Errorf("PillUnsigned16 must be derived from a string node")
+	}
+	str := n.Value
+	if len(str) == 0 {
+		return fmt.Errorf("PillUnsigned16 cannot be derived from empty string")
+	}
+
+	var ok bool
+	*_p, ok = PillUnsigned16FromString(str)
+	if !ok {
+		return _enumer.NewParseError("PillUnsigned16", str, PillUnsigned16Strings())
+	}
+	return nil
+}
+
+var _PillUnsigned16Completions = [5]string{
+	"PLACEBO",
+	"ASPIRIN",
+	"IBUPROFEN",
+	"PARACETAMOL",
+	"VITAMIN-C",
+}
+
+// PillUnsigned16Usage returns a usage hint of all String values of the enum, less the deprecated values,
+// e.g. for flag descriptions.
+func PillUnsigned16Usage() string {
+	return "one of: " + strings.Join(_PillUnsigned16Strings[:], "|")
+}
+
+// PillUnsigned16Completions returns the completion candidates of the enum matching the given prefix,
+// less the deprecated values. Each candidate is formatted as "<value>" or "<value>\t<description>",
+// as expected by shell completions, e.g. of cobra's RegisterFlagCompletionFunc.
+func PillUnsigned16Completions(toComplete string) []string {
+	out := make([]string, 0, len(_PillUnsigned16Completions))
+	for _, c := range _PillUnsigned16Completions {
+		if strings.HasPrefix(c, toComplete) {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+const (
+	_PillUnsigned32String = "PLACEBOASPIRINIBUPROFENPARACETAMOLACETAMINOPHENVITAMIN-C"
+)
+
+var (
+	_PillUnsigned32Values  = [5]PillUnsigned32{0, 1, 2, 3, 4}
+	_PillUnsigned32Strings = [5]string{_PillUnsigned32String[0:7], _PillUnsigned32String[7:14], _PillUnsigned32String[14:23], _PillUnsigned32String[23:34], _PillUnsigned32String[47:56]}
+)
+
+// _PillUnsigned32NoOp is a compile time assertion.
+// An "invalid argument/out of bounds" compiler error signifies that the enum values have changed.
+// Re-run the enumer command to generate an updated version of PillUnsigned32.
+func _PillUnsigned32NoOp() {
+	var x [1]struct{}
+	_ = x[PillUnsigned32Placebo-(0)]
+	_ = x[PillUnsigned32Aspirin-(1)]
+	_ = x[PillUnsigned32Ibuprofen-(2)]
+	_ = x[PillUnsigned32Paracetamol-(3)]
+	_ = x[PillUnsigned32Acetaminophen-(3)]
+	_ = x[PillUnsigned32VitaminC-(4)]
+}
+
+// PillUnsigned32Values returns all values of the enum.
+func PillUnsigned32Values() []PillUnsigned32 {
+	cp := _PillUnsigned32Values
+	return cp[:]
+}
+
+// PillUnsigned32Strings returns a slice of all String values of the enum.
+func PillUnsigned32Strings() []string {
+	cp := _PillUnsigned32Strings
+	return cp[:]
+}
+
+// IsValid tests whether the value is a valid enum value.
+func (_p PillUnsigned32) IsValid() bool {
+	return _p >= 0 && _p <= 4
+}
+
+// Validate whether the value is within the range of enum values.
+func (_p PillUnsigned32) Validate() error {
+	if !_p.IsValid() {
+		return fmt.Errorf("PillUnsigned32(%d) is %w", _p, ErrNoValidEnum)
+	}
+	return nil
+}
+
+// String returns the string of the enum value.
+// If the enum value is invalid, it will produce a string
+// of the following pattern PillUnsigned32(%d) instead.
+func (_p PillUnsigned32) String() string {
+	if !_p.IsValid() {
+		return fmt.Sprintf("PillUnsigned32(%d)", _p)
+	}
+	idx := uint(_p)
+	return _PillUnsigned32Strings[idx]
+}
+
+// Index returns the position of the value within the ordered values of the enum
+// (ignoring alternative values) or -1 if the value is invalid.
+func (_p PillUnsigned32) Index() int {
+	if !_p.IsValid() {
+		return -1
+	}
+	idx := int(_p)
+	return idx
+}
+
+// Compare returns -1, 0 or +1 depending on whether the value orders before,
+// equal to or after the other value. Invalid values order before all valid values.
+func (_p PillUnsigned32) Compare(other PillUnsigned32) int {
+	a, b := _p.Index(), other.Index()
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return +1
+	}
+	return 0
+}
+
+// Less tests whether the value orders before the other value.
+func (_p PillUnsigned32) Less(other PillUnsigned32) bool {
+	return _p.Compare(other) < 0
+}
+
+// Next returns the succeeding value in order of the enum.
+// It returns false if the value is invalid or the last value.
+func (_p PillUnsigned32) Next() (PillUnsigned32, bool) {
+	idx := _p.Index()
+	if idx == -1 || idx+1 == len(_PillUnsigned32Values) {
+		return PillUnsigned32(0), false
+	}
+	return _PillUnsigned32Values[idx+1], true
+}
+
+// Prev returns the preceding value in order of the enum.
+// It returns false if the value is invalid or the first value.
+func (_p PillUnsigned32) Prev() (PillUnsigned32, bool) {
+	idx := _p.Index()
+	if idx < 1 {
+		return PillUnsigned32(0), false
+	}
+	return _PillUnsigned32Values[idx-1], true
+}
+
+var (
+	_PillUnsigned32StringToValueMap = map[string]PillUnsigned32{
+		_PillUnsigned32String[0:7]:   PillUnsigned32Placebo,
+		_PillUnsigned32String[7:14]:  PillUnsigned32Aspirin,
+		_PillUnsigned32String[14:23]: PillUnsigned32Ibuprofen,
+		_PillUnsigned32String[23:34]: PillUnsigned32Paracetamol,
+		_PillUnsigned32String[34:47]: PillUnsigned32Acetaminophen,
+		_PillUnsigned32String[47:56]: PillUnsigned32VitaminC,
+	}
+)
+
+// _PillUnsigned32LookupFold determines the enum value of the string regardless of its case
+// by Unicode simple folding. It scans the String values, as a fold table is only generated
+// for enums with the "ignore-case" feature.
+func _PillUnsigned32LookupFold(raw string) (PillUnsigned32, bool) {
+	for idx := range _PillUnsigned32Strings {
+		if strings.EqualFold(_PillUnsigned32Strings[idx], raw) {
+			return _PillUnsigned32Values[idx], true
+		}
+	}
+	if strings.EqualFold(_PillUnsigned32String[34:47], raw) {
+		return PillUnsigned32Acetaminophen, true
+	}
+	return PillUnsigned32(0), false
+}
+
+// PillUnsigned32FromString determines the enum value with an exact case match.
+func PillUnsigned32FromString(raw string) (PillUnsigned32, bool) {
+	v, ok := _PillUnsigned32StringToValueMap[raw]
+	if !ok {
+		return PillUnsigned32(0), false
+	}
+	return v, true
+}
+
+// PillUnsigned32FromStringIgnoreCase determines the enum value with a case-insensitive match
+// by Unicode simple folding, regardless of the casing of raw.
+func PillUnsigned32FromStringIgnoreCase(raw string) (PillUnsigned32, bool) {
+	v, ok := PillUnsigned32FromString(raw)
+	if ok {
+		return v, ok
+	}
+	v, ok = _PillUnsigned32LookupFold(raw)
+	if !ok {
+		return PillUnsigned32(0), false
+	}
+	return v, true
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface for PillUnsigned32.
+func (_p PillUnsigned32) MarshalBinary() ([]byte, error) {
+	return _p.AppendBinary(nil)
+}
+
+// AppendBinary implements the encoding.BinaryAppender interface for PillUnsigned32.
+func (_p PillUnsigned32) AppendBinary(b []byte) ([]byte, error) {
+	if err := _p.Validate(); err != nil {
+		return b, fmt.Errorf("Cannot marshal value %q as PillUnsigned32. %w", _p, err)
+	}
+	return append(b, _p.String()...), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface for PillUnsigned32.
+func (_p *PillUnsigned32) UnmarshalBinary(text []byte) error {
+	str := string(text)
+	if len(str) == 0 {
+		return fmt.Errorf("PillUnsigned32 cannot be derived from empty string")
+	}
+
+	var ok bool
+	*_p, ok = PillUnsigned32FromString(str)
+	if !ok {
+		return _enumer.NewParseError("PillUnsigned32", str, PillUnsigned32Strings())
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface for PillUnsigned32.
+func (_p PillUnsigned32) MarshalJSON() ([]byte, error) {
+	if err := _p.Validate(); err != nil {
+		return nil, fmt.Errorf("Cannot marshal value %q as PillUnsigned32. %w", _p, err)
+	}
+	return json.Marshal(_p.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for PillUnsigned32.
+func (_p *PillUnsigned32) UnmarshalJSON(data []byte) error {
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return fmt.Errorf("PillUnsigned32 should be a string, got %q", data)
+	}
+	if len(str) == 0 {
+		return fmt.Errorf("PillUnsigned32 cannot be derived from empty string")
+	}
+
+	var ok bool
+	*_p, ok = PillUnsigned32FromString(str)
+	if !ok {
+		return _enumer.NewParseError("PillUnsigned32", str, PillUnsigned32Strings())
+	}
+	return nil
+}
+
+// Value implements the sql/driver.Valuer interface for PillUnsigned32.
+func (_p PillUnsigned32) Value() (driver.Value, error) {
+	if err := _p.Validate(); err != nil {
+		return nil, fmt.Errorf("Cannot serialize value %q as PillUnsigned32. %w", _p, err)
+	}
+	return _p.String(), nil
+}
+
+// Scan implements the sql/driver.Scanner interface for PillUnsigned32.
+func (_p *PillUnsigned32) Scan(value interface{}) error {
+	var str string
+	switch v := value.(type) {
+	case []byte:
+		str = string(v)
+	case string:
+		str = v
+	case fmt.Stringer:
+		str = v.String()
+	default:
+		return fmt.Errorf("invalid value of PillUnsigned32: %[1]T(%[1]v)", value)
+	}
+	if len(str) == 0 {
+		return fmt.Errorf("PillUnsigned32 cannot be derived from empty string")
+	}
+
+	var ok bool
+	*_p, ok = PillUnsigned32FromString(str)
+	if !ok {
+		return _enumer.NewParseError("PillUnsigned32", str, PillUnsigned32Strings())
+	}
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface for PillUnsigned32.
+func (_p PillUnsigned32) MarshalText() ([]byte, error) {
+	return _p.AppendText(nil)
+}
+
+// AppendText implements the encoding.TextAppender interface for PillUnsigned32.
+func (_p PillUnsigned32) AppendText(b []byte) ([]byte, error) {
+	if err := _p.Validate(); err != nil {
+		return b, fmt.Errorf("Cannot marshal value %q as PillUnsigned32. %w", _p, err)
+	}
+	return append(b, _p.String()...), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface for PillUnsigned32.
+func (_p *PillUnsigned32) UnmarshalText(text []byte) error {
+	str := string(text)
+	if len(str) == 0 {
+		return fmt.Errorf("PillUnsigned32 cannot be derived from empty string")
+	}
+
+	var ok bool
+	*_p, ok = PillUnsigned32FromString(str)
+	if !ok {
+		return _enumer.NewParseError("PillUnsigned32", str, PillUnsigned32Strings())
+	}
+	return nil
+}
+
+// MarshalYAML implements a YAML Marshaler for PillUnsigned32.
+func (_p PillUnsigned32) MarshalYAML() (interface{}, error) {
+	if err := _p.Validate(); err != nil {
+		return nil, fmt.Errorf("Cannot marshal value %q as PillUnsigned32. %w", _p, err)
+	}
+	return _p.String(), nil
+}
+
+// UnmarshalYAML implements a YAML Unmarshaler for PillUnsigned32.
+func (_p *PillUnsigned32) UnmarshalYAML(n *yaml.Node) error {
+	const stringTag = "!!str"
+	if n.ShortTag() != stringTag {
+		return fmt.Errorf("PillUnsigned32 must be derived from a string node")
+	}
+	str := n.Value
+	if len(str) == 0 {
+		return fmt.Errorf("PillUnsigned32 cannot be derived from empty string")
+	}
+
+	var ok bool
+	*_p, ok = PillUnsigned32FromString(str)
+	if !ok {
+		return _enumer.NewParseError("PillUnsigned32", str, PillUnsigned32Strings())
+	}
+	return nil
+}
+
+// Values returns a slice of all String values of the enum.
+// It implements the ent EnumValues interface. Declare the ent field e.g. as follows:
+//
+//	field.Enum("pill_unsigned32").GoType(PillUnsigned32(0))
+func (PillUnsigned32) Values() []string {
+	return PillUnsigned32Strings()
+}
+
+// GormDataType implements the gorm schema.GormDataTypeInterface for PillUnsigned32.
+// It is stored by its String values.
+func (PillUnsigned32) GormDataType() string {
+	return "string"
+}
+
+// GormDBDataType implements the gorm migrator.GormDataTypeInterface for PillUnsigned32.
+// The column is sized to fit the longest String value.
+func (PillUnsigned32) GormDBDataType(db *gorm.DB, field *schema.Field) string {
+	return "varchar(13)"
+}
+
+// RegisterPillUnsigned32PgxType loads the Postgres enum type of the given name
+// and its array type, e.g. "pill_unsigned32" and "_pill_unsigned32", and registers them
+// with the type map of the connection, e.g. within the AfterConnect hook of a pgxpool.
+func RegisterPillUnsigned32PgxType(ctx context.Context, conn *pgx.Conn, typeName string) error {
+	for _, name := range []string{typeName, "_" + typeName} {
+		t, err := conn.LoadType(ctx, name)
+		if err != nil {
+			return fmt.Errorf("failed loading Postgres type %q of PillUnsigned32. err: %w", name, err)
+		}
+		conn.TypeMap().RegisterType(t)
+	}
+	return nil
+}
+
+// TextValue implements the pgtype.TextValuer interface for PillUnsigned32.
+func (_p PillUnsigned32) TextValue() (pgtype.Text, error) {
+	if err := _p.Validate(); err != nil {
+		return pgtype.Text{}, fmt.Errorf("Cannot serialize value %q as PillUnsigned32. %w", _p, err)
+	}
+	return pgtype.Text{String: _p.String(), Valid: true}, nil
+}
+
+// ScanText implements the pgtype.TextScanner interface for PillUnsigned32.
+func (_p *PillUnsigned32) ScanText(v pgtype.Text) error {
+	if !v.Valid || len(v.String) == 0 {
+		return fmt.Errorf("PillUnsigned32 cannot be derived from NULL or empty string")
+	}
+
+	var ok bool
+	*_p, ok = PillUnsigned32FromString(v.String)
+	if !ok {
+		return _enumer.NewParseError("PillUnsigned32", v.String, PillUnsigned32Strings())
+	}
+	return nil
+}
+
+var _PillUnsigned32Completions = [5]string{
+	"PLACEBO",
+	"ASPIRIN",
+	"IBUPROFEN",
+	"PARACETAMOL",
+	"VITAMIN-C",
+}
+
+// PillUnsigned32Usage returns a usage hint of all String values of the enum, less the deprecated values,
+// e.g. for flag descriptions.
+func PillUnsigned32Usage() string {
+	return "one of: " + strings.Join(_PillUnsigned32Strings[:], "|")
+}
+
+// PillUnsigned32Completions returns the completion candidates of the enum matching the given prefix,
+// less the deprecated values. Each candidate is formatted as "<value>" or "<value>\t<description>",
+// as expected by shell completions, e.g. of cobra's RegisterFlagCompletionFunc.
+func PillUnsigned32Completions(toComplete string) []string {
+	out := make([]string, 0, len(_PillUnsigned32Completions))
+	for _, c := range _PillUnsigned32Completions {
+		if strings.HasPrefix(c, toComplete) {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+const (
+	_PillUnsigned64String = "PLACEBOASPIRINIBUPROFENPARACETAMOLACETAMINOPHENVITAMIN-C"
+)
+
+var (
+	_PillUnsigned64Values  = [5]PillUnsigned64{0, 1, 2, 3, 4}
+	_PillUnsigned64Strings = [5]string{_PillUnsigned64String[0:7], _PillUnsigned64String[7:14], _PillUnsigned64String[14:23], _PillUnsigned64String[23:34], _PillUnsigned64String[47:56]}
+)
+
+// _PillUnsigned64NoOp is a compile time assertion.
+// An "invalid argument/out of bounds" compiler error signifies that the enum values have changed.
+// Re-run the enumer command to generate an updated version of PillUnsigned64.
+func _PillUnsigned64NoOp() {
+	var x [1]struct{}
+	_ = x[PillUnsigned64Placebo-(0)]
+	_ = x[PillUnsigned64Aspirin-(1)]
+	_ = x[PillUnsigned64Ibuprofen-(2)]
+	_ = x[PillUnsigned64Paracetamol-(3)]
+	_ = x[PillUnsigned64Acetaminophen-(3)]
+	_ = x[PillUnsigned64VitaminC-(4)]
+}
+
+// PillUnsigned64Values returns all values of the enum.
+func PillUnsigned64Values() []PillUnsigned64 {
+	cp := _PillUnsigned64Values
+	return cp[:]
+}
+
+// PillUnsigned64Strings returns a slice of all String values of the enum.
+func PillUnsigned64Strings() []string {
+	cp := _PillUnsigned64Strings
+	return cp[:]
+}
+
+// Values returns all values of the enum.
+func (PillUnsigned64) Values() []PillUnsigned64 {
+	return PillUnsigned64Values()
+}
+
+// IsValid tests whether the value is a valid enum value.
+func (_p PillUnsigned64) IsValid() bool {
+	return _p >= 0 && _p <= 4
+}
+
+// Validate whether the value is within the range of enum values.
+func (_p PillUnsigned64) Validate() error {
+	if !_p.IsValid() {
+		return fmt.Errorf("PillUnsigned64(%d) is %w", _p, ErrNoValidEnum)
+	}
+	return nil
+}
+
+// String returns the string of the enum value.
+// If the enum value is invalid, it will produce a string
+// of the following pattern PillUnsigned64(%d) instead.
+func (_p PillUnsigned64) String() string {
+	if !_p.IsValid() {
+		return fmt.Sprintf("PillUnsigned64(%d)", _p)
+	}
+	idx := uint(_p)
+	return _PillUnsigned64Strings[idx]
+}
+
+// Index returns the position of the value within the ordered values of the enum
+// (ignoring alternative values) or -1 if the value is invalid.
+func (_p PillUnsigned64) Index() int {
+	if !_p.IsValid() {
+		return -1
 	}
-)
+	idx := int(_p)
+	return idx
+}
 
-// PillUnsigned16FromString determines the enum value with an exact case match.
-func PillUnsigned16FromString(raw string) (PillUnsigned16, bool) {
-	v, ok := _PillUnsigned16StringToValueMap[raw]
-	if !ok {
-		return PillUnsigned16(0), false
+// Compare returns -1, 0 or +1 depending on whether the value orders before,
+// equal to or after the other value. Invalid values order before all valid values.
+func (_p PillUnsigned64) Compare(other PillUnsigned64) int {
+	a, b := _p.Index(), other.Index()
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return +1
 	}
-	return v, true
+	return 0
 }
 
-// PillUnsigned16FromStringIgnoreCase determines the enum value with a case-insensitive match.
-func PillUnsigned16FromStringIgnoreCase(raw string) (PillUnsigned16, bool) {
-	v, ok := PillUnsigned16FromString(raw)
-	if ok {
-		return v, ok
+// Less tests whether the value orders before the other value.
+func (_p PillUnsigned64) Less(other PillUnsigned64) bool {
+	return _p.Compare(other) < 0
+}
+
+// Next returns the succeeding value in order of the enum.
+// It returns false if the value is invalid or the last value.
+func (_p PillUnsigned64) Next() (PillUnsigned64, bool) {
+	idx := _p.Index()
+	if idx == -1 || idx+1 == len(_PillUnsigned64Values) {
+		return PillUnsigned64(0), false
 	}
-	v, ok = _PillUnsigned16LowerStringToValueMap[raw]
-	if !ok {
-		return PillUnsigned16(0), false
+	return _PillUnsigned64Values[idx+1], true
+}
+
+// Prev returns the preceding value in order of the enum.
+// It returns false if the value is invalid or the first value.
+func (_p PillUnsigned64) Prev() (PillUnsigned64, bool) {
+	idx := _p.Index()
+	if idx < 1 {
+		return PillUnsigned64(0), false
 	}
-	return v, true
+	return _PillUnsigned64Values[idx-1], true
 }
 
-// MarshalBinary implements the encoding.BinaryMarshaler interface for PillUnsigned16.
-func (_p PillUnsigned16) MarshalBinary() ([]byte, error) {
-	if err := _p.Validate(); err != nil {
-		return nil, fmt.Errorf("Cannot marshal value %q as PillUnsigned16. %w", _p, err)
+var (
+	_PillUnsigned64StringToValueMap = map[string]PillUnsigned64{
+		_PillUnsigned64String[0:7]:   PillUnsigned64Placebo,
+		_PillUnsigned64String[7:14]:  PillUnsigned64Aspirin,
+		_PillUnsigned64String[14:23]: PillUnsigned64Ibuprofen,
+		_PillUnsigned64String[23:34]: PillUnsigned64Paracetamol,
+		_PillUnsigned64String[34:47]: PillUnsigned64Acetaminophen,
+		_PillUnsigned64String[47:56]: PillUnsigned64VitaminC,
 	}
-	return []byte(_p.String()), nil
+)
+
+// _PillUnsigned64LookupFold determines the enum value of the string regardless of its case
+// by Unicode simple folding. It scans the String values, as a fold table is only generated
+// for enums with the "ignore-case" feature.
+func _PillUnsigned64LookupFold(raw string) (PillUnsigned64, bool) {
+	for idx := range _PillUnsigned64Strings {
+		if strings.EqualFold(_PillUnsigned64Strings[idx], raw) {
+			return _PillUnsigned64Values[idx], true
+		}
+	}
+	if strings.EqualFold(_PillUnsigned64String[34:47], raw) {
+		return PillUnsigned64Acetaminophen, true
+	}
+	return PillUnsigned64(0), false
 }
 
-// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface for PillUnsigned16.
-func (_p *PillUnsigned16) UnmarshalBinary(text []byte) error {
-	str := string(text)
-	if len(str) == 0 {
-		return fmt.Errorf("PillUnsigned16 cannot be derived from empty string")
+// PillUnsigned64FromString determines the enum value with an exact case match.
+func PillUnsigned64FromString(raw string) (PillUnsigned64, bool) {
+	v, ok := _PillUnsigned64StringToValueMap[raw]
+	if !ok {
+		return PillUnsigned64(0), false
 	}
+	return v, true
+}
 
-	var ok bool
-	*_p, ok = PillUnsigned16FromString(str)
+// PillUnsigned64FromStringIgnoreCase determines the enum value with a case-insensitive match
+// by Unicode simple folding, regardless of the casing of raw.
+func PillUnsigned64FromStringIgnoreCase(raw string) (PillUnsigned64, bool) {
+	v, ok := PillUnsigned64FromString(raw)
+	if ok {
+		return v, ok
+	}
+	v, ok = _PillUnsigned64LookupFold(raw)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a PillUnsigned16", str)
+		return PillUnsigned64(0), false
 	}
-	return nil
+	return v, true
 }
 
-// MarshalGQL implements the graphql.Marshaler interface for PillUnsigned16.
-func (_p PillUnsigned16) MarshalGQL(w io.Writer) {
-	fmt.Fprint(w, strconv.Quote(_p.String()))
+// MarshalBinary implements the encoding.BinaryMarshaler interface for PillUnsigned64.
+func (_p PillUnsigned64) MarshalBinary() ([]byte, error) {
+	return _p.AppendBinary(nil)
 }
 
-// UnmarshalGQL implements the graphql.Unmarshaler interface for PillUnsigned16.
-func (_p *PillUnsigned16) UnmarshalGQL(value interface{}) error {
-	var str string
-	switch v := value.(type) {
-	case []byte:
-		str = string(v)
-	case string:
-		str = v
-	case fmt.Stringer:
-		str = v.String()
-	default:
-		return fmt.Errorf("invalid value of PillUnsigned16: %[1]T(%[1]v)", value)
+// AppendBinary implements the encoding.BinaryAppender interface for PillUnsigned64.
+func (_p PillUnsigned64) AppendBinary(b []byte) ([]byte, error) {
+	if err := _p.Validate(); err != nil {
+		return b, fmt.Errorf("Cannot marshal value %q as PillUnsigned64. %w", _p, err)
 	}
+	return append(b, _p.String()...), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface for PillUnsigned64.
+func (_p *PillUnsigned64) UnmarshalBinary(text []byte) error {
+	str := string(text)
 	if len(str) == 0 {
-		return fmt.Errorf("PillUnsigned16 cannot be derived from empty string")
+		return fmt.Errorf("PillUnsigned64 cannot be derived from empty string")
 	}
 
 	var ok bool
-	*_p, ok = PillUnsigned16FromString(str)
+	*_p, ok = PillUnsigned64FromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a PillUnsigned16", str)
+		return _enumer.NewParseError("PillUnsigned64", str, PillUnsigned64Strings())
 	}
 	return nil
 }
 
-// MarshalJSON implements the json.Marshaler interface for PillUnsigned16.
-func (_p PillUnsigned16) MarshalJSON() ([]byte, error) {
+// MarshalJSON implements the json.Marshaler interface for PillUnsigned64.
+func (_p PillUnsigned64) MarshalJSON() ([]byte, error) {
 	if err := _p.Validate(); err != nil {
-		return nil, fmt.Errorf("Cannot marshal value %q as PillUnsigned16. %w", _p, err)
+		return nil, fmt.Errorf("Cannot marshal value %q as PillUnsigned64. %w", _p, err)
 	}
 	return json.Marshal(_p.String())
 }
 
-// UnmarshalJSON implements the json.Unmarshaler interface for PillUnsigned16.
-func (_p *PillUnsigned16) UnmarshalJSON(data []byte) error {
+// UnmarshalJSON implements the json.Unmarshaler interface for PillUnsigned64.
+func (_p *PillUnsigned64) UnmarshalJSON(data []byte) error {
 	var str string
 	if err := json.Unmarshal(data, &str); err != nil {
-		return fmt.Errorf("PillUnsigned16 should be a string, got %q", data)
+		return fmt.Errorf("PillUnsigned64 should be a string, got %q", data)
 	}
 	if len(str) == 0 {
-		return fmt.Errorf("PillUnsigned16 cannot be derived from empty string")
+		return fmt.Errorf("PillUnsigned64 cannot be derived from empty string")
 	}
 
 	var ok bool
-	*_p, ok = PillUnsigned16FromString(str)
+	*_p, ok = PillUnsigned64FromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a PillUnsigned16", str)
+		return _enumer.NewParseError("PillUnsigned64", str, PillUnsigned64Strings())
 	}
 	return nil
 }
 
-// Value implements the sql/driver.Valuer interface for PillUnsigned16.
-func (_p PillUnsigned16) Value() (driver.Value, error) {
+// Value implements the sql/driver.Valuer interface for PillUnsigned64.
+func (_p PillUnsigned64) Value() (driver.Value, error) {
 	if err := _p.Validate(); err != nil {
-		return nil, fmt.Errorf("Cannot serialize value %q as PillUnsigned16. %w", _p, err)
+		return nil, fmt.Errorf("Cannot serialize value %q as PillUnsigned64. %w", _p, err)
 	}
 	return _p.String(), nil
 }
 
-// Scan implements the sql/driver.Scanner interface for PillUnsigned16.
-func (_p *PillUnsigned16) Scan(value interface{}) error {
+// Scan implements the sql/driver.Scanner interface for PillUnsigned64.
+func (_p *PillUnsigned64) Scan(value interface{}) error {
 	var str string
 	switch v := value.(type) {
 	case []byte:
@@ -738,259 +2224,326 @@ func (_p *PillUnsigned16) Scan(value interface{}) error {
 	case fmt.Stringer:
 		str = v.String()
 	default:
-		return fmt.Errorf("invalid value of PillUnsigned16: %[1]T(%[1]v)", value)
+		return fmt.Errorf("invalid value of PillUnsigned64: %[1]T(%[1]v)", value)
 	}
 	if len(str) == 0 {
-		return fmt.Errorf("PillUnsigned16 cannot be derived from empty string")
+		return fmt.Errorf("PillUnsigned64 cannot be derived from empty string")
 	}
 
 	var ok bool
-	*_p, ok = PillUnsigned16FromString(str)
+	*_p, ok = PillUnsigned64FromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a PillUnsigned16", str)
+		return _enumer.NewParseError("PillUnsigned64", str, PillUnsigned64Strings())
 	}
 	return nil
 }
 
-// MarshalText implements the encoding.TextMarshaler interface for PillUnsigned16.
-func (_p PillUnsigned16) MarshalText() ([]byte, error) {
+// MarshalText implements the encoding.TextMarshaler interface for PillUnsigned64.
+func (_p PillUnsigned64) MarshalText() ([]byte, error) {
+	return _p.AppendText(nil)
+}
+
+// AppendText implements the encoding.TextAppender interface for PillUnsigned64.
+func (_p PillUnsigned64) AppendText(b []byte) ([]byte, error) {
 	if err := _p.Validate(); err != nil {
-		return nil, fmt.Errorf("Cannot marshal value %q as PillUnsigned16. %w", _p, err)
+		return b, fmt.Errorf("Cannot marshal value %q as PillUnsigned64. %w", _p, err)
 	}
-	return []byte(_p.String()), nil
+	return append(b, _p.String()...), nil
 }
 
-// UnmarshalText implements the encoding.TextUnmarshaler interface for PillUnsigned16.
-func (_p *PillUnsigned16) UnmarshalText(text []byte) error {
+// UnmarshalText implements the encoding.TextUnmarshaler interface for PillUnsigned64.
+func (_p *PillUnsigned64) UnmarshalText(text []byte) error {
 	str := string(text)
 	if len(str) == 0 {
-		return fmt.Errorf("PillUnsigned16 cannot be derived from empty string")
+		return fmt.Errorf("PillUnsigned64 cannot be derived from empty string")
 	}
 
 	var ok bool
-	*_p, ok = PillUnsigned16FromString(str)
+	*_p, ok = PillUnsigned64FromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a PillUnsigned16", str)
+		return _enumer.NewParseError("PillUnsigned64", str, PillUnsigned64Strings())
 	}
 	return nil
 }
 
-// MarshalYAML implements a YAML Marshaler for PillUnsigned16.
-func (_p PillUnsigned16) MarshalYAML() (interface{}, error) {
+// MarshalYAML implements a YAML Marshaler for PillUnsigned64.
+func (_p PillUnsigned64) MarshalYAML() (interface{}, error) {
 	if err := _p.Validate(); err != nil {
-		return nil, fmt.Errorf("Cannot marshal value %q as PillUnsigned16. %w", _p, err)
+		return nil, fmt.Errorf("Cannot marshal value %q as PillUnsigned64. %w", _p, err)
 	}
 	return _p.String(), nil
 }
 
-// UnmarshalYAML implements a YAML Unmarshaler for PillUnsigned16.
-func (_p *PillUnsigned16) UnmarshalYAML(n *yaml.Node) error {
+// UnmarshalYAML implements a YAML Unmarshaler for PillUnsigned64.
+func (_p *PillUnsigned64) UnmarshalYAML(n *yaml.Node) error {
 	const stringTag = "!!str"
 	if n.ShortTag() != stringTag {
-		return fmt.Errorf("PillUnsigned16 must be derived from a string node")
+		return fmt.Errorf("PillUnsigned64 must be derived from a string node")
 	}
 	str := n.Value
 	if len(str) == 0 {
-		return fmt.Errorf("PillUnsigned16 cannot be derived from empty string")
+		return fmt.Errorf("PillUnsigned64 cannot be derived from empty string")
 	}
 
 	var ok bool
-	*_p, ok = PillUnsigned16FromString(str)
+	*_p, ok = PillUnsigned64FromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a PillUnsigned16", str)
+		return _enumer.NewParseError("PillUnsigned64", str, PillUnsigned64Strings())
 	}
 	return nil
 }
 
+var _PillUnsigned64Completions = [5]string{
+	"PLACEBO",
+	"ASPIRIN",
+	"IBUPROFEN",
+	"PARACETAMOL",
+	"VITAMIN-C",
+}
+
+// PillUnsigned64Usage returns a usage hint of all String values of the enum, less the deprecated values,
+// e.g. for flag descriptions.
+func PillUnsigned64Usage() string {
+	return "one of: " + strings.Join(_PillUnsigned64Strings[:], "|")
+}
+
+// PillUnsigned64Completions returns the completion candidates of the enum matching the given prefix,
+// less the deprecated values. Each candidate is formatted as "<value>" or "<value>\t<description>",
+// as expected by shell completions, e.g. of cobra's RegisterFlagCompletionFunc.
+func PillUnsigned64Completions(toComplete string) []string {
+	out := make([]string, 0, len(_PillUnsigned64Completions))
+	for _, c := range _PillUnsigned64Completions {
+		if strings.HasPrefix(c, toComplete) {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
 const (
-	_PillUnsigned32String      = "PLACEBOASPIRINIBUPROFENPARACETAMOLACETAMINOPHENVITAMIN-C"
-	_PillUnsigned32LowerString = "placeboaspirinibuprofenparacetamolacetaminophenvitamin-c"
+	_PillUnsigned8String = "PLACEBOASPIRINIBUPROFENPARACETAMOLACETAMINOPHENVITAMIN-C"
 )
 
 var (
-	_PillUnsigned32Values  = [5]PillUnsigned32{0, 1, 2, 3, 4}
-	_PillUnsigned32Strings = [5]string{_PillUnsigned32String[0:7], _PillUnsigned32String[7:14], _PillUnsigned32String[14:23], _PillUnsigned32String[23:34], _PillUnsigned32String[47:56]}
+	_PillUnsigned8Values  = [5]PillUnsigned8{0, 1, 2, 3, 4}
+	_PillUnsigned8Strings = [5]string{_PillUnsigned8String[0:7], _PillUnsigned8String[7:14], _PillUnsigned8String[14:23], _PillUnsigned8String[23:34], _PillUnsigned8String[47:56]}
 )
 
-// _PillUnsigned32NoOp is a compile time assertion.
+// _PillUnsigned8NoOp is a compile time assertion.
 // An "invalid argument/out of bounds" compiler error signifies that the enum values have changed.
-// Re-run the enumer command to generate an updated version of PillUnsigned32.
-func _PillUnsigned32NoOp() {
+// Re-run the enumer command to generate an updated version of PillUnsigned8.
+func _PillUnsigned8NoOp() {
 	var x [1]struct{}
-	_ = x[PillUnsigned32Placebo-(0)]
-	_ = x[PillUnsigned32Aspirin-(1)]
-	_ = x[PillUnsigned32Ibuprofen-(2)]
-	_ = x[PillUnsigned32Paracetamol-(3)]
-	_ = x[PillUnsigned32Acetaminophen-(3)]
-	_ = x[PillUnsigned32VitaminC-(4)]
+	_ = x[PillUnsigned8Placebo-(0)]
+	_ = x[PillUnsigned8Aspirin-(1)]
+	_ = x[PillUnsigned8Ibuprofen-(2)]
+	_ = x[PillUnsigned8Paracetamol-(3)]
+	_ = x[PillUnsigned8Acetaminophen-(3)]
+	_ = x[PillUnsigned8VitaminC-(4)]
 }
 
-// PillUnsigned32Values returns all values of the enum.
-func PillUnsigned32Values() []PillUnsigned32 {
-	cp := _PillUnsigned32Values
+// PillUnsigned8Values returns all values of the enum.
+func PillUnsigned8Values() []PillUnsigned8 {
+	cp := _PillUnsigned8Values
 	return cp[:]
 }
 
-// PillUnsigned32Strings returns a slice of all String values of the enum.
-func PillUnsigned32Strings() []string {
-	cp := _PillUnsigned32Strings
+// PillUnsigned8Strings returns a slice of all String values of the enum.
+func PillUnsigned8Strings() []string {
+	cp := _PillUnsigned8Strings
 	return cp[:]
 }
 
+// Values returns all values of the enum.
+func (PillUnsigned8) Values() []PillUnsigned8 {
+	return PillUnsigned8Values()
+}
+
 // IsValid tests whether the value is a valid enum value.
-func (_p PillUnsigned32) IsValid() bool {
+func (_p PillUnsigned8) IsValid() bool {
 	return _p >= 0 && _p <= 4
 }
 
 // Validate whether the value is within the range of enum values.
-func (_p PillUnsigned32) Validate() error {
+func (_p PillUnsigned8) Validate() error {
 	if !_p.IsValid() {
-		return fmt.Errorf("PillUnsigned32(%d) is %w", _p, ErrNoValidEnum)
+		return fmt.Errorf("PillUnsigned8(%d) is %w", _p, ErrNoValidEnum)
 	}
 	return nil
 }
 
 // String returns the string of the enum value.
 // If the enum value is invalid, it will produce a string
-// of the following pattern PillUnsigned32(%d) instead.
-func (_p PillUnsigned32) String() string {
+// of the following pattern PillUnsigned8(%d) instead.
+func (_p PillUnsigned8) String() string {
 	if !_p.IsValid() {
-		return fmt.Sprintf("PillUnsigned32(%d)", _p)
+		return fmt.Sprintf("PillUnsigned8(%d)", _p)
 	}
 	idx := uint(_p)
-	return _PillUnsigned32Strings[idx]
+	return _PillUnsigned8Strings[idx]
 }
 
-var (
-	_PillUnsigned32StringToValueMap = map[string]PillUnsigned32{
-		_PillUnsigned32String[0:7]:   PillUnsigned32Placebo,
-		_PillUnsigned32String[7:14]:  PillUnsigned32Aspirin,
-		_PillUnsigned32String[14:23]: PillUnsigned32Ibuprofen,
-		_PillUnsigned32String[23:34]: PillUnsigned32Paracetamol,
-		_PillUnsigned32String[34:47]: PillUnsigned32Acetaminophen,
-		_PillUnsigned32String[47:56]: PillUnsigned32VitaminC,
+// Index returns the position of the value within the ordered values of the enum
+// (ignoring alternative values) or -1 if the value is invalid.
+func (_p PillUnsigned8) Index() int {
+	if !_p.IsValid() {
+		return -1
 	}
-	_PillUnsigned32LowerStringToValueMap = map[string]PillUnsigned32{
-		_PillUnsigned32LowerString[0:7]:   PillUnsigned32Placebo,
-		_PillUnsigned32LowerString[7:14]:  PillUnsigned32Aspirin,
-		_PillUnsigned32LowerString[14:23]: PillUnsigned32Ibuprofen,
-		_PillUnsigned32LowerString[23:34]: PillUnsigned32Paracetamol,
-		_PillUnsigned32LowerString[34:47]: PillUnsigned32Acetaminophen,
-		_PillUnsigned32LowerString[47:56]: PillUnsigned32VitaminC,
+	idx := int(_p)
+	return idx
+}
+
+// Compare returns -1, 0 or +1 depending on whether the value orders before,
+// equal to or after the other value. Invalid values order before all valid values.
+func (_p PillUnsigned8) Compare(other PillUnsigned8) int {
+	a, b := _p.Index(), other.Index()
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return +1
+	}
+	return 0
+}
+
+// Less tests whether the value orders before the other value.
+func (_p PillUnsigned8) Less(other PillUnsigned8) bool {
+	return _p.Compare(other) < 0
+}
+
+// Next returns the succeeding value in order of the enum.
+// It returns false if the value is invalid or the last value.
+func (_p PillUnsigned8) Next() (PillUnsigned8, bool) {
+	idx := _p.Index()
+	if idx == -1 || idx+1 == len(_PillUnsigned8Values) {
+		return PillUnsigned8(0), false
+	}
+	return _PillUnsigned8Values[idx+1], true
+}
+
+// Prev returns the preceding value in order of the enum.
+// It returns false if the value is invalid or the first value.
+func (_p PillUnsigned8) Prev() (PillUnsigned8, bool) {
+	idx := _p.Index()
+	if idx < 1 {
+		return PillUnsigned8(0), false
+	}
+	return _PillUnsigned8Values[idx-1], true
+}
+
+var (
+	_PillUnsigned8StringToValueMap = map[string]PillUnsigned8{
+		_PillUnsigned8String[0:7]:   PillUnsigned8Placebo,
+		_PillUnsigned8String[7:14]:  PillUnsigned8Aspirin,
+		_PillUnsigned8String[14:23]: PillUnsigned8Ibuprofen,
+		_PillUnsigned8String[23:34]: PillUnsigned8Paracetamol,
+		_PillUnsigned8String[34:47]: PillUnsigned8Acetaminophen,
+		_PillUnsigned8String[47:56]: PillUnsigned8VitaminC,
 	}
 )
 
-// PillUnsigned32FromString determines the enum value with an exact case match.
-func PillUnsigned32FromString(raw string) (PillUnsigned32, bool) {
-	v, ok := _PillUnsigned32StringToValueMap[raw]
+// _PillUnsigned8LookupFold determines the enum value of the string regardless of its case
+// by Unicode simple folding. It scans the String values, as a fold table is only generated
+// for enums with the "ignore-case" feature.
+func _PillUnsigned8LookupFold(raw string) (PillUnsigned8, bool) {
+	for idx := range _PillUnsigned8Strings {
+		if strings.EqualFold(_PillUnsigned8Strings[idx], raw) {
+			return _PillUnsigned8Values[idx], true
+		}
+	}
+	if strings.EqualFold(_PillUnsigned8String[34:47], raw) {
+		return PillUnsigned8Acetaminophen, true
+	}
+	return PillUnsigned8(0), false
+}
+
+// PillUnsigned8FromString determines the enum value with an exact case match.
+func PillUnsigned8FromString(raw string) (PillUnsigned8, bool) {
+	v, ok := _PillUnsigned8StringToValueMap[raw]
 	if !ok {
-		return PillUnsigned32(0), false
+		return PillUnsigned8(0), false
 	}
 	return v, true
 }
 
-// PillUnsigned32FromStringIgnoreCase determines the enum value with a case-insensitive match.
-func PillUnsigned32FromStringIgnoreCase(raw string) (PillUnsigned32, bool) {
-	v, ok := PillUnsigned32FromString(raw)
+// PillUnsigned8FromStringIgnoreCase determines the enum value with a case-insensitive match
+// by Unicode simple folding, regardless of the casing of raw.
+func PillUnsigned8FromStringIgnoreCase(raw string) (PillUnsigned8, bool) {
+	v, ok := PillUnsigned8FromString(raw)
 	if ok {
 		return v, ok
 	}
-	v, ok = _PillUnsigned32LowerStringToValueMap[raw]
+	v, ok = _PillUnsigned8LookupFold(raw)
 	if !ok {
-		return PillUnsigned32(0), false
+		return PillUnsigned8(0), false
 	}
 	return v, true
 }
 
-// MarshalBinary implements the encoding.BinaryMarshaler interface for PillUnsigned32.
-func (_p PillUnsigned32) MarshalBinary() ([]byte, error) {
-	if err := _p.Validate(); err != nil {
-		return nil, fmt.Errorf("Cannot marshal value %q as PillUnsigned32. %w", _p, err)
-	}
-	return []byte(_p.String()), nil
+// MarshalBinary implements the encoding.BinaryMarshaler interface for PillUnsigned8.
+func (_p PillUnsigned8) MarshalBinary() ([]byte, error) {
+	return _p.AppendBinary(nil)
 }
 
-// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface for PillUnsigned32.
-func (_p *PillUnsigned32) UnmarshalBinary(text []byte) error {
-	str := string(text)
-	if len(str) == 0 {
-		return fmt.Errorf("PillUnsigned32 cannot be derived from empty string")
-	}
-
-	var ok bool
-	*_p, ok = PillUnsigned32FromString(str)
-	if !ok {
-		return fmt.Errorf("Value %q does not represent a PillUnsigned32", str)
+// AppendBinary implements the encoding.BinaryAppender interface for PillUnsigned8.
+func (_p PillUnsigned8) AppendBinary(b []byte) ([]byte, error) {
+	if err := _p.Validate(); err != nil {
+		return b, fmt.Errorf("Cannot marshal value %q as PillUnsigned8. %w", _p, err)
 	}
-	return nil
-}
-
-// MarshalGQL implements the graphql.Marshaler interface for PillUnsigned32.
-func (_p PillUnsigned32) MarshalGQL(w io.Writer) {
-	fmt.Fprint(w, strconv.Quote(_p.String()))
+	return append(b, _p.String()...), nil
 }
 
-// UnmarshalGQL implements the graphql.Unmarshaler interface for PillUnsigned32.
-func (_p *PillUnsigned32) UnmarshalGQL(value interface{}) error {
-	var str string
-	switch v := value.(type) {
-	case []byte:
-		str = string(v)
-	case string:
-		str = v
-	case fmt.Stringer:
-		str = v.String()
-	default:
-		return fmt.Errorf("invalid value of PillUnsigned32: %[1]T(%[1]v)", value)
-	}
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface for PillUnsigned8.
+func (_p *PillUnsigned8) UnmarshalBinary(text []byte) error {
+	str := string(text)
 	if len(str) == 0 {
-		return fmt.Errorf("PillUnsigned32 cannot be derived from empty string")
+		return fmt.Errorf("PillUnsigned8 cannot be derived from empty string")
 	}
 
 	var ok bool
-	*_p, ok = PillUnsigned32FromString(str)
+	*_p, ok = PillUnsigned8FromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a PillUnsigned32", str)
+		return _enumer.NewParseError("PillUnsigned8", str, PillUnsigned8Strings())
 	}
 	return nil
 }
 
-// MarshalJSON implements the json.Marshaler interface for PillUnsigned32.
-func (_p PillUnsigned32) MarshalJSON() ([]byte, error) {
+// MarshalJSON implements the json.Marshaler interface for PillUnsigned8.
+func (_p PillUnsigned8) MarshalJSON() ([]byte, error) {
 	if err := _p.Validate(); err != nil {
-		return nil, fmt.Errorf("Cannot marshal value %q as PillUnsigned32. %w", _p, err)
+		return nil, fmt.Errorf("Cannot marshal value %q as PillUnsigned8. %w", _p, err)
 	}
 	return json.Marshal(_p.String())
 }
 
-// UnmarshalJSON implements the json.Unmarshaler interface for PillUnsigned32.
-func (_p *PillUnsigned32) UnmarshalJSON(data []byte) error {
+// UnmarshalJSON implements the json.Unmarshaler interface for PillUnsigned8.
+func (_p *PillUnsigned8) UnmarshalJSON(data []byte) error {
 	var str string
 	if err := json.Unmarshal(data, &str); err != nil {
-		return fmt.Errorf("PillUnsigned32 should be a string, got %q", data)
+		return fmt.Errorf("PillUnsigned8 should be a string, got %q", data)
 	}
 	if len(str) == 0 {
-		return fmt.Errorf("PillUnsigned32 cannot be derived from empty string")
+		return fmt.Errorf("PillUnsigned8 cannot be derived from empty string")
 	}
 
 	var ok bool
-	*_p, ok = PillUnsigned32FromString(str)
+	*_p, ok = PillUnsigned8FromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a PillUnsigned32", str)
+		return _enumer.NewParseError("PillUnsigned8", str, PillUnsigned8Strings())
 	}
 	return nil
 }
 
-// Value implements the sql/driver.Valuer interface for PillUnsigned32.
-func (_p PillUnsigned32) Value() (driver.Value, error) {
+// Value implements the sql/driver.Valuer interface for PillUnsigned8.
+func (_p PillUnsigned8) Value() (driver.Value, error) {
 	if err := _p.Validate(); err != nil {
-		return nil, fmt.Errorf("Cannot serialize value %q as PillUnsigned32. %w", _p, err)
+		return nil, fmt.Errorf("Cannot serialize value %q as PillUnsigned8. %w", _p, err)
 	}
 	return _p.String(), nil
 }
 
-// Scan implements the sql/driver.Scanner interface for PillUnsigned32.
-func (_p *PillUnsigned32) Scan(value interface{}) error {
+// Scan implements the sql/driver.Scanner interface for PillUnsigned8.
+func (_p *PillUnsigned8) Scan(value interface{}) error {
 	var str string
 	switch v := value.(type) {
 	case []byte:
@@ -1000,261 +2553,272 @@ func (_p *PillUnsigned32) Scan(value interface{}) error {
 	case fmt.Stringer:
 		str = v.String()
 	default:
-		return fmt.Errorf("invalid value of PillUnsigned32: %[1]T(%[1]v)", value)
+		return fmt.Errorf("invalid value of PillUnsigned8: %[1]T(%[1]v)", value)
 	}
 	if len(str) == 0 {
-		return fmt.Errorf("PillUnsigned32 cannot be derived from empty string")
+		return fmt.Errorf("PillUnsigned8 cannot be derived from empty string")
 	}
 
 	var ok bool
-	*_p, ok = PillUnsigned32FromString(str)
+	*_p, ok = PillUnsigned8FromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a PillUnsigned32", str)
+		return _enumer.NewParseError("PillUnsigned8", str, PillUnsigned8Strings())
 	}
 	return nil
 }
 
-// MarshalText implements the encoding.TextMarshaler interface for PillUnsigned32.
-func (_p PillUnsigned32) MarshalText() ([]byte, error) {
+// MarshalText implements the encoding.TextMarshaler interface for PillUnsigned8.
+func (_p PillUnsigned8) MarshalText() ([]byte, error) {
+	return _p.AppendText(nil)
+}
+
+// AppendText implements the encoding.TextAppender interface for PillUnsigned8.
+func (_p PillUnsigned8) AppendText(b []byte) ([]byte, error) {
 	if err := _p.Validate(); err != nil {
-		return nil, fmt.Errorf("Cannot marshal value %q as PillUnsigned32. %w", _p, err)
+		return b, fmt.Errorf("Cannot marshal value %q as PillUnsigned8. %w", _p, err)
 	}
-	return []byte(_p.String()), nil
+	return append(b, _p.String()...), nil
 }
 
-// UnmarshalText implements the encoding.TextUnmarshaler interface for PillUnsigned32.
-func (_p *PillUnsigned32) UnmarshalText(text []byte) error {
+// UnmarshalText implements the encoding.TextUnmarshaler interface for PillUnsigned8.
+func (_p *PillUnsigned8) UnmarshalText(text []byte) error {
 	str := string(text)
 	if len(str) == 0 {
-		return fmt.Errorf("PillUnsigned32 cannot be derived from empty string")
+		return fmt.Errorf("PillUnsigned8 cannot be derived from empty string")
 	}
 
 	var ok bool
-	*_p, ok = PillUnsigned32FromString(str)
+	*_p, ok = PillUnsigned8FromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a PillUnsigned32", str)
+		return _enumer.NewParseError("PillUnsigned8", str, PillUnsigned8Strings())
 	}
 	return nil
 }
 
-// MarshalYAML implements a YAML Marshaler for PillUnsigned32.
-func (_p PillUnsigned32) MarshalYAML() (interface{}, error) {
+// MarshalYAML implements a YAML Marshaler for PillUnsigned8.
+func (_p PillUnsigned8) MarshalYAML() (interface{}, error) {
 	if err := _p.Validate(); err != nil {
-		return nil, fmt.Errorf("Cannot marshal value %q as PillUnsigned32. %w", _p, err)
+		return nil, fmt.Errorf("Cannot marshal value %q as PillUnsigned8. %w", _p, err)
 	}
 	return _p.String(), nil
 }
 
-// UnmarshalYAML implements a YAML Unmarshaler for PillUnsigned32.
-func (_p *PillUnsigned32) UnmarshalYAML(n *yaml.Node) error {
+// UnmarshalYAML implements a YAML Unmarshaler for PillUnsigned8.
+func (_p *PillUnsigned8) UnmarshalYAML(n *yaml.Node) error {
 	const stringTag = "!!str"
 	if n.ShortTag() != stringTag {
-		return fmt.Errorf("PillUnsigned32 must be derived from a string node")
+		return fmt.Errorf("PillUnsigned8 must be derived from a string node")
 	}
 	str := n.Value
 	if len(str) == 0 {
-		return fmt.Errorf("PillUnsigned32 cannot be derived from empty string")
+		return fmt.Errorf("PillUnsigned8 cannot be derived from empty string")
 	}
 
 	var ok bool
-	*_p, ok = PillUnsigned32FromString(str)
+	*_p, ok = PillUnsigned8FromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a PillUnsigned32", str)
+		return _enumer.NewParseError("PillUnsigned8", str, PillUnsigned8Strings())
 	}
 	return nil
 }
 
-const (
-	_PillUnsigned64String      = "PLACEBOASPIRINIBUPROFENPARACETAMOLACETAMINOPHENVITAMIN-C"
-	_PillUnsigned64LowerString = "placeboaspirinibuprofenparacetamolacetaminophenvitamin-c"
-)
-
-var (
-	_PillUnsigned64Values  = [5]PillUnsigned64{0, 1, 2, 3, 4}
-	_PillUnsigned64Strings = [5]string{_PillUnsigned64String[0:7], _PillUnsigned64String[7:14], _PillUnsigned64String[14:23], _PillUnsigned64String[23:34], _PillUnsigned64String[47:56]}
-)
+var _PillUnsigned8Completions = [5]string{
+	"PLACEBO",
+	"ASPIRIN",
+	"IBUPROFEN",
+	"PARACETAMOL",
+	"VITAMIN-C",
+}
 
-// _PillUnsigned64NoOp is a compile time assertion.
-// An "invalid argument/out of bounds" compiler error signifies that the enum values have changed.
-// Re-run the enumer command to generate an updated version of PillUnsigned64.
-func _PillUnsigned64NoOp() {
-	var x [1]struct{}
-	_ = x[PillUnsigned64Placebo-(0)]
-	_ = x[PillUnsigned64Aspirin-(1)]
-	_ = x[PillUnsigned64Ibuprofen-(2)]
-	_ = x[PillUnsigned64Paracetamol-(3)]
-	_ = x[PillUnsigned64Acetaminophen-(3)]
-	_ = x[PillUnsigned64VitaminC-(4)]
+// PillUnsigned8Usage returns a usage hint of all String values of the enum, less the deprecated values,
+// e.g. for flag descriptions.
+func PillUnsigned8Usage() string {
+	return "one of: " + strings.Join(_PillUnsigned8Strings[:], "|")
 }
 
-// PillUnsigned64Values returns all values of the enum.
-func PillUnsigned64Values() []PillUnsigned64 {
-	cp := _PillUnsigned64Values
-	return cp[:]
+// PillUnsigned8Completions returns the completion candidates of the enum matching the given prefix,
+// less the deprecated values. Each candidate is formatted as "<value>" or "<value>\t<description>",
+// as expected by shell completions, e.g. of cobra's RegisterFlagCompletionFunc.
+func PillUnsigned8Completions(toComplete string) []string {
+	out := make([]string, 0, len(_PillUnsigned8Completions))
+	for _, c := range _PillUnsigned8Completions {
+		if strings.HasPrefix(c, toComplete) {
+			out = append(out, c)
+		}
+	}
+	return out
 }
 
-// PillUnsigned64Strings returns a slice of all String values of the enum.
-func PillUnsigned64Strings() []string {
-	cp := _PillUnsigned64Strings
-	return cp[:]
+// PillUnsigned8Set is a set of PillUnsigned8 values backed by a bitset.
+// The zero value is an empty set.
+type PillUnsigned8Set struct {
+	bits [1]uint64
 }
 
-// IsValid tests whether the value is a valid enum value.
-func (_p PillUnsigned64) IsValid() bool {
-	return _p >= 0 && _p <= 4
+// NewPillUnsigned8Set returns a set containing the given values.
+// Invalid values are ignored.
+func NewPillUnsigned8Set(values ...PillUnsigned8) PillUnsigned8Set {
+	var _p PillUnsigned8Set
+	_p.Add(values...)
+	return _p
 }
 
-// Validate whether the value is within the range of enum values.
-func (_p PillUnsigned64) Validate() error {
-	if !_p.IsValid() {
-		return fmt.Errorf("PillUnsigned64(%d) is %w", _p, ErrNoValidEnum)
+// Add adds the values to the set. Invalid values are ignored.
+func (_p *PillUnsigned8Set) Add(values ...PillUnsigned8) {
+	for _, v := range values {
+		if !v.IsValid() {
+			continue
+		}
+		idx := uint64(v) - 0
+		_p.bits[idx/64] |= 1 << (idx % 64)
 	}
-	return nil
 }
 
-// String returns the string of the enum value.
-// If the enum value is invalid, it will produce a string
-// of the following pattern PillUnsigned64(%d) instead.
-func (_p PillUnsigned64) String() string {
-	if !_p.IsValid() {
-		return fmt.Sprintf("PillUnsigned64(%d)", _p)
+// Remove removes the values from the set.
+func (_p *PillUnsigned8Set) Remove(values ...PillUnsigned8) {
+	for _, v := range values {
+		if !v.IsValid() {
+			continue
+		}
+		idx := uint64(v) - 0
+		_p.bits[idx/64] &^= 1 << (idx % 64)
 	}
-	idx := uint(_p)
-	return _PillUnsigned64Strings[idx]
 }
 
-var (
-	_PillUnsigned64StringToValueMap = map[string]PillUnsigned64{
-		_PillUnsigned64String[0:7]:   PillUnsigned64Placebo,
-		_PillUnsigned64String[7:14]:  PillUnsigned64Aspirin,
-		_PillUnsigned64String[14:23]: PillUnsigned64Ibuprofen,
-		_PillUnsigned64String[23:34]: PillUnsigned64Paracetamol,
-		_PillUnsigned64String[34:47]: PillUnsigned64Acetaminophen,
-		_PillUnsigned64String[47:56]: PillUnsigned64VitaminC,
-	}
-	_PillUnsigned64LowerStringToValueMap = map[string]PillUnsigned64{
-		_PillUnsigned64LowerString[0:7]:   PillUnsigned64Placebo,
-		_PillUnsigned64LowerString[7:14]:  PillUnsigned64Aspirin,
-		_PillUnsigned64LowerString[14:23]: PillUnsigned64Ibuprofen,
-		_PillUnsigned64LowerString[23:34]: PillUnsigned64Paracetamol,
-		_PillUnsigned64LowerString[34:47]: PillUnsigned64Acetaminophen,
-		_PillUnsigned64LowerString[47:56]: PillUnsigned64VitaminC,
+// Contains tests whether the value is an element of the set.
+func (_p PillUnsigned8Set) Contains(v PillUnsigned8) bool {
+	if !v.IsValid() {
+		return false
 	}
-)
+	idx := uint64(v) - 0
+	return _p.bits[idx/64]&(1<<(idx%64)) != 0
+}
 
-// PillUnsigned64FromString determines the enum value with an exact case match.
-func PillUnsigned64FromString(raw string) (PillUnsigned64, bool) {
-	v, ok := _PillUnsigned64StringToValueMap[raw]
-	if !ok {
-		return PillUnsigned64(0), false
+// Union returns a set of all values contained in either set.
+func (_p PillUnsigned8Set) Union(other PillUnsigned8Set) PillUnsigned8Set {
+	for idx := range _p.bits {
+		_p.bits[idx] |= other.bits[idx]
 	}
-	return v, true
+	return _p
 }
 
-// PillUnsigned64FromStringIgnoreCase determines the enum value with a case-insensitive match.
-func PillUnsigned64FromStringIgnoreCase(raw string) (PillUnsigned64, bool) {
-	v, ok := PillUnsigned64FromString(raw)
-	if ok {
-		return v, ok
+// Intersect returns a set of all values contained in both sets.
+func (_p PillUnsigned8Set) Intersect(other PillUnsigned8Set) PillUnsigned8Set {
+	for idx := range _p.bits {
+		_p.bits[idx] &= other.bits[idx]
 	}
-	v, ok = _PillUnsigned64LowerStringToValueMap[raw]
-	if !ok {
-		return PillUnsigned64(0), false
+	return _p
+}
+
+// Difference returns a set of all values contained in the set, but not in the other set.
+func (_p PillUnsigned8Set) Difference(other PillUnsigned8Set) PillUnsigned8Set {
+	for idx := range _p.bits {
+		_p.bits[idx] &^= other.bits[idx]
 	}
-	return v, true
+	return _p
 }
 
-// MarshalBinary implements the encoding.BinaryMarshaler interface for PillUnsigned64.
-func (_p PillUnsigned64) MarshalBinary() ([]byte, error) {
-	if err := _p.Validate(); err != nil {
-		return nil, fmt.Errorf("Cannot marshal value %q as PillUnsigned64. %w", _p, err)
+// Len returns the count of values in the set.
+func (_p PillUnsigned8Set) Len() int {
+	var n int
+	for _, w := range _p.bits {
+		n += bits.OnesCount64(w)
 	}
-	return []byte(_p.String()), nil
+	return n
 }
 
-// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface for PillUnsigned64.
-func (_p *PillUnsigned64) UnmarshalBinary(text []byte) error {
-	str := string(text)
-	if len(str) == 0 {
-		return fmt.Errorf("PillUnsigned64 cannot be derived from empty string")
+// All returns all values of the set in ascending order.
+func (_p PillUnsigned8Set) All() []PillUnsigned8 {
+	out := make([]PillUnsigned8, 0, _p.Len())
+	for idx, w := range _p.bits {
+		for ; w != 0; w &= w - 1 {
+			out = append(out, PillUnsigned8(uint64(idx*64+bits.TrailingZeros64(w))+0))
+		}
 	}
+	return out
+}
 
-	var ok bool
-	*_p, ok = PillUnsigned64FromString(str)
-	if !ok {
-		return fmt.Errorf("Value %q does not represent a PillUnsigned64", str)
+// Strings returns the String values of all values of the set in ascending order.
+func (_p PillUnsigned8Set) Strings() []string {
+	values := _p.All()
+	out := make([]string, len(values))
+	for idx, v := range values {
+		out[idx] = v.String()
 	}
-	return nil
+	return out
 }
 
-// MarshalGQL implements the graphql.Marshaler interface for PillUnsigned64.
-func (_p PillUnsigned64) MarshalGQL(w io.Writer) {
-	fmt.Fprint(w, strconv.Quote(_p.String()))
+// String implements the Stringer interface.
+func (_p PillUnsigned8Set) String() string {
+	return "[" + strings.Join(_p.Strings(), " ") + "]"
 }
 
-// UnmarshalGQL implements the graphql.Unmarshaler interface for PillUnsigned64.
-func (_p *PillUnsigned64) UnmarshalGQL(value interface{}) error {
-	var str string
-	switch v := value.(type) {
-	case []byte:
-		str = string(v)
-	case string:
-		str = v
-	case fmt.Stringer:
-		str = v.String()
-	default:
-		return fmt.Errorf("invalid value of PillUnsigned64: %[1]T(%[1]v)", value)
+// setStrings replaces the values of the set by the values of the given String values.
+func (_p *PillUnsigned8Set) setStrings(strs []string) error {
+	*_p = PillUnsigned8Set{}
+	for _, str := range strs {
+		v, ok := PillUnsigned8FromString(str)
+		if !ok {
+			return _enumer.NewParseError("PillUnsigned8", str, PillUnsigned8Strings())
+		}
+		_p.Add(v)
 	}
+	return nil
+}
+
+// setJoined replaces the values of the set by the values of a comma-joined
+// list of String values.
+func (_p *PillUnsigned8Set) setJoined(str string) error {
 	if len(str) == 0 {
-		return fmt.Errorf("PillUnsigned64 cannot be derived from empty string")
+		*_p = PillUnsigned8Set{}
+		return nil
 	}
+	return _p.setStrings(strings.Split(str, ","))
+}
 
-	var ok bool
-	*_p, ok = PillUnsigned64FromString(str)
-	if !ok {
-		return fmt.Errorf("Value %q does not represent a PillUnsigned64", str)
-	}
-	return nil
+// MarshalBinary implements the encoding.BinaryMarshaler interface for PillUnsigned8Set.
+func (_p PillUnsigned8Set) MarshalBinary() ([]byte, error) {
+	return _p.AppendBinary(nil)
 }
 
-// MarshalJSON implements the json.Marshaler interface for PillUnsigned64.
-func (_p PillUnsigned64) MarshalJSON() ([]byte, error) {
-	if err := _p.Validate(); err != nil {
-		return nil, fmt.Errorf("Cannot marshal value %q as PillUnsigned64. %w", _p, err)
-	}
-	return json.Marshal(_p.String())
+// AppendBinary implements the encoding.BinaryAppender interface for PillUnsigned8Set.
+func (_p PillUnsigned8Set) AppendBinary(b []byte) ([]byte, error) {
+	return append(b, strings.Join(_p.Strings(), ",")...), nil
 }
 
-// UnmarshalJSON implements the json.Unmarshaler interface for PillUnsigned64.
-func (_p *PillUnsigned64) UnmarshalJSON(data []byte) error {
-	var str string
-	if err := json.Unmarshal(data, &str); err != nil {
-		return fmt.Errorf("PillUnsigned64 should be a string, got %q", data)
-	}
-	if len(str) == 0 {
-		return fmt.Errorf("PillUnsigned64 cannot be derived from empty string")
-	}
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface for PillUnsigned8Set.
+func (_p *PillUnsigned8Set) UnmarshalBinary(text []byte) error {
+	return _p.setJoined(string(text))
+}
 
-	var ok bool
-	*_p, ok = PillUnsigned64FromString(str)
-	if !ok {
-		return fmt.Errorf("Value %q does not represent a PillUnsigned64", str)
-	}
-	return nil
+// MarshalJSON implements the json.Marshaler interface for PillUnsigned8Set.
+func (_p PillUnsigned8Set) MarshalJSON() ([]byte, error) {
+	return json.Marshal(_p.Strings())
 }
 
-// Value implements the sql/driver.Valuer interface for PillUnsigned64.
-func (_p PillUnsigned64) Value() (driver.Value, error) {
-	if err := _p.Validate(); err != nil {
-		return nil, fmt.Errorf("Cannot serialize value %q as PillUnsigned64. %w", _p, err)
+// UnmarshalJSON implements the json.Unmarshaler interface for PillUnsigned8Set.
+func (_p *PillUnsigned8Set) UnmarshalJSON(data []byte) error {
+	var strs []string
+	if err := json.Unmarshal(data, &strs); err != nil {
+		return fmt.Errorf("PillUnsigned8Set should be an array of strings, got %q", data)
 	}
-	return _p.String(), nil
+	return _p.setStrings(strs)
 }
 
-// Scan implements the sql/driver.Scanner interface for PillUnsigned64.
-func (_p *PillUnsigned64) Scan(value interface{}) error {
+// Value implements the sql/driver.Valuer interface for PillUnsigned8Set.
+// The set is stored as Postgres array of its String values, e.g. `{a,b}`.
+func (_p PillUnsigned8Set) Value() (driver.Value, error) {
+	return string(_enumer.AppendArray(nil, _p.Strings())), nil
+}
+
+// Scan implements the sql/driver.Scanner interface for PillUnsigned8Set.
+// It accepts Postgres arrays of String values.
+func (_p *PillUnsigned8Set) Scan(value interface{}) error {
 	var str string
 	switch v := value.(type) {
+	case nil:
+		*_p = PillUnsigned8Set{}
+		return nil
 	case []byte:
 		str = string(v)
 	case string:
@@ -1262,328 +2826,573 @@ func (_p *PillUnsigned64) Scan(value interface{}) error {
 	case fmt.Stringer:
 		str = v.String()
 	default:
-		return fmt.Errorf("invalid value of PillUnsigned64: %[1]T(%[1]v)", value)
-	}
-	if len(str) == 0 {
-		return fmt.Errorf("PillUnsigned64 cannot be derived from empty string")
+		return fmt.Errorf("invalid value of PillUnsigned8Set: %[1]T(%[1]v)", value)
 	}
-
-	var ok bool
-	*_p, ok = PillUnsigned64FromString(str)
-	if !ok {
-		return fmt.Errorf("Value %q does not represent a PillUnsigned64", str)
+	elems, err := _enumer.ParseArray(str)
+	if err != nil {
+		return fmt.Errorf("invalid value of PillUnsigned8Set: %w", err)
 	}
-	return nil
+	return _p.setStrings(elems)
 }
 
-// MarshalText implements the encoding.TextMarshaler interface for PillUnsigned64.
-func (_p PillUnsigned64) MarshalText() ([]byte, error) {
-	if err := _p.Validate(); err != nil {
-		return nil, fmt.Errorf("Cannot marshal value %q as PillUnsigned64. %w", _p, err)
-	}
-	return []byte(_p.String()), nil
+// MarshalText implements the encoding.TextMarshaler interface for PillUnsigned8Set.
+func (_p PillUnsigned8Set) MarshalText() ([]byte, error) {
+	return _p.AppendText(nil)
 }
 
-// UnmarshalText implements the encoding.TextUnmarshaler interface for PillUnsigned64.
-func (_p *PillUnsigned64) UnmarshalText(text []byte) error {
-	str := string(text)
-	if len(str) == 0 {
-		return fmt.Errorf("PillUnsigned64 cannot be derived from empty string")
-	}
-
-	var ok bool
-	*_p, ok = PillUnsigned64FromString(str)
-	if !ok {
-		return fmt.Errorf("Value %q does not represent a PillUnsigned64", str)
-	}
-	return nil
+// AppendText implements the encoding.TextAppender interface for PillUnsigned8Set.
+func (_p PillUnsigned8Set) AppendText(b []byte) ([]byte, error) {
+	return append(b, strings.Join(_p.Strings(), ",")...), nil
 }
 
-// MarshalYAML implements a YAML Marshaler for PillUnsigned64.
-func (_p PillUnsigned64) MarshalYAML() (interface{}, error) {
-	if err := _p.Validate(); err != nil {
-		return nil, fmt.Errorf("Cannot marshal value %q as PillUnsigned64. %w", _p, err)
-	}
-	return _p.String(), nil
+// UnmarshalText implements the encoding.TextUnmarshaler interface for PillUnsigned8Set.
+func (_p *PillUnsigned8Set) UnmarshalText(text []byte) error {
+	return _p.setJoined(string(text))
 }
 
-// UnmarshalYAML implements a YAML Unmarshaler for PillUnsigned64.
-func (_p *PillUnsigned64) UnmarshalYAML(n *yaml.Node) error {
-	const stringTag = "!!str"
-	if n.ShortTag() != stringTag {
-		return fmt.Errorf("PillUnsigned64 must be derived from a string node")
-	}
-	str := n.Value
-	if len(str) == 0 {
-		return fmt.Errorf("PillUnsigned64 cannot be derived from empty string")
-	}
+// MarshalYAML implements a YAML Marshaler for PillUnsigned8Set.
+func (_p PillUnsigned8Set) MarshalYAML() (interface{}, error) {
+	return _p.Strings(), nil
+}
 
-	var ok bool
-	*_p, ok = PillUnsigned64FromString(str)
-	if !ok {
-		return fmt.Errorf("Value %q does not represent a PillUnsigned64", str)
+// UnmarshalYAML implements a YAML Unmarshaler for PillUnsigned8Set.
+func (_p *PillUnsigned8Set) UnmarshalYAML(n *yaml.Node) error {
+	var strs []string
+	if err := n.Decode(&strs); err != nil {
+		return err
 	}
-	return nil
+	return _p.setStrings(strs)
 }
 
 const (
-	_PillUnsigned8String      = "PLACEBOASPIRINIBUPROFENPARACETAMOLACETAMINOPHENVITAMIN-C"
-	_PillUnsigned8LowerString = "placeboaspirinibuprofenparacetamolacetaminophenvitamin-c"
+	_PillVarintString = "PLACEBOASPIRINIBUPROFENPARACETAMOLACETAMINOPHENVITAMIN-C"
 )
 
 var (
-	_PillUnsigned8Values  = [5]PillUnsigned8{0, 1, 2, 3, 4}
-	_PillUnsigned8Strings = [5]string{_PillUnsigned8String[0:7], _PillUnsigned8String[7:14], _PillUnsigned8String[14:23], _PillUnsigned8String[23:34], _PillUnsigned8String[47:56]}
+	_PillVarintValues  = [5]PillVarint{0, 1, 2, 3, 4}
+	_PillVarintStrings = [5]string{_PillVarintString[0:7], _PillVarintString[7:14], _PillVarintString[14:23], _PillVarintString[23:34], _PillVarintString[47:56]}
 )
 
-// _PillUnsigned8NoOp is a compile time assertion.
+// _PillVarintNoOp is a compile time assertion.
 // An "invalid argument/out of bounds" compiler error signifies that the enum values have changed.
-// Re-run the enumer command to generate an updated version of PillUnsigned8.
-func _PillUnsigned8NoOp() {
+// Re-run the enumer command to generate an updated version of PillVarint.
+func _PillVarintNoOp() {
 	var x [1]struct{}
-	_ = x[PillUnsigned8Placebo-(0)]
-	_ = x[PillUnsigned8Aspirin-(1)]
-	_ = x[PillUnsigned8Ibuprofen-(2)]
-	_ = x[PillUnsigned8Paracetamol-(3)]
-	_ = x[PillUnsigned8Acetaminophen-(3)]
-	_ = x[PillUnsigned8VitaminC-(4)]
+	_ = x[PillVarintPlacebo-(0)]
+	_ = x[PillVarintAspirin-(1)]
+	_ = x[PillVarintIbuprofen-(2)]
+	_ = x[PillVarintParacetamol-(3)]
+	_ = x[PillVarintAcetaminophen-(3)]
+	_ = x[PillVarintVitaminC-(4)]
 }
 
-// PillUnsigned8Values returns all values of the enum.
-func PillUnsigned8Values() []PillUnsigned8 {
-	cp := _PillUnsigned8Values
+// PillVarintValues returns all values of the enum.
+func PillVarintValues() []PillVarint {
+	cp := _PillVarintValues
 	return cp[:]
 }
 
-// PillUnsigned8Strings returns a slice of all String values of the enum.
-func PillUnsigned8Strings() []string {
-	cp := _PillUnsigned8Strings
+// PillVarintStrings returns a slice of all String values of the enum.
+func PillVarintStrings() []string {
+	cp := _PillVarintStrings
 	return cp[:]
 }
 
+// Values returns all values of the enum.
+func (PillVarint) Values() []PillVarint {
+	return PillVarintValues()
+}
+
 // IsValid tests whether the value is a valid enum value.
-func (_p PillUnsigned8) IsValid() bool {
+func (_p PillVarint) IsValid() bool {
 	return _p >= 0 && _p <= 4
 }
 
 // Validate whether the value is within the range of enum values.
-func (_p PillUnsigned8) Validate() error {
+func (_p PillVarint) Validate() error {
 	if !_p.IsValid() {
-		return fmt.Errorf("PillUnsigned8(%d) is %w", _p, ErrNoValidEnum)
+		return fmt.Errorf("PillVarint(%d) is %w", _p, ErrNoValidEnum)
 	}
 	return nil
 }
 
 // String returns the string of the enum value.
 // If the enum value is invalid, it will produce a string
-// of the following pattern PillUnsigned8(%d) instead.
-func (_p PillUnsigned8) String() string {
+// of the following pattern PillVarint(%d) instead.
+func (_p PillVarint) String() string {
 	if !_p.IsValid() {
-		return fmt.Sprintf("PillUnsigned8(%d)", _p)
+		return fmt.Sprintf("PillVarint(%d)", _p)
 	}
 	idx := uint(_p)
-	return _PillUnsigned8Strings[idx]
+	return _PillVarintStrings[idx]
 }
 
-var (
-	_PillUnsigned8StringToValueMap = map[string]PillUnsigned8{
-		_PillUnsigned8String[0:7]:   PillUnsigned8Placebo,
-		_PillUnsigned8String[7:14]:  PillUnsigned8Aspirin,
-		_PillUnsigned8String[14:23]: PillUnsigned8Ibuprofen,
-		_PillUnsigned8String[23:34]: PillUnsigned8Paracetamol,
-		_PillUnsigned8String[34:47]: PillUnsigned8Acetaminophen,
-		_PillUnsigned8String[47:56]: PillUnsigned8VitaminC,
+// Index returns the position of the value within the ordered values of the enum
+// (ignoring alternative values) or -1 if the value is invalid.
+func (_p PillVarint) Index() int {
+	if !_p.IsValid() {
+		return -1
+	}
+	idx := int(_p)
+	return idx
+}
+
+// Compare returns -1, 0 or +1 depending on whether the value orders before,
+// equal to or after the other value. Invalid values order before all valid values.
+func (_p PillVarint) Compare(other PillVarint) int {
+	a, b := _p.Index(), other.Index()
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return +1
+	}
+	return 0
+}
+
+// Less tests whether the value orders before the other value.
+func (_p PillVarint) Less(other PillVarint) bool {
+	return _p.Compare(other) < 0
+}
+
+// Next returns the succeeding value in order of the enum.
+// It returns false if the value is invalid or the last value.
+func (_p PillVarint) Next() (PillVarint, bool) {
+	idx := _p.Index()
+	if idx == -1 || idx+1 == len(_PillVarintValues) {
+		return PillVarint(0), false
+	}
+	return _PillVarintValues[idx+1], true
+}
+
+// Prev returns the preceding value in order of the enum.
+// It returns false if the value is invalid or the first value.
+func (_p PillVarint) Prev() (PillVarint, bool) {
+	idx := _p.Index()
+	if idx < 1 {
+		return PillVarint(0), false
 	}
-	_PillUnsigned8LowerStringToValueMap = map[string]PillUnsigned8{
-		_PillUnsigned8LowerString[0:7]:   PillUnsigned8Placebo,
-		_PillUnsigned8LowerString[7:14]:  PillUnsigned8Aspirin,
-		_PillUnsigned8LowerString[14:23]: PillUnsigned8Ibuprofen,
-		_PillUnsigned8LowerString[23:34]: PillUnsigned8Paracetamol,
-		_PillUnsigned8LowerString[34:47]: PillUnsigned8Acetaminophen,
-		_PillUnsigned8LowerString[47:56]: PillUnsigned8VitaminC,
+	return _PillVarintValues[idx-1], true
+}
+
+var (
+	_PillVarintStringToValueMap = map[string]PillVarint{
+		_PillVarintString[0:7]:   PillVarintPlacebo,
+		_PillVarintString[7:14]:  PillVarintAspirin,
+		_PillVarintString[14:23]: PillVarintIbuprofen,
+		_PillVarintString[23:34]: PillVarintParacetamol,
+		_PillVarintString[34:47]: PillVarintAcetaminophen,
+		_PillVarintString[47:56]: PillVarintVitaminC,
 	}
 )
 
-// PillUnsigned8FromString determines the enum value with an exact case match.
-func PillUnsigned8FromString(raw string) (PillUnsigned8, bool) {
-	v, ok := _PillUnsigned8StringToValueMap[raw]
+// _PillVarintLookupFold determines the enum value of the string regardless of its case
+// by Unicode simple folding. It scans the String values, as a fold table is only generated
+// for enums with the "ignore-case" feature.
+func _PillVarintLookupFold(raw string) (PillVarint, bool) {
+	for idx := range _PillVarintStrings {
+		if strings.EqualFold(_PillVarintStrings[idx], raw) {
+			return _PillVarintValues[idx], true
+		}
+	}
+	if strings.EqualFold(_PillVarintString[34:47], raw) {
+		return PillVarintAcetaminophen, true
+	}
+	return PillVarint(0), false
+}
+
+// PillVarintFromString determines the enum value with an exact case match.
+func PillVarintFromString(raw string) (PillVarint, bool) {
+	v, ok := _PillVarintStringToValueMap[raw]
 	if !ok {
-		return PillUnsigned8(0), false
+		return PillVarint(0), false
 	}
 	return v, true
 }
 
-// PillUnsigned8FromStringIgnoreCase determines the enum value with a case-insensitive match.
-func PillUnsigned8FromStringIgnoreCase(raw string) (PillUnsigned8, bool) {
-	v, ok := PillUnsigned8FromString(raw)
+// PillVarintFromStringIgnoreCase determines the enum value with a case-insensitive match
+// by Unicode simple folding, regardless of the casing of raw.
+func PillVarintFromStringIgnoreCase(raw string) (PillVarint, bool) {
+	v, ok := PillVarintFromString(raw)
 	if ok {
 		return v, ok
 	}
-	v, ok = _PillUnsigned8LowerStringToValueMap[raw]
+	v, ok = _PillVarintLookupFold(raw)
 	if !ok {
-		return PillUnsigned8(0), false
+		return PillVarint(0), false
 	}
 	return v, true
 }
 
-// MarshalBinary implements the encoding.BinaryMarshaler interface for PillUnsigned8.
-func (_p PillUnsigned8) MarshalBinary() ([]byte, error) {
+// MarshalBinary implements the encoding.BinaryMarshaler interface for PillVarint.
+// The value is encoded compactly as the uvarint of its numeric representation.
+func (_p PillVarint) MarshalBinary() ([]byte, error) {
+	return _p.AppendBinary(nil)
+}
+
+// AppendBinary implements the encoding.BinaryAppender interface for PillVarint.
+func (_p PillVarint) AppendBinary(b []byte) ([]byte, error) {
 	if err := _p.Validate(); err != nil {
-		return nil, fmt.Errorf("Cannot marshal value %q as PillUnsigned8. %w", _p, err)
+		return b, fmt.Errorf("Cannot marshal value %q as PillVarint. %w", _p, err)
 	}
-	return []byte(_p.String()), nil
+	return binary.AppendUvarint(b, uint64(_p)), nil
 }
 
-// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface for PillUnsigned8.
-func (_p *PillUnsigned8) UnmarshalBinary(text []byte) error {
-	str := string(text)
-	if len(str) == 0 {
-		return fmt.Errorf("PillUnsigned8 cannot be derived from empty string")
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface for PillVarint.
+func (_p *PillVarint) UnmarshalBinary(data []byte) error {
+	id, n := binary.Uvarint(data)
+	if n <= 0 || n != len(data) {
+		return fmt.Errorf("PillVarint cannot be derived from malformed varint %#x", data)
 	}
-
-	var ok bool
-	*_p, ok = PillUnsigned8FromString(str)
-	if !ok {
-		return fmt.Errorf("Value %q does not represent a PillUnsigned8", str)
+	v := PillVarint(id)
+	if uint64(v) != id || !v.IsValid() {
+		return fmt.Errorf("PillVarint(%d) is %w", id, ErrNoValidEnum)
 	}
+	*_p = v
 	return nil
 }
 
-// MarshalGQL implements the graphql.Marshaler interface for PillUnsigned8.
-func (_p PillUnsigned8) MarshalGQL(w io.Writer) {
-	fmt.Fprint(w, strconv.Quote(_p.String()))
+// GobEncode implements the gob.GobEncoder interface for PillVarint.
+func (_p PillVarint) GobEncode() ([]byte, error) {
+	if err := _p.Validate(); err != nil {
+		return nil, fmt.Errorf("Cannot marshal value %q as PillVarint. %w", _p, err)
+	}
+	return []byte(_p.String()), nil
 }
 
-// UnmarshalGQL implements the graphql.Unmarshaler interface for PillUnsigned8.
-func (_p *PillUnsigned8) UnmarshalGQL(value interface{}) error {
-	var str string
-	switch v := value.(type) {
-	case []byte:
-		str = string(v)
-	case string:
-		str = v
-	case fmt.Stringer:
-		str = v.String()
-	default:
-		return fmt.Errorf("invalid value of PillUnsigned8: %[1]T(%[1]v)", value)
-	}
+// GobDecode implements the gob.GobDecoder interface for PillVarint.
+func (_p *PillVarint) GobDecode(data []byte) error {
+	str := string(data)
 	if len(str) == 0 {
-		return fmt.Errorf("PillUnsigned8 cannot be derived from empty string")
+		return fmt.Errorf("PillVarint cannot be derived from empty string")
 	}
 
 	var ok bool
-	*_p, ok = PillUnsigned8FromString(str)
+	*_p, ok = PillVarintFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a PillUnsigned8", str)
+		return _enumer.NewParseError("PillVarint", str, PillVarintStrings())
 	}
 	return nil
 }
 
-// MarshalJSON implements the json.Marshaler interface for PillUnsigned8.
-func (_p PillUnsigned8) MarshalJSON() ([]byte, error) {
+// MarshalJSON implements the json.Marshaler interface for PillVarint.
+func (_p PillVarint) MarshalJSON() ([]byte, error) {
 	if err := _p.Validate(); err != nil {
-		return nil, fmt.Errorf("Cannot marshal value %q as PillUnsigned8. %w", _p, err)
+		return nil, fmt.Errorf("Cannot marshal value %q as PillVarint. %w", _p, err)
 	}
 	return json.Marshal(_p.String())
 }
 
-// UnmarshalJSON implements the json.Unmarshaler interface for PillUnsigned8.
-func (_p *PillUnsigned8) UnmarshalJSON(data []byte) error {
+// UnmarshalJSON implements the json.Unmarshaler interface for PillVarint.
+func (_p *PillVarint) UnmarshalJSON(data []byte) error {
 	var str string
 	if err := json.Unmarshal(data, &str); err != nil {
-		return fmt.Errorf("PillUnsigned8 should be a string, got %q", data)
+		return fmt.Errorf("PillVarint should be a string, got %q", data)
 	}
 	if len(str) == 0 {
-		return fmt.Errorf("PillUnsigned8 cannot be derived from empty string")
+		return fmt.Errorf("PillVarint cannot be derived from empty string")
 	}
 
 	var ok bool
-	*_p, ok = PillUnsigned8FromString(str)
+	*_p, ok = PillVarintFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a PillUnsigned8", str)
+		return _enumer.NewParseError("PillVarint", str, PillVarintStrings())
 	}
 	return nil
 }
 
-// Value implements the sql/driver.Valuer interface for PillUnsigned8.
-func (_p PillUnsigned8) Value() (driver.Value, error) {
+// MarshalText implements the encoding.TextMarshaler interface for PillVarint.
+func (_p PillVarint) MarshalText() ([]byte, error) {
+	return _p.AppendText(nil)
+}
+
+// AppendText implements the encoding.TextAppender interface for PillVarint.
+func (_p PillVarint) AppendText(b []byte) ([]byte, error) {
 	if err := _p.Validate(); err != nil {
-		return nil, fmt.Errorf("Cannot serialize value %q as PillUnsigned8. %w", _p, err)
+		return b, fmt.Errorf("Cannot marshal value %q as PillVarint. %w", _p, err)
 	}
-	return _p.String(), nil
+	return append(b, _p.String()...), nil
 }
 
-// Scan implements the sql/driver.Scanner interface for PillUnsigned8.
-func (_p *PillUnsigned8) Scan(value interface{}) error {
-	var str string
-	switch v := value.(type) {
-	case []byte:
-		str = string(v)
-	case string:
-		str = v
-	case fmt.Stringer:
-		str = v.String()
-	default:
-		return fmt.Errorf("invalid value of PillUnsigned8: %[1]T(%[1]v)", value)
-	}
+// UnmarshalText implements the encoding.TextUnmarshaler interface for PillVarint.
+func (_p *PillVarint) UnmarshalText(text []byte) error {
+	str := string(text)
 	if len(str) == 0 {
-		return fmt.Errorf("PillUnsigned8 cannot be derived from empty string")
+		return fmt.Errorf("PillVarint cannot be derived from empty string")
 	}
 
 	var ok bool
-	*_p, ok = PillUnsigned8FromString(str)
+	*_p, ok = PillVarintFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a PillUnsigned8", str)
+		return _enumer.NewParseError("PillVarint", str, PillVarintStrings())
 	}
 	return nil
 }
 
-// MarshalText implements the encoding.TextMarshaler interface for PillUnsigned8.
-func (_p PillUnsigned8) MarshalText() ([]byte, error) {
+// MarshalYAML implements a YAML Marshaler for PillVarint.
+func (_p PillVarint) MarshalYAML() (interface{}, error) {
 	if err := _p.Validate(); err != nil {
-		return nil, fmt.Errorf("Cannot marshal value %q as PillUnsigned8. %w", _p, err)
+		return nil, fmt.Errorf("Cannot marshal value %q as PillVarint. %w", _p, err)
 	}
-	return []byte(_p.String()), nil
+	return _p.String(), nil
 }
 
-// UnmarshalText implements the encoding.TextUnmarshaler interface for PillUnsigned8.
-func (_p *PillUnsigned8) UnmarshalText(text []byte) error {
-	str := string(text)
+// UnmarshalYAML implements a YAML Unmarshaler for PillVarint.
+func (_p *PillVarint) UnmarshalYAML(n *yaml.Node) error {
+	const stringTag = "!!str"
+	if n.ShortTag() != stringTag {
+		return fmt.Errorf("PillVarint must be derived from a string node")
+	}
+	str := n.Value
 	if len(str) == 0 {
-		return fmt.Errorf("PillUnsigned8 cannot be derived from empty string")
+		return fmt.Errorf("PillVarint cannot be derived from empty string")
 	}
 
 	var ok bool
-	*_p, ok = PillUnsigned8FromString(str)
+	*_p, ok = PillVarintFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a PillUnsigned8", str)
+		return _enumer.NewParseError("PillVarint", str, PillVarintStrings())
 	}
 	return nil
 }
 
-// MarshalYAML implements a YAML Marshaler for PillUnsigned8.
-func (_p PillUnsigned8) MarshalYAML() (interface{}, error) {
-	if err := _p.Validate(); err != nil {
-		return nil, fmt.Errorf("Cannot marshal value %q as PillUnsigned8. %w", _p, err)
+var _PillVarintCompletions = [5]string{
+	"PLACEBO",
+	"ASPIRIN",
+	"IBUPROFEN",
+	"PARACETAMOL",
+	"VITAMIN-C",
+}
+
+// PillVarintUsage returns a usage hint of all String values of the enum, less the deprecated values,
+// e.g. for flag descriptions.
+func PillVarintUsage() string {
+	return "one of: " + strings.Join(_PillVarintStrings[:], "|")
+}
+
+// PillVarintCompletions returns the completion candidates of the enum matching the given prefix,
+// less the deprecated values. Each candidate is formatted as "<value>" or "<value>\t<description>",
+// as expected by shell completions, e.g. of cobra's RegisterFlagCompletionFunc.
+func PillVarintCompletions(toComplete string) []string {
+	out := make([]string, 0, len(_PillVarintCompletions))
+	for _, c := range _PillVarintCompletions {
+		if strings.HasPrefix(c, toComplete) {
+			out = append(out, c)
+		}
 	}
-	return _p.String(), nil
+	return out
 }
 
-// UnmarshalYAML implements a YAML Unmarshaler for PillUnsigned8.
-func (_p *PillUnsigned8) UnmarshalYAML(n *yaml.Node) error {
-	const stringTag = "!!str"
-	if n.ShortTag() != stringTag {
-		return fmt.Errorf("PillUnsigned8 must be derived from a string node")
+// PillVarintSet is a set of PillVarint values backed by a bitset.
+// The zero value is an empty set.
+type PillVarintSet struct {
+	bits [1]uint64
+}
+
+// NewPillVarintSet returns a set containing the given values.
+// Invalid values are ignored.
+func NewPillVarintSet(values ...PillVarint) PillVarintSet {
+	var _p PillVarintSet
+	_p.Add(values...)
+	return _p
+}
+
+// Add adds the values to the set. Invalid values are ignored.
+func (_p *PillVarintSet) Add(values ...PillVarint) {
+	for _, v := range values {
+		if !v.IsValid() {
+			continue
+		}
+		idx := uint64(v) - 0
+		_p.bits[idx/64] |= 1 << (idx % 64)
 	}
-	str := n.Value
+}
+
+// Remove removes the values from the set.
+func (_p *PillVarintSet) Remove(values ...PillVarint) {
+	for _, v := range values {
+		if !v.IsValid() {
+			continue
+		}
+		idx := uint64(v) - 0
+		_p.bits[idx/64] &^= 1 << (idx % 64)
+	}
+}
+
+// Contains tests whether the value is an element of the set.
+func (_p PillVarintSet) Contains(v PillVarint) bool {
+	if !v.IsValid() {
+		return false
+	}
+	idx := uint64(v) - 0
+	return _p.bits[idx/64]&(1<<(idx%64)) != 0
+}
+
+// Union returns a set of all values contained in either set.
+func (_p PillVarintSet) Union(other PillVarintSet) PillVarintSet {
+	for idx := range _p.bits {
+		_p.bits[idx] |= other.bits[idx]
+	}
+	return _p
+}
+
+// Intersect returns a set of all values contained in both sets.
+func (_p PillVarintSet) Intersect(other PillVarintSet) PillVarintSet {
+	for idx := range _p.bits {
+		_p.bits[idx] &= other.bits[idx]
+	}
+	return _p
+}
+
+// Difference returns a set of all values contained in the set, but not in the other set.
+func (_p PillVarintSet) Difference(other PillVarintSet) PillVarintSet {
+	for idx := range _p.bits {
+		_p.bits[idx] &^= other.bits[idx]
+	}
+	return _p
+}
+
+// Len returns the count of values in the set.
+func (_p PillVarintSet) Len() int {
+	var n int
+	for _, w := range _p.bits {
+		n += bits.OnesCount64(w)
+	}
+	return n
+}
+
+// All returns all values of the set in ascending order.
+func (_p PillVarintSet) All() []PillVarint {
+	out := make([]PillVarint, 0, _p.Len())
+	for idx, w := range _p.bits {
+		for ; w != 0; w &= w - 1 {
+			out = append(out, PillVarint(uint64(idx*64+bits.TrailingZeros64(w))+0))
+		}
+	}
+	return out
+}
+
+// Strings returns the String values of all values of the set in ascending order.
+func (_p PillVarintSet) Strings() []string {
+	values := _p.All()
+	out := make([]string, len(values))
+	for idx, v := range values {
+		out[idx] = v.String()
+	}
+	return out
+}
+
+// String implements the Stringer interface.
+func (_p PillVarintSet) String() string {
+	return "[" + strings.Join(_p.Strings(), " ") + "]"
+}
+
+// setStrings replaces the values of the set by the values of the given String values.
+func (_p *PillVarintSet) setStrings(strs []string) error {
+	*_p = PillVarintSet{}
+	for _, str := range strs {
+		v, ok := PillVarintFromString(str)
+		if !ok {
+			return _enumer.NewParseError("PillVarint", str, PillVarintStrings())
+		}
+		_p.Add(v)
+	}
+	return nil
+}
+
+// setJoined replaces the values of the set by the values of a comma-joined
+// list of String values.
+func (_p *PillVarintSet) setJoined(str string) error {
 	if len(str) == 0 {
-		return fmt.Errorf("PillUnsigned8 cannot be derived from empty string")
+		*_p = PillVarintSet{}
+		return nil
 	}
+	return _p.setStrings(strings.Split(str, ","))
+}
 
-	var ok bool
-	*_p, ok = PillUnsigned8FromString(str)
-	if !ok {
-		return fmt.Errorf("Value %q does not represent a PillUnsigned8", str)
+// MarshalBinary implements the encoding.BinaryMarshaler interface for PillVarintSet.
+// The values are encoded compactly as a sequence of uvarints of their numeric representation.
+func (_p PillVarintSet) MarshalBinary() ([]byte, error) {
+	return _p.AppendBinary(nil)
+}
+
+// AppendBinary implements the encoding.BinaryAppender interface for PillVarintSet.
+func (_p PillVarintSet) AppendBinary(b []byte) ([]byte, error) {
+	for idx, w := range _p.bits {
+		for ; w != 0; w &= w - 1 {
+			b = binary.AppendUvarint(b, uint64(idx*64+bits.TrailingZeros64(w))+0)
+		}
+	}
+	return b, nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface for PillVarintSet.
+func (_p *PillVarintSet) UnmarshalBinary(data []byte) error {
+	*_p = PillVarintSet{}
+	for len(data) > 0 {
+		id, n := binary.Uvarint(data)
+		if n <= 0 {
+			return fmt.Errorf("PillVarintSet cannot be derived from malformed varint %#x", data)
+		}
+		v := PillVarint(id)
+		if uint64(v) != id || !v.IsValid() {
+			return fmt.Errorf("PillVarint(%d) is %w", id, ErrNoValidEnum)
+		}
+		_p.Add(v)
+		data = data[n:]
 	}
 	return nil
 }
+
+// GobEncode implements the gob.GobEncoder interface for PillVarintSet.
+func (_p PillVarintSet) GobEncode() ([]byte, error) {
+	return []byte(strings.Join(_p.Strings(), ",")), nil
+}
+
+// GobDecode implements the gob.GobDecoder interface for PillVarintSet.
+func (_p *PillVarintSet) GobDecode(data []byte) error {
+	return _p.setJoined(string(data))
+}
+
+// MarshalJSON implements the json.Marshaler interface for PillVarintSet.
+func (_p PillVarintSet) MarshalJSON() ([]byte, error) {
+	return json.Marshal(_p.Strings())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for PillVarintSet.
+func (_p *PillVarintSet) UnmarshalJSON(data []byte) error {
+	var strs []string
+	if err := json.Unmarshal(data, &strs); err != nil {
+		return fmt.Errorf("PillVarintSet should be an array of strings, got %q", data)
+	}
+	return _p.setStrings(strs)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface for PillVarintSet.
+func (_p PillVarintSet) MarshalText() ([]byte, error) {
+	return _p.AppendText(nil)
+}
+
+// AppendText implements the encoding.TextAppender interface for PillVarintSet.
+func (_p PillVarintSet) AppendText(b []byte) ([]byte, error) {
+	return append(b, strings.Join(_p.Strings(), ",")...), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface for PillVarintSet.
+func (_p *PillVarintSet) UnmarshalText(text []byte) error {
+	return _p.setJoined(string(text))
+}
+
+// MarshalYAML implements a YAML Marshaler for PillVarintSet.
+func (_p PillVarintSet) MarshalYAML() (interface{}, error) {
+	return _p.Strings(), nil
+}
+
+// UnmarshalYAML implements a YAML Unmarshaler for PillVarintSet.
+func (_p *PillVarintSet) UnmarshalYAML(n *yaml.Node) error {
+	var strs []string
+	if err := n.Decode(&strs); err != nil {
+		return err
+	}
+	return _p.setStrings(strs)
+}
@@ -0,0 +1,57 @@
+package pills
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+type prescription struct {
+	ID             uint
+	PillNumeric    PillNumeric
+	PillUnsigned32 PillUnsigned32
+}
+
+// TestGorm asserts that GORM migrations derive the column types
+// from the storage of the enums and that the enums round trip through GORM.
+func TestGorm(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{Logger: logger.Discard})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&prescription{}))
+
+	t.Run("column types", func(t *testing.T) {
+		columnTypes, err := db.Migrator().ColumnTypes(&prescription{})
+		require.NoError(t, err)
+		actual := map[string]string{}
+		for _, c := range columnTypes {
+			actual[c.Name()] = c.DatabaseTypeName()
+			if length, ok := c.Length(); ok {
+				actual[c.Name()] += fmt.Sprintf("(%d)", length)
+			}
+		}
+		require.Equal(t, "integer", actual["pill_numeric"])
+		require.Equal(t, "varchar(13)", actual["pill_unsigned32"]) // hint: fits "ACETAMINOPHEN"
+	})
+	t.Run("round trip", func(t *testing.T) {
+		in := prescription{PillNumeric: PillNumericIbuprofen, PillUnsigned32: PillUnsigned32VitaminC}
+		require.NoError(t, db.Create(&in).Error)
+
+		var out prescription
+		require.NoError(t, db.Where(&prescription{PillUnsigned32: PillUnsigned32VitaminC}).First(&out).Error)
+		require.Equal(t, in, out)
+
+		var numeric int64
+		var str string
+		require.NoError(t, db.Raw(`SELECT pill_numeric, pill_unsigned32 FROM prescriptions WHERE id = ?`, in.ID).Row().Scan(&numeric, &str))
+		require.Equal(t, int64(PillNumericIbuprofen), numeric)
+		require.Equal(t, PillUnsigned32VitaminC.String(), str)
+	})
+	t.Run("fail on invalid values", func(t *testing.T) {
+		err := db.Create(&prescription{PillNumeric: PillNumeric(42), PillUnsigned32: PillUnsigned32VitaminC}).Error
+		require.ErrorContains(t, err, ErrNoValidEnum.Error())
+	})
+}
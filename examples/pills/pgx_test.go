@@ -0,0 +1,76 @@
+package pills
+
+import (
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+)
+
+// TestPgx asserts the encoding and decoding of enums via the pgx type map,
+// which pgx utilizes for query arguments and results.
+// The Postgres enum type "pill_unsigned32" and its array type are registered
+// the same way as RegisterPillUnsigned32PgxType registers the loaded types.
+func TestPgx(t *testing.T) {
+	const enumOID, arrayOID = 100001, 100002
+
+	m := pgtype.NewMap()
+	enumType := &pgtype.Type{Name: "pill_unsigned32", OID: enumOID, Codec: &pgtype.EnumCodec{}}
+	m.RegisterType(enumType)
+	m.RegisterType(&pgtype.Type{Name: "_pill_unsigned32", OID: arrayOID, Codec: &pgtype.ArrayCodec{ElementType: enumType}})
+
+	t.Run("numeric values", func(t *testing.T) {
+		for _, oid := range []uint32{pgtype.Int2OID, pgtype.Int4OID, pgtype.Int8OID} {
+			for _, format := range []int16{pgtype.TextFormatCode, pgtype.BinaryFormatCode} {
+				buf, err := m.Encode(oid, format, PillNumericParacetamol, nil)
+				require.NoError(t, err)
+
+				var actual PillNumeric
+				require.NoError(t, m.Scan(oid, format, buf, &actual))
+				require.Equal(t, PillNumericParacetamol, actual)
+
+				var raw int64
+				require.NoError(t, m.Scan(oid, format, buf, &raw))
+				require.Equal(t, int64(PillNumericParacetamol), raw)
+			}
+		}
+	})
+	t.Run("string values", func(t *testing.T) {
+		for _, oid := range []uint32{enumOID, pgtype.TextOID, pgtype.VarcharOID} {
+			for _, format := range []int16{pgtype.TextFormatCode, pgtype.BinaryFormatCode} {
+				buf, err := m.Encode(oid, format, PillUnsigned32VitaminC, nil)
+				require.NoError(t, err)
+				require.Equal(t, PillUnsigned32VitaminC.String(), string(buf))
+
+				var actual PillUnsigned32
+				require.NoError(t, m.Scan(oid, format, buf, &actual))
+				require.Equal(t, PillUnsigned32VitaminC, actual)
+			}
+		}
+	})
+	t.Run("arrays of string values", func(t *testing.T) {
+		in := []PillUnsigned32{PillUnsigned32Aspirin, PillUnsigned32VitaminC}
+		buf, err := m.Encode(arrayOID, pgtype.TextFormatCode, in, nil)
+		require.NoError(t, err)
+		require.Equal(t, "{ASPIRIN,VITAMIN-C}", string(buf))
+
+		var actual []PillUnsigned32
+		require.NoError(t, m.Scan(arrayOID, pgtype.TextFormatCode, buf, &actual))
+		require.Equal(t, in, actual)
+	})
+	t.Run("fail on invalid values", func(t *testing.T) {
+		_, err := m.Encode(pgtype.Int8OID, pgtype.BinaryFormatCode, PillNumeric(42), nil)
+		require.ErrorIs(t, err, ErrNoValidEnum)
+		_, err = m.Encode(enumOID, pgtype.TextFormatCode, PillUnsigned32(42), nil)
+		require.ErrorIs(t, err, ErrNoValidEnum)
+
+		var numeric PillNumeric
+		require.ErrorIs(t, m.Scan(pgtype.Int8OID, pgtype.TextFormatCode, []byte("42"), &numeric), ErrNoValidEnum)
+		require.ErrorIs(t, m.Scan(pgtype.Int8OID, pgtype.TextFormatCode, []byte("-1"), &numeric), ErrNoValidEnum)
+		require.Error(t, m.Scan(pgtype.Int8OID, pgtype.TextFormatCode, nil, &numeric))
+
+		var str PillUnsigned32
+		require.ErrorIs(t, m.Scan(enumOID, pgtype.TextFormatCode, []byte("UNKNOWN"), &str), ErrNoValidEnum)
+		require.Error(t, m.Scan(enumOID, pgtype.TextFormatCode, nil, &str))
+	})
+}
@@ -1,7 +1,7 @@
 package planets
 
 // Planet has NO default value here.
-//go:enum
+//go:enum -support=set
 type Planet uint8
 
 const (
@@ -35,7 +35,7 @@ const (
 // But it supports deserialization from "undefined"/zero values
 // and serialization to an "" (empty string).
 // For this scenario a special const will be generated "<type>Undefined"
-//go:enum -support=undefined
+//go:enum -support=set,undefined
 type PlanetSupportUndefined uint8
 
 const (
@@ -65,3 +65,28 @@ const (
 	PlanetSupportUndefinedWithDefaultUranus
 	PlanetSupportUndefinedWithDefaultNeptune
 )
+
+// PlanetWithExplicitDefault has an explicitly configured default value Earth,
+// which is used for deserialization of empty values.
+//go:enum -default=Earth
+type PlanetWithExplicitDefault uint8
+
+const (
+	PlanetWithExplicitDefaultMercury PlanetWithExplicitDefault = iota + 1
+	PlanetWithExplicitDefaultVenus
+	PlanetWithExplicitDefaultEarth
+	PlanetWithExplicitDefaultMars
+	PlanetWithExplicitDefaultJupiter
+	PlanetWithExplicitDefaultSaturn
+	PlanetWithExplicitDefaultUranus
+	PlanetWithExplicitDefaultNeptune
+)
+
+// enum shares its name with the runtime package of the generated code,
+// which is therefore imported under an alias.
+func enum(p Planet) string {
+	if !p.IsValid() {
+		return "<unknown planet>"
+	}
+	return p.String()
+}
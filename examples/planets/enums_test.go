@@ -1,9 +1,15 @@
 package planets
 
 import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
 	"testing"
 
 	"github.com/mvrahden/go-enumer/pkg/utils"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"gopkg.in/yaml.v3"
 )
 
 func TestEnums(t *testing.T) {
@@ -24,10 +30,131 @@ func TestEnums(t *testing.T) {
 				{From: "Neptune", Enum: toPtr(PlanetNeptune), Expected: utils.Expected{AsSerialized: "Neptune"}},
 			}
 			for idx, tC := range testCases {
-				serializers := []string{"binary", "graphql", "json", "sql", "text", "yaml"}
+				serializers := []string{"binary", "cbor", "graphql", "json", "msgpack", "sql", "text", "xml", "yaml"}
 				utils.AssertSerializationInterfacesFor[Planet](t, idx, tC, cfg, serializers)
 			}
 		})
+		t.Run("Set", func(t *testing.T) {
+			s := NewPlanetSet(PlanetVenus, PlanetMars, Planet(0), Planet(9))
+			require.Equal(t, 2, s.Len())
+			require.Equal(t, []Planet{PlanetMars, PlanetVenus}, s.All())
+			require.Equal(t, "[Mars Venus]", s.String())
+			require.True(t, s.Contains(PlanetMars))
+			require.False(t, s.Contains(PlanetPluto))
+			require.False(t, s.Contains(Planet(0)))
+
+			s.Add(PlanetNeptune)
+			s.Remove(PlanetMars, PlanetPluto)
+			require.Equal(t, []Planet{PlanetVenus, PlanetNeptune}, s.All())
+
+			other := NewPlanetSet(PlanetVenus, PlanetSaturn)
+			require.Equal(t, []Planet{PlanetVenus, PlanetSaturn, PlanetNeptune}, s.Union(other).All())
+			require.Equal(t, []Planet{PlanetVenus}, s.Intersect(other).All())
+			require.Equal(t, []Planet{PlanetNeptune}, s.Difference(other).All())
+			require.Empty(t, PlanetSet{}.All())
+			require.Equal(t, 0, PlanetSet{}.Len())
+
+			t.Run("Serialization", func(t *testing.T) {
+				s := NewPlanetSet(PlanetMars, PlanetVenus)
+
+				buf, err := json.Marshal(s)
+				require.NoError(t, err)
+				require.Equal(t, `["Mars","Venus"]`, string(buf))
+				buf, err = json.Marshal(PlanetSet{})
+				require.NoError(t, err)
+				require.Equal(t, `[]`, string(buf))
+				var fromJSON PlanetSet
+				require.NoError(t, json.Unmarshal([]byte(`["Venus","Mars"]`), &fromJSON))
+				require.Equal(t, s, fromJSON)
+				require.NoError(t, json.Unmarshal([]byte(`null`), &fromJSON))
+				require.Zero(t, fromJSON.Len())
+				err = json.Unmarshal([]byte(`["Mars","Earth"]`), &fromJSON)
+				require.ErrorIs(t, err, ErrNoValidEnum)
+
+				buf, err = yaml.Marshal(s)
+				require.NoError(t, err)
+				require.Equal(t, "- Mars\n- Venus\n", string(buf))
+				var fromYAML PlanetSet
+				require.NoError(t, yaml.Unmarshal(buf, &fromYAML))
+				require.Equal(t, s, fromYAML)
+
+				typ, data, err := s.MarshalBSONValue()
+				require.NoError(t, err)
+				require.Equal(t, bsontype.Array, typ)
+				var fromBSON PlanetSet
+				require.NoError(t, fromBSON.UnmarshalBSONValue(typ, data))
+				require.Equal(t, s, fromBSON)
+				require.Error(t, fromBSON.UnmarshalBSONValue(bsontype.String, data))
+
+				value, err := s.Value()
+				require.NoError(t, err)
+				require.Equal(t, "{Mars,Venus}", value)
+				var fromSQL PlanetSet
+				require.NoError(t, fromSQL.Scan(value))
+				require.Equal(t, s, fromSQL)
+				require.NoError(t, fromSQL.Scan([]byte(`{Venus, "Mars"}`)))
+				require.Equal(t, s, fromSQL)
+				require.NoError(t, fromSQL.Scan(nil))
+				require.Zero(t, fromSQL.Len())
+				require.ErrorIs(t, fromSQL.Scan("{Mars,Earth}"), ErrNoValidEnum)
+				require.Error(t, fromSQL.Scan("Mars,Venus"))
+				value, err = PlanetSet{}.Value()
+				require.NoError(t, err)
+				require.Equal(t, "{}", value)
+				require.NoError(t, fromSQL.Scan(value))
+				require.Zero(t, fromSQL.Len())
+
+				text, err := s.MarshalText()
+				require.NoError(t, err)
+				require.Equal(t, "Mars,Venus", string(text))
+				var fromText PlanetSet
+				require.NoError(t, fromText.UnmarshalText(text))
+				require.Equal(t, s, fromText)
+				text, err = s.AppendText([]byte("via:"))
+				require.NoError(t, err)
+				require.Equal(t, "via:Mars,Venus", string(text))
+				buf, err = s.AppendBinary([]byte("via:"))
+				require.NoError(t, err)
+				require.Equal(t, "via:Mars,Venus", string(buf))
+
+				buf, err = s.MarshalCBOR()
+				require.NoError(t, err)
+				var fromCBOR PlanetSet
+				require.NoError(t, fromCBOR.UnmarshalCBOR(buf))
+				require.Equal(t, s, fromCBOR)
+
+				buf, err = s.MarshalMsgpack()
+				require.NoError(t, err)
+				var fromMsgpack PlanetSet
+				require.NoError(t, fromMsgpack.UnmarshalMsgpack(buf))
+				require.Equal(t, s, fromMsgpack)
+
+				gql := bytes.NewBuffer(nil)
+				s.MarshalGQL(gql)
+				require.Equal(t, `["Mars","Venus"]`, gql.String())
+				var fromGQL PlanetSet
+				require.NoError(t, fromGQL.UnmarshalGQL([]interface{}{"Mars", "Venus"}))
+				require.Equal(t, s, fromGQL)
+				require.NoError(t, fromGQL.UnmarshalGQL("Mars"))
+				require.Equal(t, NewPlanetSet(PlanetMars), fromGQL)
+
+				type route struct {
+					Via  PlanetSet `xml:"via,attr"`
+					Stop PlanetSet `xml:"stop"`
+				}
+				buf, err = xml.Marshal(route{Via: s, Stop: NewPlanetSet(PlanetSaturn)})
+				require.NoError(t, err)
+				require.Equal(t, `<route via="Mars,Venus"><stop>Saturn</stop></route>`, string(buf))
+				var fromXML route
+				require.NoError(t, xml.Unmarshal(buf, &fromXML))
+				require.Equal(t, s, fromXML.Via)
+				require.Equal(t, NewPlanetSet(PlanetSaturn), fromXML.Stop)
+			})
+		})
+		t.Run("Package identifier named like the runtime package", func(t *testing.T) {
+			require.Equal(t, "Mars", enum(PlanetMars))
+			require.Equal(t, "<unknown planet>", enum(Planet(0)))
+		})
 	})
 	t.Run("PlanetWithDefault", func(t *testing.T) {
 		t.Run("Serialization", func(t *testing.T) {
@@ -50,12 +177,54 @@ func TestEnums(t *testing.T) {
 				{From: "Neptune", Enum: toPtr(PlanetWithDefaultNeptune), Expected: utils.Expected{AsSerialized: "Neptune"}},
 			}
 			for idx, tC := range testCases {
-				serializers := []string{"binary", "graphql", "json", "sql", "text", "yaml"}
+				serializers := []string{"binary", "cbor", "graphql", "json", "msgpack", "sql", "text", "xml", "yaml"}
 				utils.AssertSerializationInterfacesFor[PlanetWithDefault](t, idx, tC, cfg, serializers)
 			}
 		})
 	})
 	t.Run("PlanetSupportUndefined", func(t *testing.T) {
+		t.Run("Set", func(t *testing.T) {
+			for _, s := range []PlanetSupportUndefinedSet{
+				{},
+				NewPlanetSupportUndefinedSet(PlanetSupportUndefined(0)),
+				NewPlanetSupportUndefinedSet(PlanetSupportUndefined(0), PlanetSupportUndefinedVenus),
+			} {
+				value, err := s.Value()
+				require.NoError(t, err)
+				var fromSQL PlanetSupportUndefinedSet
+				require.NoError(t, fromSQL.Scan(value))
+				require.Equal(t, s, fromSQL, value)
+			}
+			value, err := NewPlanetSupportUndefinedSet(PlanetSupportUndefined(0)).Value()
+			require.NoError(t, err)
+			require.Equal(t, `{""}`, value)
+		})
+		t.Run("Ordering", func(t *testing.T) {
+			require.Equal(t, -1, PlanetSupportUndefined(0).Index())
+			require.Equal(t, -1, PlanetSupportUndefined(9).Index())
+			require.Equal(t, 0, PlanetSupportUndefinedMars.Index())
+			require.Equal(t, 7, PlanetSupportUndefinedNeptune.Index())
+
+			require.Equal(t, -1, PlanetSupportUndefinedMars.Compare(PlanetSupportUndefinedPluto))
+			require.Equal(t, +1, PlanetSupportUndefinedPluto.Compare(PlanetSupportUndefinedMars))
+			require.Equal(t, 0, PlanetSupportUndefinedPluto.Compare(PlanetSupportUndefinedPluto))
+			require.Equal(t, -1, PlanetSupportUndefined(0).Compare(PlanetSupportUndefinedMars))
+			require.True(t, PlanetSupportUndefinedMars.Less(PlanetSupportUndefinedNeptune))
+			require.False(t, PlanetSupportUndefinedNeptune.Less(PlanetSupportUndefinedMars))
+
+			next, ok := PlanetSupportUndefinedMars.Next()
+			require.True(t, ok)
+			require.Equal(t, PlanetSupportUndefinedPluto, next)
+			_, ok = PlanetSupportUndefinedNeptune.Next()
+			require.False(t, ok)
+			_, ok = PlanetSupportUndefined(0).Next()
+			require.False(t, ok)
+			prev, ok := PlanetSupportUndefinedNeptune.Prev()
+			require.True(t, ok)
+			require.Equal(t, PlanetSupportUndefinedUranus, prev)
+			_, ok = PlanetSupportUndefinedMars.Prev()
+			require.False(t, ok)
+		})
 		t.Run("Serialization", func(t *testing.T) {
 			toPtr := utils.ToPointer[PlanetSupportUndefined]
 			cfg := utils.TestConfig{SupportUndefined: true}
@@ -72,7 +241,7 @@ func TestEnums(t *testing.T) {
 				{From: "Neptune", Enum: toPtr(PlanetSupportUndefinedNeptune), Expected: utils.Expected{AsSerialized: "Neptune"}},
 			}
 			for idx, tC := range testCases {
-				serializers := []string{"binary", "graphql", "json", "sql", "text", "yaml"}
+				serializers := []string{"binary", "cbor", "graphql", "json", "msgpack", "sql", "text", "xml", "yaml"}
 				utils.AssertSerializationInterfacesFor[PlanetSupportUndefined](t, idx, tC, cfg, serializers)
 			}
 		})
@@ -95,9 +264,44 @@ func TestEnums(t *testing.T) {
 				{From: "Neptune", Enum: toPtr(PlanetSupportUndefinedWithDefaultNeptune), Expected: utils.Expected{AsSerialized: "Neptune"}},
 			}
 			for idx, tC := range testCases {
-				serializers := []string{"binary", "graphql", "json", "sql", "text", "yaml"}
+				serializers := []string{"binary", "cbor", "graphql", "json", "msgpack", "sql", "text", "xml", "yaml"}
 				utils.AssertSerializationInterfacesFor[PlanetSupportUndefinedWithDefault](t, idx, tC, cfg, serializers)
 			}
 		})
 	})
+	t.Run("PlanetWithExplicitDefault", func(t *testing.T) {
+		t.Run("Serialization", func(t *testing.T) {
+			toPtr := utils.ToPointer[PlanetWithExplicitDefault]
+			cfg := utils.TestConfig{Default: PlanetWithExplicitDefaultEarth}
+			testCases := []utils.TestCase{
+				{From: "PlanetWithExplicitDefault(0)", Enum: toPtr(0), Expected: utils.Expected{AsSerialized: "PlanetWithExplicitDefault(0)", IsInvalid: true}},
+				{From: "PlanetWithExplicitDefault(9)", Enum: toPtr(9), Expected: utils.Expected{AsSerialized: "PlanetWithExplicitDefault(9)", IsInvalid: true}},
+				{From: "", Enum: toPtr(PlanetWithExplicitDefaultEarth), Expected: utils.Expected{AsSerialized: "Earth"}},
+				{From: "Mercury", Enum: toPtr(PlanetWithExplicitDefaultMercury), Expected: utils.Expected{AsSerialized: "Mercury"}},
+				{From: "Venus", Enum: toPtr(PlanetWithExplicitDefaultVenus), Expected: utils.Expected{AsSerialized: "Venus"}},
+				{From: "Earth", Enum: toPtr(PlanetWithExplicitDefaultEarth), Expected: utils.Expected{AsSerialized: "Earth"}},
+				{From: "Mars", Enum: toPtr(PlanetWithExplicitDefaultMars), Expected: utils.Expected{AsSerialized: "Mars"}},
+				{From: "Jupiter", Enum: toPtr(PlanetWithExplicitDefaultJupiter), Expected: utils.Expected{AsSerialized: "Jupiter"}},
+				{From: "Saturn", Enum: toPtr(PlanetWithExplicitDefaultSaturn), Expected: utils.Expected{AsSerialized: "Saturn"}},
+				{From: "Uranus", Enum: toPtr(PlanetWithExplicitDefaultUranus), Expected: utils.Expected{AsSerialized: "Uranus"}},
+				{From: "Neptune", Enum: toPtr(PlanetWithExplicitDefaultNeptune), Expected: utils.Expected{AsSerialized: "Neptune"}},
+			}
+			for idx, tC := range testCases {
+				serializers := []string{"binary", "bson", "cbor", "graphql", "json", "msgpack", "sql", "text", "xml", "yaml"}
+				utils.AssertSerializationInterfacesFor[PlanetWithExplicitDefault](t, idx, tC, cfg, serializers)
+			}
+		})
+		t.Run("Default", func(t *testing.T) {
+			var v PlanetWithExplicitDefault
+			require.Equal(t, PlanetWithExplicitDefaultEarth, v.Default())
+			require.Equal(t, PlanetWithExplicitDefaultEarth, v.OrDefault())
+			require.Equal(t, PlanetWithExplicitDefaultEarth, PlanetWithExplicitDefault(9).OrDefault())
+			require.Equal(t, PlanetWithExplicitDefaultMars, PlanetWithExplicitDefaultMars.OrDefault())
+		})
+		t.Run("Deserialization from null", func(t *testing.T) {
+			var v PlanetWithExplicitDefault
+			require.NoError(t, v.UnmarshalBSONValue(bsontype.Null, nil))
+			require.Equal(t, PlanetWithExplicitDefaultEarth, v)
+		})
+	})
 }
@@ -5,22 +5,26 @@ package planets
 import (
 	"database/sql/driver"
 	"encoding/json"
-	"errors"
+	"encoding/xml"
 	"fmt"
+	"github.com/fxamacker/cbor/v2"
+	_enumer "github.com/mvrahden/go-enumer/enum"
+	"github.com/vmihailenco/msgpack/v5"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/bsontype"
 	"go.mongodb.org/mongo-driver/x/bsonx/bsoncore"
 	"io"
+	"math/bits"
 	"strconv"
+	"strings"
 )
 
 var (
-	ErrNoValidEnum = errors.New("not a valid enum")
+	ErrNoValidEnum = _enumer.ErrNoValidEnum
 )
 
 const (
-	_PlanetString      = "MarsPlutoVenusMercuryJupiterSaturnUranusNeptune"
-	_PlanetLowerString = "marsplutovenusmercuryjupitersaturnuranusneptune"
+	_PlanetString = "MarsPlutoVenusMercuryJupiterSaturnUranusNeptune"
 )
 
 var (
@@ -55,6 +59,11 @@ func PlanetStrings() []string {
 	return cp[:]
 }
 
+// Values returns all values of the enum.
+func (Planet) Values() []Planet {
+	return PlanetValues()
+}
+
 // IsValid tests whether the value is a valid enum value.
 func (_p Planet) IsValid() bool {
 	return _p >= 1 && _p <= 8
@@ -79,6 +88,54 @@ func (_p Planet) String() string {
 	return _PlanetStrings[idx]
 }
 
+// Index returns the position of the value within the ordered values of the enum
+// (ignoring alternative values) or -1 if the value is invalid.
+func (_p Planet) Index() int {
+	if !_p.IsValid() {
+		return -1
+	}
+	idx := int(_p) - 1
+	return idx
+}
+
+// Compare returns -1, 0 or +1 depending on whether the value orders before,
+// equal to or after the other value. Invalid values order before all valid values.
+func (_p Planet) Compare(other Planet) int {
+	a, b := _p.Index(), other.Index()
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return +1
+	}
+	return 0
+}
+
+// Less tests whether the value orders before the other value.
+func (_p Planet) Less(other Planet) bool {
+	return _p.Compare(other) < 0
+}
+
+// Next returns the succeeding value in order of the enum.
+// It returns false if the value is invalid or the last value.
+func (_p Planet) Next() (Planet, bool) {
+	idx := _p.Index()
+	if idx == -1 || idx+1 == len(_PlanetValues) {
+		return Planet(0), false
+	}
+	return _PlanetValues[idx+1], true
+}
+
+// Prev returns the preceding value in order of the enum.
+// It returns false if the value is invalid or the first value.
+func (_p Planet) Prev() (Planet, bool) {
+	idx := _p.Index()
+	if idx < 1 {
+		return Planet(0), false
+	}
+	return _PlanetValues[idx-1], true
+}
+
 var (
 	_PlanetStringToValueMap = map[string]Planet{
 		_PlanetString[0:4]:   PlanetMars,
@@ -90,18 +147,20 @@ var (
 		_PlanetString[34:40]: PlanetUranus,
 		_PlanetString[40:47]: PlanetNeptune,
 	}
-	_PlanetLowerStringToValueMap = map[string]Planet{
-		_PlanetLowerString[0:4]:   PlanetMars,
-		_PlanetLowerString[4:9]:   PlanetPluto,
-		_PlanetLowerString[9:14]:  PlanetVenus,
-		_PlanetLowerString[14:21]: PlanetMercury,
-		_PlanetLowerString[21:28]: PlanetJupiter,
-		_PlanetLowerString[28:34]: PlanetSaturn,
-		_PlanetLowerString[34:40]: PlanetUranus,
-		_PlanetLowerString[40:47]: PlanetNeptune,
-	}
 )
 
+// _PlanetLookupFold determines the enum value of the string regardless of its case
+// by Unicode simple folding. It scans the String values, as a fold table is only generated
+// for enums with the "ignore-case" feature.
+func _PlanetLookupFold(raw string) (Planet, bool) {
+	for idx := range _PlanetStrings {
+		if strings.EqualFold(_PlanetStrings[idx], raw) {
+			return _PlanetValues[idx], true
+		}
+	}
+	return Planet(0), false
+}
+
 // PlanetFromString determines the enum value with an exact case match.
 func PlanetFromString(raw string) (Planet, bool) {
 	v, ok := _PlanetStringToValueMap[raw]
@@ -111,13 +170,14 @@ func PlanetFromString(raw string) (Planet, bool) {
 	return v, true
 }
 
-// PlanetFromStringIgnoreCase determines the enum value with a case-insensitive match.
+// PlanetFromStringIgnoreCase determines the enum value with a case-insensitive match
+// by Unicode simple folding, regardless of the casing of raw.
 func PlanetFromStringIgnoreCase(raw string) (Planet, bool) {
 	v, ok := PlanetFromString(raw)
 	if ok {
 		return v, ok
 	}
-	v, ok = _PlanetLowerStringToValueMap[raw]
+	v, ok = _PlanetLookupFold(raw)
 	if !ok {
 		return Planet(0), false
 	}
@@ -126,10 +186,15 @@ func PlanetFromStringIgnoreCase(raw string) (Planet, bool) {
 
 // MarshalBinary implements the encoding.BinaryMarshaler interface for Planet.
 func (_p Planet) MarshalBinary() ([]byte, error) {
+	return _p.AppendBinary(nil)
+}
+
+// AppendBinary implements the encoding.BinaryAppender interface for Planet.
+func (_p Planet) AppendBinary(b []byte) ([]byte, error) {
 	if err := _p.Validate(); err != nil {
-		return nil, fmt.Errorf("Cannot marshal value %q as Planet. %w", _p, err)
+		return b, fmt.Errorf("Cannot marshal value %q as Planet. %w", _p, err)
 	}
-	return []byte(_p.String()), nil
+	return append(b, _p.String()...), nil
 }
 
 // UnmarshalBinary implements the encoding.BinaryUnmarshaler interface for Planet.
@@ -142,7 +207,7 @@ func (_p *Planet) UnmarshalBinary(text []byte) error {
 	var ok bool
 	*_p, ok = PlanetFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a Planet", str)
+		return _enumer.NewParseError("Planet", str, PlanetStrings())
 	}
 	return nil
 }
@@ -170,7 +235,33 @@ func (_p *Planet) UnmarshalBSONValue(t bsontype.Type, data []byte) error {
 
 	*_p, ok = PlanetFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a Planet", str)
+		return _enumer.NewParseError("Planet", str, PlanetStrings())
+	}
+	return nil
+}
+
+// MarshalCBOR implements the cbor.Marshaler interface for Planet.
+func (_p Planet) MarshalCBOR() ([]byte, error) {
+	if err := _p.Validate(); err != nil {
+		return nil, fmt.Errorf("Cannot marshal value %q as Planet. %w", _p, err)
+	}
+	return cbor.Marshal(_p.String())
+}
+
+// UnmarshalCBOR implements the cbor.Unmarshaler interface for Planet.
+func (_p *Planet) UnmarshalCBOR(data []byte) error {
+	var str string
+	if err := cbor.Unmarshal(data, &str); err != nil {
+		return fmt.Errorf("Planet should be a string, got %q", data)
+	}
+	if len(str) == 0 {
+		return fmt.Errorf("Planet cannot be derived from empty string")
+	}
+
+	var ok bool
+	*_p, ok = PlanetFromString(str)
+	if !ok {
+		return _enumer.NewParseError("Planet", str, PlanetStrings())
 	}
 	return nil
 }
@@ -200,7 +291,7 @@ func (_p *Planet) UnmarshalGQL(value interface{}) error {
 	var ok bool
 	*_p, ok = PlanetFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a Planet", str)
+		return _enumer.NewParseError("Planet", str, PlanetStrings())
 	}
 	return nil
 }
@@ -226,7 +317,33 @@ func (_p *Planet) UnmarshalJSON(data []byte) error {
 	var ok bool
 	*_p, ok = PlanetFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a Planet", str)
+		return _enumer.NewParseError("Planet", str, PlanetStrings())
+	}
+	return nil
+}
+
+// MarshalMsgpack implements the msgpack.Marshaler interface for Planet.
+func (_p Planet) MarshalMsgpack() ([]byte, error) {
+	if err := _p.Validate(); err != nil {
+		return nil, fmt.Errorf("Cannot marshal value %q as Planet. %w", _p, err)
+	}
+	return msgpack.Marshal(_p.String())
+}
+
+// UnmarshalMsgpack implements the msgpack.Unmarshaler interface for Planet.
+func (_p *Planet) UnmarshalMsgpack(data []byte) error {
+	var str string
+	if err := msgpack.Unmarshal(data, &str); err != nil {
+		return fmt.Errorf("Planet should be a string, got %q", data)
+	}
+	if len(str) == 0 {
+		return fmt.Errorf("Planet cannot be derived from empty string")
+	}
+
+	var ok bool
+	*_p, ok = PlanetFromString(str)
+	if !ok {
+		return _enumer.NewParseError("Planet", str, PlanetStrings())
 	}
 	return nil
 }
@@ -259,17 +376,22 @@ func (_p *Planet) Scan(value interface{}) error {
 	var ok bool
 	*_p, ok = PlanetFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a Planet", str)
+		return _enumer.NewParseError("Planet", str, PlanetStrings())
 	}
 	return nil
 }
 
 // MarshalText implements the encoding.TextMarshaler interface for Planet.
 func (_p Planet) MarshalText() ([]byte, error) {
+	return _p.AppendText(nil)
+}
+
+// AppendText implements the encoding.TextAppender interface for Planet.
+func (_p Planet) AppendText(b []byte) ([]byte, error) {
 	if err := _p.Validate(); err != nil {
-		return nil, fmt.Errorf("Cannot marshal value %q as Planet. %w", _p, err)
+		return b, fmt.Errorf("Cannot marshal value %q as Planet. %w", _p, err)
 	}
-	return []byte(_p.String()), nil
+	return append(b, _p.String()...), nil
 }
 
 // UnmarshalText implements the encoding.TextUnmarshaler interface for Planet.
@@ -282,7 +404,56 @@ func (_p *Planet) UnmarshalText(text []byte) error {
 	var ok bool
 	*_p, ok = PlanetFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a Planet", str)
+		return _enumer.NewParseError("Planet", str, PlanetStrings())
+	}
+	return nil
+}
+
+// MarshalXML implements the xml.Marshaler interface for Planet.
+func (_p Planet) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if err := _p.Validate(); err != nil {
+		return fmt.Errorf("Cannot marshal value %q as Planet. %w", _p, err)
+	}
+	return e.EncodeElement(_p.String(), start)
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface for Planet.
+func (_p *Planet) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var str string
+	if err := d.DecodeElement(&str, &start); err != nil {
+		return err
+	}
+	if len(str) == 0 {
+		return fmt.Errorf("Planet cannot be derived from empty string")
+	}
+
+	var ok bool
+	*_p, ok = PlanetFromString(str)
+	if !ok {
+		return _enumer.NewParseError("Planet", str, PlanetStrings())
+	}
+	return nil
+}
+
+// MarshalXMLAttr implements the xml.MarshalerAttr interface for Planet.
+func (_p Planet) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	if err := _p.Validate(); err != nil {
+		return xml.Attr{}, fmt.Errorf("Cannot marshal value %q as Planet. %w", _p, err)
+	}
+	return xml.Attr{Name: name, Value: _p.String()}, nil
+}
+
+// UnmarshalXMLAttr implements the xml.UnmarshalerAttr interface for Planet.
+func (_p *Planet) UnmarshalXMLAttr(attr xml.Attr) error {
+	str := attr.Value
+	if len(str) == 0 {
+		return fmt.Errorf("Planet cannot be derived from empty string")
+	}
+
+	var ok bool
+	*_p, ok = PlanetFromString(str)
+	if !ok {
+		return _enumer.NewParseError("Planet", str, PlanetStrings())
 	}
 	return nil
 }
@@ -308,14 +479,369 @@ func (_p *Planet) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	var ok bool
 	*_p, ok = PlanetFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a Planet", str)
+		return _enumer.NewParseError("Planet", str, PlanetStrings())
+	}
+	return nil
+}
+
+var _PlanetCompletions = [8]string{
+	"Mars",
+	"Pluto",
+	"Venus",
+	"Mercury",
+	"Jupiter",
+	"Saturn",
+	"Uranus",
+	"Neptune",
+}
+
+// PlanetUsage returns a usage hint of all String values of the enum, less the deprecated values,
+// e.g. for flag descriptions.
+func PlanetUsage() string {
+	return "one of: " + strings.Join(_PlanetStrings[:], "|")
+}
+
+// PlanetCompletions returns the completion candidates of the enum matching the given prefix,
+// less the deprecated values. Each candidate is formatted as "<value>" or "<value>\t<description>",
+// as expected by shell completions, e.g. of cobra's RegisterFlagCompletionFunc.
+func PlanetCompletions(toComplete string) []string {
+	out := make([]string, 0, len(_PlanetCompletions))
+	for _, c := range _PlanetCompletions {
+		if strings.HasPrefix(c, toComplete) {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+// PlanetSet is a set of Planet values backed by a bitset.
+// The zero value is an empty set.
+type PlanetSet struct {
+	bits [1]uint64
+}
+
+// NewPlanetSet returns a set containing the given values.
+// Invalid values are ignored.
+func NewPlanetSet(values ...Planet) PlanetSet {
+	var _p PlanetSet
+	_p.Add(values...)
+	return _p
+}
+
+// Add adds the values to the set. Invalid values are ignored.
+func (_p *PlanetSet) Add(values ...Planet) {
+	for _, v := range values {
+		if !v.IsValid() {
+			continue
+		}
+		idx := uint64(v) - 1
+		_p.bits[idx/64] |= 1 << (idx % 64)
+	}
+}
+
+// Remove removes the values from the set.
+func (_p *PlanetSet) Remove(values ...Planet) {
+	for _, v := range values {
+		if !v.IsValid() {
+			continue
+		}
+		idx := uint64(v) - 1
+		_p.bits[idx/64] &^= 1 << (idx % 64)
+	}
+}
+
+// Contains tests whether the value is an element of the set.
+func (_p PlanetSet) Contains(v Planet) bool {
+	if !v.IsValid() {
+		return false
+	}
+	idx := uint64(v) - 1
+	return _p.bits[idx/64]&(1<<(idx%64)) != 0
+}
+
+// Union returns a set of all values contained in either set.
+func (_p PlanetSet) Union(other PlanetSet) PlanetSet {
+	for idx := range _p.bits {
+		_p.bits[idx] |= other.bits[idx]
+	}
+	return _p
+}
+
+// Intersect returns a set of all values contained in both sets.
+func (_p PlanetSet) Intersect(other PlanetSet) PlanetSet {
+	for idx := range _p.bits {
+		_p.bits[idx] &= other.bits[idx]
+	}
+	return _p
+}
+
+// Difference returns a set of all values contained in the set, but not in the other set.
+func (_p PlanetSet) Difference(other PlanetSet) PlanetSet {
+	for idx := range _p.bits {
+		_p.bits[idx] &^= other.bits[idx]
+	}
+	return _p
+}
+
+// Len returns the count of values in the set.
+func (_p PlanetSet) Len() int {
+	var n int
+	for _, w := range _p.bits {
+		n += bits.OnesCount64(w)
+	}
+	return n
+}
+
+// All returns all values of the set in ascending order.
+func (_p PlanetSet) All() []Planet {
+	out := make([]Planet, 0, _p.Len())
+	for idx, w := range _p.bits {
+		for ; w != 0; w &= w - 1 {
+			out = append(out, Planet(uint64(idx*64+bits.TrailingZeros64(w))+1))
+		}
+	}
+	return out
+}
+
+// Strings returns the String values of all values of the set in ascending order.
+func (_p PlanetSet) Strings() []string {
+	values := _p.All()
+	out := make([]string, len(values))
+	for idx, v := range values {
+		out[idx] = v.String()
+	}
+	return out
+}
+
+// String implements the Stringer interface.
+func (_p PlanetSet) String() string {
+	return "[" + strings.Join(_p.Strings(), " ") + "]"
+}
+
+// setStrings replaces the values of the set by the values of the given String values.
+func (_p *PlanetSet) setStrings(strs []string) error {
+	*_p = PlanetSet{}
+	for _, str := range strs {
+		v, ok := PlanetFromString(str)
+		if !ok {
+			return _enumer.NewParseError("Planet", str, PlanetStrings())
+		}
+		_p.Add(v)
 	}
 	return nil
 }
 
+// setJoined replaces the values of the set by the values of a comma-joined
+// list of String values.
+func (_p *PlanetSet) setJoined(str string) error {
+	if len(str) == 0 {
+		*_p = PlanetSet{}
+		return nil
+	}
+	return _p.setStrings(strings.Split(str, ","))
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface for PlanetSet.
+func (_p PlanetSet) MarshalBinary() ([]byte, error) {
+	return _p.AppendBinary(nil)
+}
+
+// AppendBinary implements the encoding.BinaryAppender interface for PlanetSet.
+func (_p PlanetSet) AppendBinary(b []byte) ([]byte, error) {
+	return append(b, strings.Join(_p.Strings(), ",")...), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface for PlanetSet.
+func (_p *PlanetSet) UnmarshalBinary(text []byte) error {
+	return _p.setJoined(string(text))
+}
+
+// MarshalBSONValue implements the bson.ValueMarshaler interface for PlanetSet.
+func (_p PlanetSet) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	return bson.MarshalValue(_p.Strings())
+}
+
+// UnmarshalBSONValue implements the bson.ValueUnmarshaler interface for PlanetSet.
+func (_p *PlanetSet) UnmarshalBSONValue(t bsontype.Type, data []byte) error {
+	if t == bsontype.Undefined || t == bsontype.Null {
+		*_p = PlanetSet{}
+		return nil
+	}
+	if t != bsontype.Array {
+		return fmt.Errorf("PlanetSet should be an array, got %q of Type %q", data, t)
+	}
+	var strs []string
+	if err := (bson.RawValue{Type: t, Value: data}).Unmarshal(&strs); err != nil {
+		return fmt.Errorf("PlanetSet should be an array of strings. %w", err)
+	}
+	return _p.setStrings(strs)
+}
+
+// MarshalCBOR implements the cbor.Marshaler interface for PlanetSet.
+func (_p PlanetSet) MarshalCBOR() ([]byte, error) {
+	return cbor.Marshal(_p.Strings())
+}
+
+// UnmarshalCBOR implements the cbor.Unmarshaler interface for PlanetSet.
+func (_p *PlanetSet) UnmarshalCBOR(data []byte) error {
+	var strs []string
+	if err := cbor.Unmarshal(data, &strs); err != nil {
+		return fmt.Errorf("PlanetSet should be an array of strings, got %q", data)
+	}
+	return _p.setStrings(strs)
+}
+
+// MarshalGQL implements the graphql.Marshaler interface for PlanetSet.
+func (_p PlanetSet) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, "[")
+	for idx, str := range _p.Strings() {
+		if idx > 0 {
+			fmt.Fprint(w, ",")
+		}
+		fmt.Fprint(w, strconv.Quote(str))
+	}
+	fmt.Fprint(w, "]")
+}
+
+// UnmarshalGQL implements the graphql.Unmarshaler interface for PlanetSet.
+func (_p *PlanetSet) UnmarshalGQL(value interface{}) error {
+	var values []interface{}
+	switch v := value.(type) {
+	case nil:
+	case []interface{}:
+		values = v
+	default:
+		values = []interface{}{v} // hint: GraphQL coerces a single value into a list
+	}
+	strs := make([]string, len(values))
+	for idx, value := range values {
+		switch v := value.(type) {
+		case []byte:
+			strs[idx] = string(v)
+		case string:
+			strs[idx] = v
+		case fmt.Stringer:
+			strs[idx] = v.String()
+		default:
+			return fmt.Errorf("invalid value of Planet: %[1]T(%[1]v)", value)
+		}
+	}
+	return _p.setStrings(strs)
+}
+
+// MarshalJSON implements the json.Marshaler interface for PlanetSet.
+func (_p PlanetSet) MarshalJSON() ([]byte, error) {
+	return json.Marshal(_p.Strings())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for PlanetSet.
+func (_p *PlanetSet) UnmarshalJSON(data []byte) error {
+	var strs []string
+	if err := json.Unmarshal(data, &strs); err != nil {
+		return fmt.Errorf("PlanetSet should be an array of strings, got %q", data)
+	}
+	return _p.setStrings(strs)
+}
+
+// MarshalMsgpack implements the msgpack.Marshaler interface for PlanetSet.
+func (_p PlanetSet) MarshalMsgpack() ([]byte, error) {
+	return msgpack.Marshal(_p.Strings())
+}
+
+// UnmarshalMsgpack implements the msgpack.Unmarshaler interface for PlanetSet.
+func (_p *PlanetSet) UnmarshalMsgpack(data []byte) error {
+	var strs []string
+	if err := msgpack.Unmarshal(data, &strs); err != nil {
+		return fmt.Errorf("PlanetSet should be an array of strings, got %q", data)
+	}
+	return _p.setStrings(strs)
+}
+
+// Value implements the sql/driver.Valuer interface for PlanetSet.
+// The set is stored as Postgres array of its String values, e.g. `{a,b}`.
+func (_p PlanetSet) Value() (driver.Value, error) {
+	return string(_enumer.AppendArray(nil, _p.Strings())), nil
+}
+
+// Scan implements the sql/driver.Scanner interface for PlanetSet.
+// It accepts Postgres arrays of String values.
+func (_p *PlanetSet) Scan(value interface{}) error {
+	var str string
+	switch v := value.(type) {
+	case nil:
+		*_p = PlanetSet{}
+		return nil
+	case []byte:
+		str = string(v)
+	case string:
+		str = v
+	case fmt.Stringer:
+		str = v.String()
+	default:
+		return fmt.Errorf("invalid value of PlanetSet: %[1]T(%[1]v)", value)
+	}
+	elems, err := _enumer.ParseArray(str)
+	if err != nil {
+		return fmt.Errorf("invalid value of PlanetSet: %w", err)
+	}
+	return _p.setStrings(elems)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface for PlanetSet.
+func (_p PlanetSet) MarshalText() ([]byte, error) {
+	return _p.AppendText(nil)
+}
+
+// AppendText implements the encoding.TextAppender interface for PlanetSet.
+func (_p PlanetSet) AppendText(b []byte) ([]byte, error) {
+	return append(b, strings.Join(_p.Strings(), ",")...), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface for PlanetSet.
+func (_p *PlanetSet) UnmarshalText(text []byte) error {
+	return _p.setJoined(string(text))
+}
+
+// MarshalXML implements the xml.Marshaler interface for PlanetSet.
+func (_p PlanetSet) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return e.EncodeElement(strings.Join(_p.Strings(), ","), start)
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface for PlanetSet.
+func (_p *PlanetSet) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var str string
+	if err := d.DecodeElement(&str, &start); err != nil {
+		return err
+	}
+	return _p.setJoined(str)
+}
+
+// MarshalXMLAttr implements the xml.MarshalerAttr interface for PlanetSet.
+func (_p PlanetSet) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return xml.Attr{Name: name, Value: strings.Join(_p.Strings(), ",")}, nil
+}
+
+// UnmarshalXMLAttr implements the xml.UnmarshalerAttr interface for PlanetSet.
+func (_p *PlanetSet) UnmarshalXMLAttr(attr xml.Attr) error {
+	return _p.setJoined(attr.Value)
+}
+
+// MarshalYAML implements a YAML Marshaler for PlanetSet.
+func (_p PlanetSet) MarshalYAML() (interface{}, error) {
+	return _p.Strings(), nil
+}
+
+// UnmarshalYAML implements a YAML Unmarshaler for PlanetSet.
+func (_p *PlanetSet) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var strs []string
+	if err := unmarshal(&strs); err != nil {
+		return err
+	}
+	return _p.setStrings(strs)
+}
+
 const (
-	_PlanetSupportUndefinedString      = "MarsPlutoVenusMercuryJupiterSaturnUranusNeptune"
-	_PlanetSupportUndefinedLowerString = "marsplutovenusmercuryjupitersaturnuranusneptune"
+	_PlanetSupportUndefinedString = "MarsPlutoVenusMercuryJupiterSaturnUranusNeptune"
 )
 
 var (
@@ -350,6 +876,11 @@ func PlanetSupportUndefinedStrings() []string {
 	return cp[:]
 }
 
+// Values returns all values of the enum.
+func (PlanetSupportUndefined) Values() []PlanetSupportUndefined {
+	return PlanetSupportUndefinedValues()
+}
+
 // IsValid tests whether the value is a valid enum value.
 func (_p PlanetSupportUndefined) IsValid() bool {
 	return _p >= 0 && _p <= 8
@@ -377,6 +908,54 @@ func (_p PlanetSupportUndefined) String() string {
 	return _PlanetSupportUndefinedStrings[idx]
 }
 
+// Index returns the position of the value within the ordered values of the enum
+// (ignoring alternative values) or -1 if the value is invalid.
+func (_p PlanetSupportUndefined) Index() int {
+	if !_p.IsValid() || _p == 0 {
+		return -1
+	}
+	idx := int(_p) - 1
+	return idx
+}
+
+// Compare returns -1, 0 or +1 depending on whether the value orders before,
+// equal to or after the other value. Invalid values order before all valid values.
+func (_p PlanetSupportUndefined) Compare(other PlanetSupportUndefined) int {
+	a, b := _p.Index(), other.Index()
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return +1
+	}
+	return 0
+}
+
+// Less tests whether the value orders before the other value.
+func (_p PlanetSupportUndefined) Less(other PlanetSupportUndefined) bool {
+	return _p.Compare(other) < 0
+}
+
+// Next returns the succeeding value in order of the enum.
+// It returns false if the value is invalid or the last value.
+func (_p PlanetSupportUndefined) Next() (PlanetSupportUndefined, bool) {
+	idx := _p.Index()
+	if idx == -1 || idx+1 == len(_PlanetSupportUndefinedValues) {
+		return PlanetSupportUndefined(0), false
+	}
+	return _PlanetSupportUndefinedValues[idx+1], true
+}
+
+// Prev returns the preceding value in order of the enum.
+// It returns false if the value is invalid or the first value.
+func (_p PlanetSupportUndefined) Prev() (PlanetSupportUndefined, bool) {
+	idx := _p.Index()
+	if idx < 1 {
+		return PlanetSupportUndefined(0), false
+	}
+	return _PlanetSupportUndefinedValues[idx-1], true
+}
+
 var (
 	_PlanetSupportUndefinedStringToValueMap = map[string]PlanetSupportUndefined{
 		_PlanetSupportUndefinedString[0:4]:   PlanetSupportUndefinedMars,
@@ -388,18 +967,20 @@ var (
 		_PlanetSupportUndefinedString[34:40]: PlanetSupportUndefinedUranus,
 		_PlanetSupportUndefinedString[40:47]: PlanetSupportUndefinedNeptune,
 	}
-	_PlanetSupportUndefinedLowerStringToValueMap = map[string]PlanetSupportUndefined{
-		_PlanetSupportUndefinedLowerString[0:4]:   PlanetSupportUndefinedMars,
-		_PlanetSupportUndefinedLowerString[4:9]:   PlanetSupportUndefinedPluto,
-		_PlanetSupportUndefinedLowerString[9:14]:  PlanetSupportUndefinedVenus,
-		_PlanetSupportUndefinedLowerString[14:21]: PlanetSupportUndefinedMercury,
-		_PlanetSupportUndefinedLowerString[21:28]: PlanetSupportUndefinedJupiter,
-		_PlanetSupportUndefinedLowerString[28:34]: PlanetSupportUndefinedSaturn,
-		_PlanetSupportUndefinedLowerString[34:40]: PlanetSupportUndefinedUranus,
-		_PlanetSupportUndefinedLowerString[40:47]: PlanetSupportUndefinedNeptune,
-	}
 )
 
+// _PlanetSupportUndefinedLookupFold determines the enum value of the string regardless of its case
+// by Unicode simple folding. It scans the String values, as a fold table is only generated
+// for enums with the "ignore-case" feature.
+func _PlanetSupportUndefinedLookupFold(raw string) (PlanetSupportUndefined, bool) {
+	for idx := range _PlanetSupportUndefinedStrings {
+		if strings.EqualFold(_PlanetSupportUndefinedStrings[idx], raw) {
+			return _PlanetSupportUndefinedValues[idx], true
+		}
+	}
+	return PlanetSupportUndefined(0), false
+}
+
 // PlanetSupportUndefinedFromString determines the enum value with an exact case match.
 func PlanetSupportUndefinedFromString(raw string) (PlanetSupportUndefined, bool) {
 	if len(raw) == 0 {
@@ -412,7 +993,8 @@ func PlanetSupportUndefinedFromString(raw string) (PlanetSupportUndefined, bool)
 	return v, true
 }
 
-// PlanetSupportUndefinedFromStringIgnoreCase determines the enum value with a case-insensitive match.
+// PlanetSupportUndefinedFromStringIgnoreCase determines the enum value with a case-insensitive match
+// by Unicode simple folding, regardless of the casing of raw.
 func PlanetSupportUndefinedFromStringIgnoreCase(raw string) (PlanetSupportUndefined, bool) {
 	if len(raw) == 0 {
 		return PlanetSupportUndefined(0), true
@@ -421,7 +1003,7 @@ func PlanetSupportUndefinedFromStringIgnoreCase(raw string) (PlanetSupportUndefi
 	if ok {
 		return v, ok
 	}
-	v, ok = _PlanetSupportUndefinedLowerStringToValueMap[raw]
+	v, ok = _PlanetSupportUndefinedLookupFold(raw)
 	if !ok {
 		return PlanetSupportUndefined(0), false
 	}
@@ -430,10 +1012,15 @@ func PlanetSupportUndefinedFromStringIgnoreCase(raw string) (PlanetSupportUndefi
 
 // MarshalBinary implements the encoding.BinaryMarshaler interface for PlanetSupportUndefined.
 func (_p PlanetSupportUndefined) MarshalBinary() ([]byte, error) {
+	return _p.AppendBinary(nil)
+}
+
+// AppendBinary implements the encoding.BinaryAppender interface for PlanetSupportUndefined.
+func (_p PlanetSupportUndefined) AppendBinary(b []byte) ([]byte, error) {
 	if err := _p.Validate(); err != nil {
-		return nil, fmt.Errorf("Cannot marshal value %q as PlanetSupportUndefined. %w", _p, err)
+		return b, fmt.Errorf("Cannot marshal value %q as PlanetSupportUndefined. %w", _p, err)
 	}
-	return []byte(_p.String()), nil
+	return append(b, _p.String()...), nil
 }
 
 // UnmarshalBinary implements the encoding.BinaryUnmarshaler interface for PlanetSupportUndefined.
@@ -443,7 +1030,7 @@ func (_p *PlanetSupportUndefined) UnmarshalBinary(text []byte) error {
 	var ok bool
 	*_p, ok = PlanetSupportUndefinedFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a PlanetSupportUndefined", str)
+		return _enumer.NewParseError("PlanetSupportUndefined", str, PlanetSupportUndefinedStrings())
 	}
 	return nil
 }
@@ -471,14 +1058,37 @@ func (_p *PlanetSupportUndefined) UnmarshalBSONValue(t bsontype.Type, data []byt
 
 	*_p, ok = PlanetSupportUndefinedFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a PlanetSupportUndefined", str)
+		return _enumer.NewParseError("PlanetSupportUndefined", str, PlanetSupportUndefinedStrings())
 	}
 	return nil
 }
 
-// MarshalGQL implements the graphql.Marshaler interface for PlanetSupportUndefined.
-func (_p PlanetSupportUndefined) MarshalGQL(w io.Writer) {
-	fmt.Fprint(w, strconv.Quote(_p.String()))
+// MarshalCBOR implements the cbor.Marshaler interface for PlanetSupportUndefined.
+func (_p PlanetSupportUndefined) MarshalCBOR() ([]byte, error) {
+	if err := _p.Validate(); err != nil {
+		return nil, fmt.Errorf("Cannot marshal value %q as PlanetSupportUndefined. %w", _p, err)
+	}
+	return cbor.Marshal(_p.String())
+}
+
+// UnmarshalCBOR implements the cbor.Unmarshaler interface for PlanetSupportUndefined.
+func (_p *PlanetSupportUndefined) UnmarshalCBOR(data []byte) error {
+	var str string
+	if err := cbor.Unmarshal(data, &str); err != nil {
+		return fmt.Errorf("PlanetSupportUndefined should be a string, got %q", data)
+	}
+
+	var ok bool
+	*_p, ok = PlanetSupportUndefinedFromString(str)
+	if !ok {
+		return _enumer.NewParseError("PlanetSupportUndefined", str, PlanetSupportUndefinedStrings())
+	}
+	return nil
+}
+
+// MarshalGQL implements the graphql.Marshaler interface for PlanetSupportUndefined.
+func (_p PlanetSupportUndefined) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(_p.String()))
 }
 
 // UnmarshalGQL implements the graphql.Unmarshaler interface for PlanetSupportUndefined.
@@ -499,7 +1109,7 @@ func (_p *PlanetSupportUndefined) UnmarshalGQL(value interface{}) error {
 	var ok bool
 	*_p, ok = PlanetSupportUndefinedFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a PlanetSupportUndefined", str)
+		return _enumer.NewParseError("PlanetSupportUndefined", str, PlanetSupportUndefinedStrings())
 	}
 	return nil
 }
@@ -522,7 +1132,30 @@ func (_p *PlanetSupportUndefined) UnmarshalJSON(data []byte) error {
 	var ok bool
 	*_p, ok = PlanetSupportUndefinedFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a PlanetSupportUndefined", str)
+		return _enumer.NewParseError("PlanetSupportUndefined", str, PlanetSupportUndefinedStrings())
+	}
+	return nil
+}
+
+// MarshalMsgpack implements the msgpack.Marshaler interface for PlanetSupportUndefined.
+func (_p PlanetSupportUndefined) MarshalMsgpack() ([]byte, error) {
+	if err := _p.Validate(); err != nil {
+		return nil, fmt.Errorf("Cannot marshal value %q as PlanetSupportUndefined. %w", _p, err)
+	}
+	return msgpack.Marshal(_p.String())
+}
+
+// UnmarshalMsgpack implements the msgpack.Unmarshaler interface for PlanetSupportUndefined.
+func (_p *PlanetSupportUndefined) UnmarshalMsgpack(data []byte) error {
+	var str string
+	if err := msgpack.Unmarshal(data, &str); err != nil {
+		return fmt.Errorf("PlanetSupportUndefined should be a string, got %q", data)
+	}
+
+	var ok bool
+	*_p, ok = PlanetSupportUndefinedFromString(str)
+	if !ok {
+		return _enumer.NewParseError("PlanetSupportUndefined", str, PlanetSupportUndefinedStrings())
 	}
 	return nil
 }
@@ -556,17 +1189,22 @@ func (_p *PlanetSupportUndefined) Scan(value interface{}) error {
 	var ok bool
 	*_p, ok = PlanetSupportUndefinedFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a PlanetSupportUndefined", str)
+		return _enumer.NewParseError("PlanetSupportUndefined", str, PlanetSupportUndefinedStrings())
 	}
 	return nil
 }
 
 // MarshalText implements the encoding.TextMarshaler interface for PlanetSupportUndefined.
 func (_p PlanetSupportUndefined) MarshalText() ([]byte, error) {
+	return _p.AppendText(nil)
+}
+
+// AppendText implements the encoding.TextAppender interface for PlanetSupportUndefined.
+func (_p PlanetSupportUndefined) AppendText(b []byte) ([]byte, error) {
 	if err := _p.Validate(); err != nil {
-		return nil, fmt.Errorf("Cannot marshal value %q as PlanetSupportUndefined. %w", _p, err)
+		return b, fmt.Errorf("Cannot marshal value %q as PlanetSupportUndefined. %w", _p, err)
 	}
-	return []byte(_p.String()), nil
+	return append(b, _p.String()...), nil
 }
 
 // UnmarshalText implements the encoding.TextUnmarshaler interface for PlanetSupportUndefined.
@@ -576,7 +1214,53 @@ func (_p *PlanetSupportUndefined) UnmarshalText(text []byte) error {
 	var ok bool
 	*_p, ok = PlanetSupportUndefinedFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a PlanetSupportUndefined", str)
+		return _enumer.NewParseError("PlanetSupportUndefined", str, PlanetSupportUndefinedStrings())
+	}
+	return nil
+}
+
+// MarshalXML implements the xml.Marshaler interface for PlanetSupportUndefined.
+func (_p PlanetSupportUndefined) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if err := _p.Validate(); err != nil {
+		return fmt.Errorf("Cannot marshal value %q as PlanetSupportUndefined. %w", _p, err)
+	}
+	return e.EncodeElement(_p.String(), start)
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface for PlanetSupportUndefined.
+func (_p *PlanetSupportUndefined) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var str string
+	if err := d.DecodeElement(&str, &start); err != nil {
+		return err
+	}
+
+	var ok bool
+	*_p, ok = PlanetSupportUndefinedFromString(str)
+	if !ok {
+		return _enumer.NewParseError("PlanetSupportUndefined", str, PlanetSupportUndefinedStrings())
+	}
+	return nil
+}
+
+// MarshalXMLAttr implements the xml.MarshalerAttr interface for PlanetSupportUndefined.
+func (_p PlanetSupportUndefined) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	if err := _p.Validate(); err != nil {
+		return xml.Attr{}, fmt.Errorf("Cannot marshal value %q as PlanetSupportUndefined. %w", _p, err)
+	}
+	if _p == 0 {
+		return xml.Attr{}, nil // an empty name omits the attribute
+	}
+	return xml.Attr{Name: name, Value: _p.String()}, nil
+}
+
+// UnmarshalXMLAttr implements the xml.UnmarshalerAttr interface for PlanetSupportUndefined.
+func (_p *PlanetSupportUndefined) UnmarshalXMLAttr(attr xml.Attr) error {
+	str := attr.Value
+
+	var ok bool
+	*_p, ok = PlanetSupportUndefinedFromString(str)
+	if !ok {
+		return _enumer.NewParseError("PlanetSupportUndefined", str, PlanetSupportUndefinedStrings())
 	}
 	return nil
 }
@@ -599,14 +1283,369 @@ func (_p *PlanetSupportUndefined) UnmarshalYAML(unmarshal func(interface{}) erro
 	var ok bool
 	*_p, ok = PlanetSupportUndefinedFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a PlanetSupportUndefined", str)
+		return _enumer.NewParseError("PlanetSupportUndefined", str, PlanetSupportUndefinedStrings())
 	}
 	return nil
 }
 
+var _PlanetSupportUndefinedCompletions = [8]string{
+	"Mars",
+	"Pluto",
+	"Venus",
+	"Mercury",
+	"Jupiter",
+	"Saturn",
+	"Uranus",
+	"Neptune",
+}
+
+// PlanetSupportUndefinedUsage returns a usage hint of all String values of the enum, less the deprecated values,
+// e.g. for flag descriptions.
+func PlanetSupportUndefinedUsage() string {
+	return "one of: " + strings.Join(_PlanetSupportUndefinedStrings[:], "|")
+}
+
+// PlanetSupportUndefinedCompletions returns the completion candidates of the enum matching the given prefix,
+// less the deprecated values. Each candidate is formatted as "<value>" or "<value>\t<description>",
+// as expected by shell completions, e.g. of cobra's RegisterFlagCompletionFunc.
+func PlanetSupportUndefinedCompletions(toComplete string) []string {
+	out := make([]string, 0, len(_PlanetSupportUndefinedCompletions))
+	for _, c := range _PlanetSupportUndefinedCompletions {
+		if strings.HasPrefix(c, toComplete) {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+// PlanetSupportUndefinedSet is a set of PlanetSupportUndefined values backed by a bitset.
+// The zero value is an empty set.
+type PlanetSupportUndefinedSet struct {
+	bits [1]uint64
+}
+
+// NewPlanetSupportUndefinedSet returns a set containing the given values.
+// Invalid values are ignored.
+func NewPlanetSupportUndefinedSet(values ...PlanetSupportUndefined) PlanetSupportUndefinedSet {
+	var _p PlanetSupportUndefinedSet
+	_p.Add(values...)
+	return _p
+}
+
+// Add adds the values to the set. Invalid values are ignored.
+func (_p *PlanetSupportUndefinedSet) Add(values ...PlanetSupportUndefined) {
+	for _, v := range values {
+		if !v.IsValid() {
+			continue
+		}
+		idx := uint64(v) - 0
+		_p.bits[idx/64] |= 1 << (idx % 64)
+	}
+}
+
+// Remove removes the values from the set.
+func (_p *PlanetSupportUndefinedSet) Remove(values ...PlanetSupportUndefined) {
+	for _, v := range values {
+		if !v.IsValid() {
+			continue
+		}
+		idx := uint64(v) - 0
+		_p.bits[idx/64] &^= 1 << (idx % 64)
+	}
+}
+
+// Contains tests whether the value is an element of the set.
+func (_p PlanetSupportUndefinedSet) Contains(v PlanetSupportUndefined) bool {
+	if !v.IsValid() {
+		return false
+	}
+	idx := uint64(v) - 0
+	return _p.bits[idx/64]&(1<<(idx%64)) != 0
+}
+
+// Union returns a set of all values contained in either set.
+func (_p PlanetSupportUndefinedSet) Union(other PlanetSupportUndefinedSet) PlanetSupportUndefinedSet {
+	for idx := range _p.bits {
+		_p.bits[idx] |= other.bits[idx]
+	}
+	return _p
+}
+
+// Intersect returns a set of all values contained in both sets.
+func (_p PlanetSupportUndefinedSet) Intersect(other PlanetSupportUndefinedSet) PlanetSupportUndefinedSet {
+	for idx := range _p.bits {
+		_p.bits[idx] &= other.bits[idx]
+	}
+	return _p
+}
+
+// Difference returns a set of all values contained in the set, but not in the other set.
+func (_p PlanetSupportUndefinedSet) Difference(other PlanetSupportUndefinedSet) PlanetSupportUndefinedSet {
+	for idx := range _p.bits {
+		_p.bits[idx] &^= other.bits[idx]
+	}
+	return _p
+}
+
+// Len returns the count of values in the set.
+func (_p PlanetSupportUndefinedSet) Len() int {
+	var n int
+	for _, w := range _p.bits {
+		n += bits.OnesCount64(w)
+	}
+	return n
+}
+
+// All returns all values of the set in ascending order.
+func (_p PlanetSupportUndefinedSet) All() []PlanetSupportUndefined {
+	out := make([]PlanetSupportUndefined, 0, _p.Len())
+	for idx, w := range _p.bits {
+		for ; w != 0; w &= w - 1 {
+			out = append(out, PlanetSupportUndefined(uint64(idx*64+bits.TrailingZeros64(w))+0))
+		}
+	}
+	return out
+}
+
+// Strings returns the String values of all values of the set in ascending order.
+func (_p PlanetSupportUndefinedSet) Strings() []string {
+	values := _p.All()
+	out := make([]string, len(values))
+	for idx, v := range values {
+		out[idx] = v.String()
+	}
+	return out
+}
+
+// String implements the Stringer interface.
+func (_p PlanetSupportUndefinedSet) String() string {
+	return "[" + strings.Join(_p.Strings(), " ") + "]"
+}
+
+// setStrings replaces the values of the set by the values of the given String values.
+func (_p *PlanetSupportUndefinedSet) setStrings(strs []string) error {
+	*_p = PlanetSupportUndefinedSet{}
+	for _, str := range strs {
+		v, ok := PlanetSupportUndefinedFromString(str)
+		if !ok {
+			return _enumer.NewParseError("PlanetSupportUndefined", str, PlanetSupportUndefinedStrings())
+		}
+		_p.Add(v)
+	}
+	return nil
+}
+
+// setJoined replaces the values of the set by the values of a comma-joined
+// list of String values.
+func (_p *PlanetSupportUndefinedSet) setJoined(str string) error {
+	if len(str) == 0 {
+		*_p = PlanetSupportUndefinedSet{}
+		return nil
+	}
+	return _p.setStrings(strings.Split(str, ","))
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface for PlanetSupportUndefinedSet.
+func (_p PlanetSupportUndefinedSet) MarshalBinary() ([]byte, error) {
+	return _p.AppendBinary(nil)
+}
+
+// AppendBinary implements the encoding.BinaryAppender interface for PlanetSupportUndefinedSet.
+func (_p PlanetSupportUndefinedSet) AppendBinary(b []byte) ([]byte, error) {
+	return append(b, strings.Join(_p.Strings(), ",")...), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface for PlanetSupportUndefinedSet.
+func (_p *PlanetSupportUndefinedSet) UnmarshalBinary(text []byte) error {
+	return _p.setJoined(string(text))
+}
+
+// MarshalBSONValue implements the bson.ValueMarshaler interface for PlanetSupportUndefinedSet.
+func (_p PlanetSupportUndefinedSet) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	return bson.MarshalValue(_p.Strings())
+}
+
+// UnmarshalBSONValue implements the bson.ValueUnmarshaler interface for PlanetSupportUndefinedSet.
+func (_p *PlanetSupportUndefinedSet) UnmarshalBSONValue(t bsontype.Type, data []byte) error {
+	if t == bsontype.Undefined || t == bsontype.Null {
+		*_p = PlanetSupportUndefinedSet{}
+		return nil
+	}
+	if t != bsontype.Array {
+		return fmt.Errorf("PlanetSupportUndefinedSet should be an array, got %q of Type %q", data, t)
+	}
+	var strs []string
+	if err := (bson.RawValue{Type: t, Value: data}).Unmarshal(&strs); err != nil {
+		return fmt.Errorf("PlanetSupportUndefinedSet should be an array of strings. %w", err)
+	}
+	return _p.setStrings(strs)
+}
+
+// MarshalCBOR implements the cbor.Marshaler interface for PlanetSupportUndefinedSet.
+func (_p PlanetSupportUndefinedSet) MarshalCBOR() ([]byte, error) {
+	return cbor.Marshal(_p.Strings())
+}
+
+// UnmarshalCBOR implements the cbor.Unmarshaler interface for PlanetSupportUndefinedSet.
+func (_p *PlanetSupportUndefinedSet) UnmarshalCBOR(data []byte) error {
+	var strs []string
+	if err := cbor.Unmarshal(data, &strs); err != nil {
+		return fmt.Errorf("PlanetSupportUndefinedSet should be an array of strings, got %q", data)
+	}
+	return _p.setStrings(strs)
+}
+
+// MarshalGQL implements the graphql.Marshaler interface for PlanetSupportUndefinedSet.
+func (_p PlanetSupportUndefinedSet) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, "[")
+	for idx, str := range _p.Strings() {
+		if idx > 0 {
+			fmt.Fprint(w, ",")
+		}
+		fmt.Fprint(w, strconv.Quote(str))
+	}
+	fmt.Fprint(w, "]")
+}
+
+// UnmarshalGQL implements the graphql.Unmarshaler interface for PlanetSupportUndefinedSet.
+func (_p *PlanetSupportUndefinedSet) UnmarshalGQL(value interface{}) error {
+	var values []interface{}
+	switch v := value.(type) {
+	case nil:
+	case []interface{}:
+		values = v
+	default:
+		values = []interface{}{v} // hint: GraphQL coerces a single value into a list
+	}
+	strs := make([]string, len(values))
+	for idx, value := range values {
+		switch v := value.(type) {
+		case []byte:
+			strs[idx] = string(v)
+		case string:
+			strs[idx] = v
+		case fmt.Stringer:
+			strs[idx] = v.String()
+		default:
+			return fmt.Errorf("invalid value of PlanetSupportUndefined: %[1]T(%[1]v)", value)
+		}
+	}
+	return _p.setStrings(strs)
+}
+
+// MarshalJSON implements the json.Marshaler interface for PlanetSupportUndefinedSet.
+func (_p PlanetSupportUndefinedSet) MarshalJSON() ([]byte, error) {
+	return json.Marshal(_p.Strings())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for PlanetSupportUndefinedSet.
+func (_p *PlanetSupportUndefinedSet) UnmarshalJSON(data []byte) error {
+	var strs []string
+	if err := json.Unmarshal(data, &strs); err != nil {
+		return fmt.Errorf("PlanetSupportUndefinedSet should be an array of strings, got %q", data)
+	}
+	return _p.setStrings(strs)
+}
+
+// MarshalMsgpack implements the msgpack.Marshaler interface for PlanetSupportUndefinedSet.
+func (_p PlanetSupportUndefinedSet) MarshalMsgpack() ([]byte, error) {
+	return msgpack.Marshal(_p.Strings())
+}
+
+// UnmarshalMsgpack implements the msgpack.Unmarshaler interface for PlanetSupportUndefinedSet.
+func (_p *PlanetSupportUndefinedSet) UnmarshalMsgpack(data []byte) error {
+	var strs []string
+	if err := msgpack.Unmarshal(data, &strs); err != nil {
+		return fmt.Errorf("PlanetSupportUndefinedSet should be an array of strings, got %q", data)
+	}
+	return _p.setStrings(strs)
+}
+
+// Value implements the sql/driver.Valuer interface for PlanetSupportUndefinedSet.
+// The set is stored as Postgres array of its String values, e.g. `{a,b}`.
+func (_p PlanetSupportUndefinedSet) Value() (driver.Value, error) {
+	return string(_enumer.AppendArray(nil, _p.Strings())), nil
+}
+
+// Scan implements the sql/driver.Scanner interface for PlanetSupportUndefinedSet.
+// It accepts Postgres arrays of String values.
+func (_p *PlanetSupportUndefinedSet) Scan(value interface{}) error {
+	var str string
+	switch v := value.(type) {
+	case nil:
+		*_p = PlanetSupportUndefinedSet{}
+		return nil
+	case []byte:
+		str = string(v)
+	case string:
+		str = v
+	case fmt.Stringer:
+		str = v.String()
+	default:
+		return fmt.Errorf("invalid value of PlanetSupportUndefinedSet: %[1]T(%[1]v)", value)
+	}
+	elems, err := _enumer.ParseArray(str)
+	if err != nil {
+		return fmt.Errorf("invalid value of PlanetSupportUndefinedSet: %w", err)
+	}
+	return _p.setStrings(elems)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface for PlanetSupportUndefinedSet.
+func (_p PlanetSupportUndefinedSet) MarshalText() ([]byte, error) {
+	return _p.AppendText(nil)
+}
+
+// AppendText implements the encoding.TextAppender interface for PlanetSupportUndefinedSet.
+func (_p PlanetSupportUndefinedSet) AppendText(b []byte) ([]byte, error) {
+	return append(b, strings.Join(_p.Strings(), ",")...), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface for PlanetSupportUndefinedSet.
+func (_p *PlanetSupportUndefinedSet) UnmarshalText(text []byte) error {
+	return _p.setJoined(string(text))
+}
+
+// MarshalXML implements the xml.Marshaler interface for PlanetSupportUndefinedSet.
+func (_p PlanetSupportUndefinedSet) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return e.EncodeElement(strings.Join(_p.Strings(), ","), start)
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface for PlanetSupportUndefinedSet.
+func (_p *PlanetSupportUndefinedSet) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var str string
+	if err := d.DecodeElement(&str, &start); err != nil {
+		return err
+	}
+	return _p.setJoined(str)
+}
+
+// MarshalXMLAttr implements the xml.MarshalerAttr interface for PlanetSupportUndefinedSet.
+func (_p PlanetSupportUndefinedSet) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return xml.Attr{Name: name, Value: strings.Join(_p.Strings(), ",")}, nil
+}
+
+// UnmarshalXMLAttr implements the xml.UnmarshalerAttr interface for PlanetSupportUndefinedSet.
+func (_p *PlanetSupportUndefinedSet) UnmarshalXMLAttr(attr xml.Attr) error {
+	return _p.setJoined(attr.Value)
+}
+
+// MarshalYAML implements a YAML Marshaler for PlanetSupportUndefinedSet.
+func (_p PlanetSupportUndefinedSet) MarshalYAML() (interface{}, error) {
+	return _p.Strings(), nil
+}
+
+// UnmarshalYAML implements a YAML Unmarshaler for PlanetSupportUndefinedSet.
+func (_p *PlanetSupportUndefinedSet) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var strs []string
+	if err := unmarshal(&strs); err != nil {
+		return err
+	}
+	return _p.setStrings(strs)
+}
+
 const (
-	_PlanetSupportUndefinedWithDefaultString      = "EarthMarsPlutoVenusMercuryJupiterSaturnUranusNeptune"
-	_PlanetSupportUndefinedWithDefaultLowerString = "earthmarsplutovenusmercuryjupitersaturnuranusneptune"
+	_PlanetSupportUndefinedWithDefaultString = "EarthMarsPlutoVenusMercuryJupiterSaturnUranusNeptune"
 )
 
 var (
@@ -642,6 +1681,11 @@ func PlanetSupportUndefinedWithDefaultStrings() []string {
 	return cp[:]
 }
 
+// Values returns all values of the enum.
+func (PlanetSupportUndefinedWithDefault) Values() []PlanetSupportUndefinedWithDefault {
+	return PlanetSupportUndefinedWithDefaultValues()
+}
+
 // IsValid tests whether the value is a valid enum value.
 func (_p PlanetSupportUndefinedWithDefault) IsValid() bool {
 	return _p >= 0 && _p <= 8
@@ -666,6 +1710,54 @@ func (_p PlanetSupportUndefinedWithDefault) String() string {
 	return _PlanetSupportUndefinedWithDefaultStrings[idx]
 }
 
+// Index returns the position of the value within the ordered values of the enum
+// (ignoring alternative values) or -1 if the value is invalid.
+func (_p PlanetSupportUndefinedWithDefault) Index() int {
+	if !_p.IsValid() {
+		return -1
+	}
+	idx := int(_p)
+	return idx
+}
+
+// Compare returns -1, 0 or +1 depending on whether the value orders before,
+// equal to or after the other value. Invalid values order before all valid values.
+func (_p PlanetSupportUndefinedWithDefault) Compare(other PlanetSupportUndefinedWithDefault) int {
+	a, b := _p.Index(), other.Index()
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return +1
+	}
+	return 0
+}
+
+// Less tests whether the value orders before the other value.
+func (_p PlanetSupportUndefinedWithDefault) Less(other PlanetSupportUndefinedWithDefault) bool {
+	return _p.Compare(other) < 0
+}
+
+// Next returns the succeeding value in order of the enum.
+// It returns false if the value is invalid or the last value.
+func (_p PlanetSupportUndefinedWithDefault) Next() (PlanetSupportUndefinedWithDefault, bool) {
+	idx := _p.Index()
+	if idx == -1 || idx+1 == len(_PlanetSupportUndefinedWithDefaultValues) {
+		return PlanetSupportUndefinedWithDefault(0), false
+	}
+	return _PlanetSupportUndefinedWithDefaultValues[idx+1], true
+}
+
+// Prev returns the preceding value in order of the enum.
+// It returns false if the value is invalid or the first value.
+func (_p PlanetSupportUndefinedWithDefault) Prev() (PlanetSupportUndefinedWithDefault, bool) {
+	idx := _p.Index()
+	if idx < 1 {
+		return PlanetSupportUndefinedWithDefault(0), false
+	}
+	return _PlanetSupportUndefinedWithDefaultValues[idx-1], true
+}
+
 var (
 	_PlanetSupportUndefinedWithDefaultStringToValueMap = map[string]PlanetSupportUndefinedWithDefault{
 		_PlanetSupportUndefinedWithDefaultString[0:5]:   PlanetSupportUndefinedWithDefaultEarth,
@@ -678,19 +1770,20 @@ var (
 		_PlanetSupportUndefinedWithDefaultString[39:45]: PlanetSupportUndefinedWithDefaultUranus,
 		_PlanetSupportUndefinedWithDefaultString[45:52]: PlanetSupportUndefinedWithDefaultNeptune,
 	}
-	_PlanetSupportUndefinedWithDefaultLowerStringToValueMap = map[string]PlanetSupportUndefinedWithDefault{
-		_PlanetSupportUndefinedWithDefaultLowerString[0:5]:   PlanetSupportUndefinedWithDefaultEarth,
-		_PlanetSupportUndefinedWithDefaultLowerString[5:9]:   PlanetSupportUndefinedWithDefaultMars,
-		_PlanetSupportUndefinedWithDefaultLowerString[9:14]:  PlanetSupportUndefinedWithDefaultPluto,
-		_PlanetSupportUndefinedWithDefaultLowerString[14:19]: PlanetSupportUndefinedWithDefaultVenus,
-		_PlanetSupportUndefinedWithDefaultLowerString[19:26]: PlanetSupportUndefinedWithDefaultMercury,
-		_PlanetSupportUndefinedWithDefaultLowerString[26:33]: PlanetSupportUndefinedWithDefaultJupiter,
-		_PlanetSupportUndefinedWithDefaultLowerString[33:39]: PlanetSupportUndefinedWithDefaultSaturn,
-		_PlanetSupportUndefinedWithDefaultLowerString[39:45]: PlanetSupportUndefinedWithDefaultUranus,
-		_PlanetSupportUndefinedWithDefaultLowerString[45:52]: PlanetSupportUndefinedWithDefaultNeptune,
-	}
 )
 
+// _PlanetSupportUndefinedWithDefaultLookupFold determines the enum value of the string regardless of its case
+// by Unicode simple folding. It scans the String values, as a fold table is only generated
+// for enums with the "ignore-case" feature.
+func _PlanetSupportUndefinedWithDefaultLookupFold(raw string) (PlanetSupportUndefinedWithDefault, bool) {
+	for idx := range _PlanetSupportUndefinedWithDefaultStrings {
+		if strings.EqualFold(_PlanetSupportUndefinedWithDefaultStrings[idx], raw) {
+			return _PlanetSupportUndefinedWithDefaultValues[idx], true
+		}
+	}
+	return PlanetSupportUndefinedWithDefault(0), false
+}
+
 // PlanetSupportUndefinedWithDefaultFromString determines the enum value with an exact case match.
 func PlanetSupportUndefinedWithDefaultFromString(raw string) (PlanetSupportUndefinedWithDefault, bool) {
 	if len(raw) == 0 {
@@ -703,7 +1796,8 @@ func PlanetSupportUndefinedWithDefaultFromString(raw string) (PlanetSupportUndef
 	return v, true
 }
 
-// PlanetSupportUndefinedWithDefaultFromStringIgnoreCase determines the enum value with a case-insensitive match.
+// PlanetSupportUndefinedWithDefaultFromStringIgnoreCase determines the enum value with a case-insensitive match
+// by Unicode simple folding, regardless of the casing of raw.
 func PlanetSupportUndefinedWithDefaultFromStringIgnoreCase(raw string) (PlanetSupportUndefinedWithDefault, bool) {
 	if len(raw) == 0 {
 		return PlanetSupportUndefinedWithDefault(0), true
@@ -712,7 +1806,7 @@ func PlanetSupportUndefinedWithDefaultFromStringIgnoreCase(raw string) (PlanetSu
 	if ok {
 		return v, ok
 	}
-	v, ok = _PlanetSupportUndefinedWithDefaultLowerStringToValueMap[raw]
+	v, ok = _PlanetSupportUndefinedWithDefaultLookupFold(raw)
 	if !ok {
 		return PlanetSupportUndefinedWithDefault(0), false
 	}
@@ -721,10 +1815,15 @@ func PlanetSupportUndefinedWithDefaultFromStringIgnoreCase(raw string) (PlanetSu
 
 // MarshalBinary implements the encoding.BinaryMarshaler interface for PlanetSupportUndefinedWithDefault.
 func (_p PlanetSupportUndefinedWithDefault) MarshalBinary() ([]byte, error) {
+	return _p.AppendBinary(nil)
+}
+
+// AppendBinary implements the encoding.BinaryAppender interface for PlanetSupportUndefinedWithDefault.
+func (_p PlanetSupportUndefinedWithDefault) AppendBinary(b []byte) ([]byte, error) {
 	if err := _p.Validate(); err != nil {
-		return nil, fmt.Errorf("Cannot marshal value %q as PlanetSupportUndefinedWithDefault. %w", _p, err)
+		return b, fmt.Errorf("Cannot marshal value %q as PlanetSupportUndefinedWithDefault. %w", _p, err)
 	}
-	return []byte(_p.String()), nil
+	return append(b, _p.String()...), nil
 }
 
 // UnmarshalBinary implements the encoding.BinaryUnmarshaler interface for PlanetSupportUndefinedWithDefault.
@@ -734,7 +1833,7 @@ func (_p *PlanetSupportUndefinedWithDefault) UnmarshalBinary(text []byte) error
 	var ok bool
 	*_p, ok = PlanetSupportUndefinedWithDefaultFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a PlanetSupportUndefinedWithDefault", str)
+		return _enumer.NewParseError("PlanetSupportUndefinedWithDefault", str, PlanetSupportUndefinedWithDefaultStrings())
 	}
 	return nil
 }
@@ -759,7 +1858,30 @@ func (_p *PlanetSupportUndefinedWithDefault) UnmarshalBSONValue(t bsontype.Type,
 
 	*_p, ok = PlanetSupportUndefinedWithDefaultFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a PlanetSupportUndefinedWithDefault", str)
+		return _enumer.NewParseError("PlanetSupportUndefinedWithDefault", str, PlanetSupportUndefinedWithDefaultStrings())
+	}
+	return nil
+}
+
+// MarshalCBOR implements the cbor.Marshaler interface for PlanetSupportUndefinedWithDefault.
+func (_p PlanetSupportUndefinedWithDefault) MarshalCBOR() ([]byte, error) {
+	if err := _p.Validate(); err != nil {
+		return nil, fmt.Errorf("Cannot marshal value %q as PlanetSupportUndefinedWithDefault. %w", _p, err)
+	}
+	return cbor.Marshal(_p.String())
+}
+
+// UnmarshalCBOR implements the cbor.Unmarshaler interface for PlanetSupportUndefinedWithDefault.
+func (_p *PlanetSupportUndefinedWithDefault) UnmarshalCBOR(data []byte) error {
+	var str string
+	if err := cbor.Unmarshal(data, &str); err != nil {
+		return fmt.Errorf("PlanetSupportUndefinedWithDefault should be a string, got %q", data)
+	}
+
+	var ok bool
+	*_p, ok = PlanetSupportUndefinedWithDefaultFromString(str)
+	if !ok {
+		return _enumer.NewParseError("PlanetSupportUndefinedWithDefault", str, PlanetSupportUndefinedWithDefaultStrings())
 	}
 	return nil
 }
@@ -787,7 +1909,7 @@ func (_p *PlanetSupportUndefinedWithDefault) UnmarshalGQL(value interface{}) err
 	var ok bool
 	*_p, ok = PlanetSupportUndefinedWithDefaultFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a PlanetSupportUndefinedWithDefault", str)
+		return _enumer.NewParseError("PlanetSupportUndefinedWithDefault", str, PlanetSupportUndefinedWithDefaultStrings())
 	}
 	return nil
 }
@@ -810,7 +1932,30 @@ func (_p *PlanetSupportUndefinedWithDefault) UnmarshalJSON(data []byte) error {
 	var ok bool
 	*_p, ok = PlanetSupportUndefinedWithDefaultFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a PlanetSupportUndefinedWithDefault", str)
+		return _enumer.NewParseError("PlanetSupportUndefinedWithDefault", str, PlanetSupportUndefinedWithDefaultStrings())
+	}
+	return nil
+}
+
+// MarshalMsgpack implements the msgpack.Marshaler interface for PlanetSupportUndefinedWithDefault.
+func (_p PlanetSupportUndefinedWithDefault) MarshalMsgpack() ([]byte, error) {
+	if err := _p.Validate(); err != nil {
+		return nil, fmt.Errorf("Cannot marshal value %q as PlanetSupportUndefinedWithDefault. %w", _p, err)
+	}
+	return msgpack.Marshal(_p.String())
+}
+
+// UnmarshalMsgpack implements the msgpack.Unmarshaler interface for PlanetSupportUndefinedWithDefault.
+func (_p *PlanetSupportUndefinedWithDefault) UnmarshalMsgpack(data []byte) error {
+	var str string
+	if err := msgpack.Unmarshal(data, &str); err != nil {
+		return fmt.Errorf("PlanetSupportUndefinedWithDefault should be a string, got %q", data)
+	}
+
+	var ok bool
+	*_p, ok = PlanetSupportUndefinedWithDefaultFromString(str)
+	if !ok {
+		return _enumer.NewParseError("PlanetSupportUndefinedWithDefault", str, PlanetSupportUndefinedWithDefaultStrings())
 	}
 	return nil
 }
@@ -841,17 +1986,22 @@ func (_p *PlanetSupportUndefinedWithDefault) Scan(value interface{}) error {
 	var ok bool
 	*_p, ok = PlanetSupportUndefinedWithDefaultFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a PlanetSupportUndefinedWithDefault", str)
+		return _enumer.NewParseError("PlanetSupportUndefinedWithDefault", str, PlanetSupportUndefinedWithDefaultStrings())
 	}
 	return nil
 }
 
 // MarshalText implements the encoding.TextMarshaler interface for PlanetSupportUndefinedWithDefault.
 func (_p PlanetSupportUndefinedWithDefault) MarshalText() ([]byte, error) {
+	return _p.AppendText(nil)
+}
+
+// AppendText implements the encoding.TextAppender interface for PlanetSupportUndefinedWithDefault.
+func (_p PlanetSupportUndefinedWithDefault) AppendText(b []byte) ([]byte, error) {
 	if err := _p.Validate(); err != nil {
-		return nil, fmt.Errorf("Cannot marshal value %q as PlanetSupportUndefinedWithDefault. %w", _p, err)
+		return b, fmt.Errorf("Cannot marshal value %q as PlanetSupportUndefinedWithDefault. %w", _p, err)
 	}
-	return []byte(_p.String()), nil
+	return append(b, _p.String()...), nil
 }
 
 // UnmarshalText implements the encoding.TextUnmarshaler interface for PlanetSupportUndefinedWithDefault.
@@ -861,21 +2011,64 @@ func (_p *PlanetSupportUndefinedWithDefault) UnmarshalText(text []byte) error {
 	var ok bool
 	*_p, ok = PlanetSupportUndefinedWithDefaultFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a PlanetSupportUndefinedWithDefault", str)
+		return _enumer.NewParseError("PlanetSupportUndefinedWithDefault", str, PlanetSupportUndefinedWithDefaultStrings())
 	}
 	return nil
 }
 
-// MarshalYAML implements a YAML Marshaler for PlanetSupportUndefinedWithDefault.
-func (_p PlanetSupportUndefinedWithDefault) MarshalYAML() (interface{}, error) {
+// MarshalXML implements the xml.Marshaler interface for PlanetSupportUndefinedWithDefault.
+func (_p PlanetSupportUndefinedWithDefault) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if err := _p.Validate(); err != nil {
-		return nil, fmt.Errorf("Cannot marshal value %q as PlanetSupportUndefinedWithDefault. %w", _p, err)
+		return fmt.Errorf("Cannot marshal value %q as PlanetSupportUndefinedWithDefault. %w", _p, err)
 	}
-	return _p.String(), nil
+	return e.EncodeElement(_p.String(), start)
 }
 
-// UnmarshalYAML implements a YAML Unmarshaler for PlanetSupportUndefinedWithDefault.
-func (_p *PlanetSupportUndefinedWithDefault) UnmarshalYAML(unmarshal func(interface{}) error) error {
+// UnmarshalXML implements the xml.Unmarshaler interface for PlanetSupportUndefinedWithDefault.
+func (_p *PlanetSupportUndefinedWithDefault) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var str string
+	if err := d.DecodeElement(&str, &start); err != nil {
+		return err
+	}
+
+	var ok bool
+	*_p, ok = PlanetSupportUndefinedWithDefaultFromString(str)
+	if !ok {
+		return _enumer.NewParseError("PlanetSupportUndefinedWithDefault", str, PlanetSupportUndefinedWithDefaultStrings())
+	}
+	return nil
+}
+
+// MarshalXMLAttr implements the xml.MarshalerAttr interface for PlanetSupportUndefinedWithDefault.
+func (_p PlanetSupportUndefinedWithDefault) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	if err := _p.Validate(); err != nil {
+		return xml.Attr{}, fmt.Errorf("Cannot marshal value %q as PlanetSupportUndefinedWithDefault. %w", _p, err)
+	}
+	return xml.Attr{Name: name, Value: _p.String()}, nil
+}
+
+// UnmarshalXMLAttr implements the xml.UnmarshalerAttr interface for PlanetSupportUndefinedWithDefault.
+func (_p *PlanetSupportUndefinedWithDefault) UnmarshalXMLAttr(attr xml.Attr) error {
+	str := attr.Value
+
+	var ok bool
+	*_p, ok = PlanetSupportUndefinedWithDefaultFromString(str)
+	if !ok {
+		return _enumer.NewParseError("PlanetSupportUndefinedWithDefault", str, PlanetSupportUndefinedWithDefaultStrings())
+	}
+	return nil
+}
+
+// MarshalYAML implements a YAML Marshaler for PlanetSupportUndefinedWithDefault.
+func (_p PlanetSupportUndefinedWithDefault) MarshalYAML() (interface{}, error) {
+	if err := _p.Validate(); err != nil {
+		return nil, fmt.Errorf("Cannot marshal value %q as PlanetSupportUndefinedWithDefault. %w", _p, err)
+	}
+	return _p.String(), nil
+}
+
+// UnmarshalYAML implements a YAML Unmarshaler for PlanetSupportUndefinedWithDefault.
+func (_p *PlanetSupportUndefinedWithDefault) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	var str string
 	if err := unmarshal(&str); err != nil {
 		return err
@@ -884,14 +2077,44 @@ func (_p *PlanetSupportUndefinedWithDefault) UnmarshalYAML(unmarshal func(interf
 	var ok bool
 	*_p, ok = PlanetSupportUndefinedWithDefaultFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a PlanetSupportUndefinedWithDefault", str)
+		return _enumer.NewParseError("PlanetSupportUndefinedWithDefault", str, PlanetSupportUndefinedWithDefaultStrings())
 	}
 	return nil
 }
 
+var _PlanetSupportUndefinedWithDefaultCompletions = [9]string{
+	"Earth",
+	"Mars",
+	"Pluto",
+	"Venus",
+	"Mercury",
+	"Jupiter",
+	"Saturn",
+	"Uranus",
+	"Neptune",
+}
+
+// PlanetSupportUndefinedWithDefaultUsage returns a usage hint of all String values of the enum, less the deprecated values,
+// e.g. for flag descriptions.
+func PlanetSupportUndefinedWithDefaultUsage() string {
+	return "one of: " + strings.Join(_PlanetSupportUndefinedWithDefaultStrings[:], "|")
+}
+
+// PlanetSupportUndefinedWithDefaultCompletions returns the completion candidates of the enum matching the given prefix,
+// less the deprecated values. Each candidate is formatted as "<value>" or "<value>\t<description>",
+// as expected by shell completions, e.g. of cobra's RegisterFlagCompletionFunc.
+func PlanetSupportUndefinedWithDefaultCompletions(toComplete string) []string {
+	out := make([]string, 0, len(_PlanetSupportUndefinedWithDefaultCompletions))
+	for _, c := range _PlanetSupportUndefinedWithDefaultCompletions {
+		if strings.HasPrefix(c, toComplete) {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
 const (
-	_PlanetWithDefaultString      = "EarthMarsPlutoVenusMercuryJupiterSaturnUranusNeptune"
-	_PlanetWithDefaultLowerString = "earthmarsplutovenusmercuryjupitersaturnuranusneptune"
+	_PlanetWithDefaultString = "EarthMarsPlutoVenusMercuryJupiterSaturnUranusNeptune"
 )
 
 var (
@@ -927,6 +2150,11 @@ func PlanetWithDefaultStrings() []string {
 	return cp[:]
 }
 
+// Values returns all values of the enum.
+func (PlanetWithDefault) Values() []PlanetWithDefault {
+	return PlanetWithDefaultValues()
+}
+
 // IsValid tests whether the value is a valid enum value.
 func (_p PlanetWithDefault) IsValid() bool {
 	return _p >= 0 && _p <= 8
@@ -951,6 +2179,54 @@ func (_p PlanetWithDefault) String() string {
 	return _PlanetWithDefaultStrings[idx]
 }
 
+// Index returns the position of the value within the ordered values of the enum
+// (ignoring alternative values) or -1 if the value is invalid.
+func (_p PlanetWithDefault) Index() int {
+	if !_p.IsValid() {
+		return -1
+	}
+	idx := int(_p)
+	return idx
+}
+
+// Compare returns -1, 0 or +1 depending on whether the value orders before,
+// equal to or after the other value. Invalid values order before all valid values.
+func (_p PlanetWithDefault) Compare(other PlanetWithDefault) int {
+	a, b := _p.Index(), other.Index()
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return +1
+	}
+	return 0
+}
+
+// Less tests whether the value orders before the other value.
+func (_p PlanetWithDefault) Less(other PlanetWithDefault) bool {
+	return _p.Compare(other) < 0
+}
+
+// Next returns the succeeding value in order of the enum.
+// It returns false if the value is invalid or the last value.
+func (_p PlanetWithDefault) Next() (PlanetWithDefault, bool) {
+	idx := _p.Index()
+	if idx == -1 || idx+1 == len(_PlanetWithDefaultValues) {
+		return PlanetWithDefault(0), false
+	}
+	return _PlanetWithDefaultValues[idx+1], true
+}
+
+// Prev returns the preceding value in order of the enum.
+// It returns false if the value is invalid or the first value.
+func (_p PlanetWithDefault) Prev() (PlanetWithDefault, bool) {
+	idx := _p.Index()
+	if idx < 1 {
+		return PlanetWithDefault(0), false
+	}
+	return _PlanetWithDefaultValues[idx-1], true
+}
+
 var (
 	_PlanetWithDefaultStringToValueMap = map[string]PlanetWithDefault{
 		_PlanetWithDefaultString[0:5]:   PlanetWithDefaultEarth,
@@ -963,19 +2239,20 @@ var (
 		_PlanetWithDefaultString[39:45]: PlanetWithDefaultUranus,
 		_PlanetWithDefaultString[45:52]: PlanetWithDefaultNeptune,
 	}
-	_PlanetWithDefaultLowerStringToValueMap = map[string]PlanetWithDefault{
-		_PlanetWithDefaultLowerString[0:5]:   PlanetWithDefaultEarth,
-		_PlanetWithDefaultLowerString[5:9]:   PlanetWithDefaultMars,
-		_PlanetWithDefaultLowerString[9:14]:  PlanetWithDefaultPluto,
-		_PlanetWithDefaultLowerString[14:19]: PlanetWithDefaultVenus,
-		_PlanetWithDefaultLowerString[19:26]: PlanetWithDefaultMercury,
-		_PlanetWithDefaultLowerString[26:33]: PlanetWithDefaultJupiter,
-		_PlanetWithDefaultLowerString[33:39]: PlanetWithDefaultSaturn,
-		_PlanetWithDefaultLowerString[39:45]: PlanetWithDefaultUranus,
-		_PlanetWithDefaultLowerString[45:52]: PlanetWithDefaultNeptune,
-	}
 )
 
+// _PlanetWithDefaultLookupFold determines the enum value of the string regardless of its case
+// by Unicode simple folding. It scans the String values, as a fold table is only generated
+// for enums with the "ignore-case" feature.
+func _PlanetWithDefaultLookupFold(raw string) (PlanetWithDefault, bool) {
+	for idx := range _PlanetWithDefaultStrings {
+		if strings.EqualFold(_PlanetWithDefaultStrings[idx], raw) {
+			return _PlanetWithDefaultValues[idx], true
+		}
+	}
+	return PlanetWithDefault(0), false
+}
+
 // PlanetWithDefaultFromString determines the enum value with an exact case match.
 func PlanetWithDefaultFromString(raw string) (PlanetWithDefault, bool) {
 	v, ok := _PlanetWithDefaultStringToValueMap[raw]
@@ -985,13 +2262,14 @@ func PlanetWithDefaultFromString(raw string) (PlanetWithDefault, bool) {
 	return v, true
 }
 
-// PlanetWithDefaultFromStringIgnoreCase determines the enum value with a case-insensitive match.
+// PlanetWithDefaultFromStringIgnoreCase determines the enum value with a case-insensitive match
+// by Unicode simple folding, regardless of the casing of raw.
 func PlanetWithDefaultFromStringIgnoreCase(raw string) (PlanetWithDefault, bool) {
 	v, ok := PlanetWithDefaultFromString(raw)
 	if ok {
 		return v, ok
 	}
-	v, ok = _PlanetWithDefaultLowerStringToValueMap[raw]
+	v, ok = _PlanetWithDefaultLookupFold(raw)
 	if !ok {
 		return PlanetWithDefault(0), false
 	}
@@ -1000,10 +2278,15 @@ func PlanetWithDefaultFromStringIgnoreCase(raw string) (PlanetWithDefault, bool)
 
 // MarshalBinary implements the encoding.BinaryMarshaler interface for PlanetWithDefault.
 func (_p PlanetWithDefault) MarshalBinary() ([]byte, error) {
+	return _p.AppendBinary(nil)
+}
+
+// AppendBinary implements the encoding.BinaryAppender interface for PlanetWithDefault.
+func (_p PlanetWithDefault) AppendBinary(b []byte) ([]byte, error) {
 	if err := _p.Validate(); err != nil {
-		return nil, fmt.Errorf("Cannot marshal value %q as PlanetWithDefault. %w", _p, err)
+		return b, fmt.Errorf("Cannot marshal value %q as PlanetWithDefault. %w", _p, err)
 	}
-	return []byte(_p.String()), nil
+	return append(b, _p.String()...), nil
 }
 
 // UnmarshalBinary implements the encoding.BinaryUnmarshaler interface for PlanetWithDefault.
@@ -1016,7 +2299,7 @@ func (_p *PlanetWithDefault) UnmarshalBinary(text []byte) error {
 	var ok bool
 	*_p, ok = PlanetWithDefaultFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a PlanetWithDefault", str)
+		return _enumer.NewParseError("PlanetWithDefault", str, PlanetWithDefaultStrings())
 	}
 	return nil
 }
@@ -1044,7 +2327,33 @@ func (_p *PlanetWithDefault) UnmarshalBSONValue(t bsontype.Type, data []byte) er
 
 	*_p, ok = PlanetWithDefaultFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a PlanetWithDefault", str)
+		return _enumer.NewParseError("PlanetWithDefault", str, PlanetWithDefaultStrings())
+	}
+	return nil
+}
+
+// MarshalCBOR implements the cbor.Marshaler interface for PlanetWithDefault.
+func (_p PlanetWithDefault) MarshalCBOR() ([]byte, error) {
+	if err := _p.Validate(); err != nil {
+		return nil, fmt.Errorf("Cannot marshal value %q as PlanetWithDefault. %w", _p, err)
+	}
+	return cbor.Marshal(_p.String())
+}
+
+// UnmarshalCBOR implements the cbor.Unmarshaler interface for PlanetWithDefault.
+func (_p *PlanetWithDefault) UnmarshalCBOR(data []byte) error {
+	var str string
+	if err := cbor.Unmarshal(data, &str); err != nil {
+		return fmt.Errorf("PlanetWithDefault should be a string, got %q", data)
+	}
+	if len(str) == 0 {
+		return fmt.Errorf("PlanetWithDefault cannot be derived from empty string")
+	}
+
+	var ok bool
+	*_p, ok = PlanetWithDefaultFromString(str)
+	if !ok {
+		return _enumer.NewParseError("PlanetWithDefault", str, PlanetWithDefaultStrings())
 	}
 	return nil
 }
@@ -1074,7 +2383,7 @@ func (_p *PlanetWithDefault) UnmarshalGQL(value interface{}) error {
 	var ok bool
 	*_p, ok = PlanetWithDefaultFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a PlanetWithDefault", str)
+		return _enumer.NewParseError("PlanetWithDefault", str, PlanetWithDefaultStrings())
 	}
 	return nil
 }
@@ -1100,7 +2409,33 @@ func (_p *PlanetWithDefault) UnmarshalJSON(data []byte) error {
 	var ok bool
 	*_p, ok = PlanetWithDefaultFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a PlanetWithDefault", str)
+		return _enumer.NewParseError("PlanetWithDefault", str, PlanetWithDefaultStrings())
+	}
+	return nil
+}
+
+// MarshalMsgpack implements the msgpack.Marshaler interface for PlanetWithDefault.
+func (_p PlanetWithDefault) MarshalMsgpack() ([]byte, error) {
+	if err := _p.Validate(); err != nil {
+		return nil, fmt.Errorf("Cannot marshal value %q as PlanetWithDefault. %w", _p, err)
+	}
+	return msgpack.Marshal(_p.String())
+}
+
+// UnmarshalMsgpack implements the msgpack.Unmarshaler interface for PlanetWithDefault.
+func (_p *PlanetWithDefault) UnmarshalMsgpack(data []byte) error {
+	var str string
+	if err := msgpack.Unmarshal(data, &str); err != nil {
+		return fmt.Errorf("PlanetWithDefault should be a string, got %q", data)
+	}
+	if len(str) == 0 {
+		return fmt.Errorf("PlanetWithDefault cannot be derived from empty string")
+	}
+
+	var ok bool
+	*_p, ok = PlanetWithDefaultFromString(str)
+	if !ok {
+		return _enumer.NewParseError("PlanetWithDefault", str, PlanetWithDefaultStrings())
 	}
 	return nil
 }
@@ -1133,17 +2468,22 @@ func (_p *PlanetWithDefault) Scan(value interface{}) error {
 	var ok bool
 	*_p, ok = PlanetWithDefaultFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a PlanetWithDefault", str)
+		return _enumer.NewParseError("PlanetWithDefault", str, PlanetWithDefaultStrings())
 	}
 	return nil
 }
 
 // MarshalText implements the encoding.TextMarshaler interface for PlanetWithDefault.
 func (_p PlanetWithDefault) MarshalText() ([]byte, error) {
+	return _p.AppendText(nil)
+}
+
+// AppendText implements the encoding.TextAppender interface for PlanetWithDefault.
+func (_p PlanetWithDefault) AppendText(b []byte) ([]byte, error) {
 	if err := _p.Validate(); err != nil {
-		return nil, fmt.Errorf("Cannot marshal value %q as PlanetWithDefault. %w", _p, err)
+		return b, fmt.Errorf("Cannot marshal value %q as PlanetWithDefault. %w", _p, err)
 	}
-	return []byte(_p.String()), nil
+	return append(b, _p.String()...), nil
 }
 
 // UnmarshalText implements the encoding.TextUnmarshaler interface for PlanetWithDefault.
@@ -1156,7 +2496,56 @@ func (_p *PlanetWithDefault) UnmarshalText(text []byte) error {
 	var ok bool
 	*_p, ok = PlanetWithDefaultFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a PlanetWithDefault", str)
+		return _enumer.NewParseError("PlanetWithDefault", str, PlanetWithDefaultStrings())
+	}
+	return nil
+}
+
+// MarshalXML implements the xml.Marshaler interface for PlanetWithDefault.
+func (_p PlanetWithDefault) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if err := _p.Validate(); err != nil {
+		return fmt.Errorf("Cannot marshal value %q as PlanetWithDefault. %w", _p, err)
+	}
+	return e.EncodeElement(_p.String(), start)
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface for PlanetWithDefault.
+func (_p *PlanetWithDefault) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var str string
+	if err := d.DecodeElement(&str, &start); err != nil {
+		return err
+	}
+	if len(str) == 0 {
+		return fmt.Errorf("PlanetWithDefault cannot be derived from empty string")
+	}
+
+	var ok bool
+	*_p, ok = PlanetWithDefaultFromString(str)
+	if !ok {
+		return _enumer.NewParseError("PlanetWithDefault", str, PlanetWithDefaultStrings())
+	}
+	return nil
+}
+
+// MarshalXMLAttr implements the xml.MarshalerAttr interface for PlanetWithDefault.
+func (_p PlanetWithDefault) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	if err := _p.Validate(); err != nil {
+		return xml.Attr{}, fmt.Errorf("Cannot marshal value %q as PlanetWithDefault. %w", _p, err)
+	}
+	return xml.Attr{Name: name, Value: _p.String()}, nil
+}
+
+// UnmarshalXMLAttr implements the xml.UnmarshalerAttr interface for PlanetWithDefault.
+func (_p *PlanetWithDefault) UnmarshalXMLAttr(attr xml.Attr) error {
+	str := attr.Value
+	if len(str) == 0 {
+		return fmt.Errorf("PlanetWithDefault cannot be derived from empty string")
+	}
+
+	var ok bool
+	*_p, ok = PlanetWithDefaultFromString(str)
+	if !ok {
+		return _enumer.NewParseError("PlanetWithDefault", str, PlanetWithDefaultStrings())
 	}
 	return nil
 }
@@ -1182,7 +2571,521 @@ func (_p *PlanetWithDefault) UnmarshalYAML(unmarshal func(interface{}) error) er
 	var ok bool
 	*_p, ok = PlanetWithDefaultFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a PlanetWithDefault", str)
+		return _enumer.NewParseError("PlanetWithDefault", str, PlanetWithDefaultStrings())
+	}
+	return nil
+}
+
+var _PlanetWithDefaultCompletions = [9]string{
+	"Earth",
+	"Mars",
+	"Pluto",
+	"Venus",
+	"Mercury",
+	"Jupiter",
+	"Saturn",
+	"Uranus",
+	"Neptune",
+}
+
+// PlanetWithDefaultUsage returns a usage hint of all String values of the enum, less the deprecated values,
+// e.g. for flag descriptions.
+func PlanetWithDefaultUsage() string {
+	return "one of: " + strings.Join(_PlanetWithDefaultStrings[:], "|")
+}
+
+// PlanetWithDefaultCompletions returns the completion candidates of the enum matching the given prefix,
+// less the deprecated values. Each candidate is formatted as "<value>" or "<value>\t<description>",
+// as expected by shell completions, e.g. of cobra's RegisterFlagCompletionFunc.
+func PlanetWithDefaultCompletions(toComplete string) []string {
+	out := make([]string, 0, len(_PlanetWithDefaultCompletions))
+	for _, c := range _PlanetWithDefaultCompletions {
+		if strings.HasPrefix(c, toComplete) {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+const (
+	_PlanetWithExplicitDefaultString = "MercuryVenusEarthMarsJupiterSaturnUranusNeptune"
+)
+
+var (
+	_PlanetWithExplicitDefaultValues  = [8]PlanetWithExplicitDefault{1, 2, 3, 4, 5, 6, 7, 8}
+	_PlanetWithExplicitDefaultStrings = [8]string{_PlanetWithExplicitDefaultString[0:7], _PlanetWithExplicitDefaultString[7:12], _PlanetWithExplicitDefaultString[12:17], _PlanetWithExplicitDefaultString[17:21], _PlanetWithExplicitDefaultString[21:28], _PlanetWithExplicitDefaultString[28:34], _PlanetWithExplicitDefaultString[34:40], _PlanetWithExplicitDefaultString[40:47]}
+)
+
+// _PlanetWithExplicitDefaultNoOp is a compile time assertion.
+// An "invalid argument/out of bounds" compiler error signifies that the enum values have changed.
+// Re-run the enumer command to generate an updated version of PlanetWithExplicitDefault.
+func _PlanetWithExplicitDefaultNoOp() {
+	var x [1]struct{}
+	_ = x[PlanetWithExplicitDefaultMercury-(1)]
+	_ = x[PlanetWithExplicitDefaultVenus-(2)]
+	_ = x[PlanetWithExplicitDefaultEarth-(3)]
+	_ = x[PlanetWithExplicitDefaultMars-(4)]
+	_ = x[PlanetWithExplicitDefaultJupiter-(5)]
+	_ = x[PlanetWithExplicitDefaultSaturn-(6)]
+	_ = x[PlanetWithExplicitDefaultUranus-(7)]
+	_ = x[PlanetWithExplicitDefaultNeptune-(8)]
+}
+
+// PlanetWithExplicitDefaultValues returns all values of the enum.
+func PlanetWithExplicitDefaultValues() []PlanetWithExplicitDefault {
+	cp := _PlanetWithExplicitDefaultValues
+	return cp[:]
+}
+
+// PlanetWithExplicitDefaultStrings returns a slice of all String values of the enum.
+func PlanetWithExplicitDefaultStrings() []string {
+	cp := _PlanetWithExplicitDefaultStrings
+	return cp[:]
+}
+
+// Values returns all values of the enum.
+func (PlanetWithExplicitDefault) Values() []PlanetWithExplicitDefault {
+	return PlanetWithExplicitDefaultValues()
+}
+
+// IsValid tests whether the value is a valid enum value.
+func (_p PlanetWithExplicitDefault) IsValid() bool {
+	return _p >= 1 && _p <= 8
+}
+
+// Validate whether the value is within the range of enum values.
+func (_p PlanetWithExplicitDefault) Validate() error {
+	if !_p.IsValid() {
+		return fmt.Errorf("PlanetWithExplicitDefault(%d) is %w", _p, ErrNoValidEnum)
+	}
+	return nil
+}
+
+// Default returns the default value of the enum.
+func (PlanetWithExplicitDefault) Default() PlanetWithExplicitDefault {
+	return PlanetWithExplicitDefaultEarth
+}
+
+// OrDefault returns the value if it is valid and the default value otherwise.
+func (_p PlanetWithExplicitDefault) OrDefault() PlanetWithExplicitDefault {
+	if !_p.IsValid() {
+		return PlanetWithExplicitDefaultEarth
+	}
+	return _p
+}
+
+// String returns the string of the enum value.
+// If the enum value is invalid, it will produce a string
+// of the following pattern PlanetWithExplicitDefault(%d) instead.
+func (_p PlanetWithExplicitDefault) String() string {
+	if !_p.IsValid() {
+		return fmt.Sprintf("PlanetWithExplicitDefault(%d)", _p)
+	}
+	idx := uint(_p) - 1
+	return _PlanetWithExplicitDefaultStrings[idx]
+}
+
+// Index returns the position of the value within the ordered values of the enum
+// (ignoring alternative values) or -1 if the value is invalid.
+func (_p PlanetWithExplicitDefault) Index() int {
+	if !_p.IsValid() {
+		return -1
+	}
+	idx := int(_p) - 1
+	return idx
+}
+
+// Compare returns -1, 0 or +1 depending on whether the value orders before,
+// equal to or after the other value. Invalid values order before all valid values.
+func (_p PlanetWithExplicitDefault) Compare(other PlanetWithExplicitDefault) int {
+	a, b := _p.Index(), other.Index()
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return +1
+	}
+	return 0
+}
+
+// Less tests whether the value orders before the other value.
+func (_p PlanetWithExplicitDefault) Less(other PlanetWithExplicitDefault) bool {
+	return _p.Compare(other) < 0
+}
+
+// Next returns the succeeding value in order of the enum.
+// It returns false if the value is invalid or the last value.
+func (_p PlanetWithExplicitDefault) Next() (PlanetWithExplicitDefault, bool) {
+	idx := _p.Index()
+	if idx == -1 || idx+1 == len(_PlanetWithExplicitDefaultValues) {
+		return PlanetWithExplicitDefault(0), false
+	}
+	return _PlanetWithExplicitDefaultValues[idx+1], true
+}
+
+// Prev returns the preceding value in order of the enum.
+// It returns false if the value is invalid or the first value.
+func (_p PlanetWithExplicitDefault) Prev() (PlanetWithExplicitDefault, bool) {
+	idx := _p.Index()
+	if idx < 1 {
+		return PlanetWithExplicitDefault(0), false
+	}
+	return _PlanetWithExplicitDefaultValues[idx-1], true
+}
+
+var (
+	_PlanetWithExplicitDefaultStringToValueMap = map[string]PlanetWithExplicitDefault{
+		_PlanetWithExplicitDefaultString[0:7]:   PlanetWithExplicitDefaultMercury,
+		_PlanetWithExplicitDefaultString[7:12]:  PlanetWithExplicitDefaultVenus,
+		_PlanetWithExplicitDefaultString[12:17]: PlanetWithExplicitDefaultEarth,
+		_PlanetWithExplicitDefaultString[17:21]: PlanetWithExplicitDefaultMars,
+		_PlanetWithExplicitDefaultString[21:28]: PlanetWithExplicitDefaultJupiter,
+		_PlanetWithExplicitDefaultString[28:34]: PlanetWithExplicitDefaultSaturn,
+		_PlanetWithExplicitDefaultString[34:40]: PlanetWithExplicitDefaultUranus,
+		_PlanetWithExplicitDefaultString[40:47]: PlanetWithExplicitDefaultNeptune,
+	}
+)
+
+// _PlanetWithExplicitDefaultLookupFold determines the enum value of the string regardless of its case
+// by Unicode simple folding. It scans the String values, as a fold table is only generated
+// for enums with the "ignore-case" feature.
+func _PlanetWithExplicitDefaultLookupFold(raw string) (PlanetWithExplicitDefault, bool) {
+	for idx := range _PlanetWithExplicitDefaultStrings {
+		if strings.EqualFold(_PlanetWithExplicitDefaultStrings[idx], raw) {
+			return _PlanetWithExplicitDefaultValues[idx], true
+		}
+	}
+	return PlanetWithExplicitDefault(0), false
+}
+
+// PlanetWithExplicitDefaultFromString determines the enum value with an exact case match.
+func PlanetWithExplicitDefaultFromString(raw string) (PlanetWithExplicitDefault, bool) {
+	if len(raw) == 0 {
+		return PlanetWithExplicitDefaultEarth, true
+	}
+	v, ok := _PlanetWithExplicitDefaultStringToValueMap[raw]
+	if !ok {
+		return PlanetWithExplicitDefault(0), false
+	}
+	return v, true
+}
+
+// PlanetWithExplicitDefaultFromStringIgnoreCase determines the enum value with a case-insensitive match
+// by Unicode simple folding, regardless of the casing of raw.
+func PlanetWithExplicitDefaultFromStringIgnoreCase(raw string) (PlanetWithExplicitDefault, bool) {
+	if len(raw) == 0 {
+		return PlanetWithExplicitDefaultEarth, true
+	}
+	v, ok := PlanetWithExplicitDefaultFromString(raw)
+	if ok {
+		return v, ok
+	}
+	v, ok = _PlanetWithExplicitDefaultLookupFold(raw)
+	if !ok {
+		return PlanetWithExplicitDefault(0), false
+	}
+	return v, true
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface for PlanetWithExplicitDefault.
+func (_p PlanetWithExplicitDefault) MarshalBinary() ([]byte, error) {
+	return _p.AppendBinary(nil)
+}
+
+// AppendBinary implements the encoding.BinaryAppender interface for PlanetWithExplicitDefault.
+func (_p PlanetWithExplicitDefault) AppendBinary(b []byte) ([]byte, error) {
+	if err := _p.Validate(); err != nil {
+		return b, fmt.Errorf("Cannot marshal value %q as PlanetWithExplicitDefault. %w", _p, err)
+	}
+	return append(b, _p.String()...), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface for PlanetWithExplicitDefault.
+func (_p *PlanetWithExplicitDefault) UnmarshalBinary(text []byte) error {
+	str := string(text)
+
+	var ok bool
+	*_p, ok = PlanetWithExplicitDefaultFromString(str)
+	if !ok {
+		return _enumer.NewParseError("PlanetWithExplicitDefault", str, PlanetWithExplicitDefaultStrings())
+	}
+	return nil
+}
+
+// MarshalBSONValue implements the bson.ValueMarshaler interface for PlanetWithExplicitDefault.
+func (_p PlanetWithExplicitDefault) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	if err := _p.Validate(); err != nil {
+		return 0, nil, fmt.Errorf("Cannot marshal value %q as PlanetWithExplicitDefault. %w", _p, err)
+	}
+	return bson.MarshalValue(_p.String())
+}
+
+// UnmarshalBSONValue implements the bson.ValueUnmarshaler interface for PlanetWithExplicitDefault.
+func (_p *PlanetWithExplicitDefault) UnmarshalBSONValue(t bsontype.Type, data []byte) error {
+	if t == bsontype.Undefined || t == bsontype.Null {
+		*_p = PlanetWithExplicitDefaultEarth
+		return nil
+	}
+	if t != bsontype.String {
+		return fmt.Errorf("PlanetWithExplicitDefault should be a string, got %q of Type %q", data, t)
+	}
+	str, data, ok := bsoncore.ReadString(data)
+	if !ok {
+		return fmt.Errorf("failed reading value as string, got %q", data)
+	}
+
+	*_p, ok = PlanetWithExplicitDefaultFromString(str)
+	if !ok {
+		return _enumer.NewParseError("PlanetWithExplicitDefault", str, PlanetWithExplicitDefaultStrings())
+	}
+	return nil
+}
+
+// MarshalCBOR implements the cbor.Marshaler interface for PlanetWithExplicitDefault.
+func (_p PlanetWithExplicitDefault) MarshalCBOR() ([]byte, error) {
+	if err := _p.Validate(); err != nil {
+		return nil, fmt.Errorf("Cannot marshal value %q as PlanetWithExplicitDefault. %w", _p, err)
+	}
+	return cbor.Marshal(_p.String())
+}
+
+// UnmarshalCBOR implements the cbor.Unmarshaler interface for PlanetWithExplicitDefault.
+func (_p *PlanetWithExplicitDefault) UnmarshalCBOR(data []byte) error {
+	var str string
+	if err := cbor.Unmarshal(data, &str); err != nil {
+		return fmt.Errorf("PlanetWithExplicitDefault should be a string, got %q", data)
+	}
+
+	var ok bool
+	*_p, ok = PlanetWithExplicitDefaultFromString(str)
+	if !ok {
+		return _enumer.NewParseError("PlanetWithExplicitDefault", str, PlanetWithExplicitDefaultStrings())
+	}
+	return nil
+}
+
+// MarshalGQL implements the graphql.Marshaler interface for PlanetWithExplicitDefault.
+func (_p PlanetWithExplicitDefault) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(_p.String()))
+}
+
+// UnmarshalGQL implements the graphql.Unmarshaler interface for PlanetWithExplicitDefault.
+func (_p *PlanetWithExplicitDefault) UnmarshalGQL(value interface{}) error {
+	var str string
+	switch v := value.(type) {
+	case nil:
+	case []byte:
+		str = string(v)
+	case string:
+		str = v
+	case fmt.Stringer:
+		str = v.String()
+	default:
+		return fmt.Errorf("invalid value of PlanetWithExplicitDefault: %[1]T(%[1]v)", value)
+	}
+
+	var ok bool
+	*_p, ok = PlanetWithExplicitDefaultFromString(str)
+	if !ok {
+		return _enumer.NewParseError("PlanetWithExplicitDefault", str, PlanetWithExplicitDefaultStrings())
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface for PlanetWithExplicitDefault.
+func (_p PlanetWithExplicitDefault) MarshalJSON() ([]byte, error) {
+	if err := _p.Validate(); err != nil {
+		return nil, fmt.Errorf("Cannot marshal value %q as PlanetWithExplicitDefault. %w", _p, err)
+	}
+	return json.Marshal(_p.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for PlanetWithExplicitDefault.
+func (_p *PlanetWithExplicitDefault) UnmarshalJSON(data []byte) error {
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return fmt.Errorf("PlanetWithExplicitDefault should be a string, got %q", data)
+	}
+
+	var ok bool
+	*_p, ok = PlanetWithExplicitDefaultFromString(str)
+	if !ok {
+		return _enumer.NewParseError("PlanetWithExplicitDefault", str, PlanetWithExplicitDefaultStrings())
+	}
+	return nil
+}
+
+// MarshalMsgpack implements the msgpack.Marshaler interface for PlanetWithExplicitDefault.
+func (_p PlanetWithExplicitDefault) MarshalMsgpack() ([]byte, error) {
+	if err := _p.Validate(); err != nil {
+		return nil, fmt.Errorf("Cannot marshal value %q as PlanetWithExplicitDefault. %w", _p, err)
+	}
+	return msgpack.Marshal(_p.String())
+}
+
+// UnmarshalMsgpack implements the msgpack.Unmarshaler interface for PlanetWithExplicitDefault.
+func (_p *PlanetWithExplicitDefault) UnmarshalMsgpack(data []byte) error {
+	var str string
+	if err := msgpack.Unmarshal(data, &str); err != nil {
+		return fmt.Errorf("PlanetWithExplicitDefault should be a string, got %q", data)
+	}
+
+	var ok bool
+	*_p, ok = PlanetWithExplicitDefaultFromString(str)
+	if !ok {
+		return _enumer.NewParseError("PlanetWithExplicitDefault", str, PlanetWithExplicitDefaultStrings())
 	}
 	return nil
 }
+
+// Value implements the sql/driver.Valuer interface for PlanetWithExplicitDefault.
+func (_p PlanetWithExplicitDefault) Value() (driver.Value, error) {
+	if err := _p.Validate(); err != nil {
+		return nil, fmt.Errorf("Cannot serialize value %q as PlanetWithExplicitDefault. %w", _p, err)
+	}
+	return _p.String(), nil
+}
+
+// Scan implements the sql/driver.Scanner interface for PlanetWithExplicitDefault.
+func (_p *PlanetWithExplicitDefault) Scan(value interface{}) error {
+	var str string
+	switch v := value.(type) {
+	case nil:
+	case []byte:
+		str = string(v)
+	case string:
+		str = v
+	case fmt.Stringer:
+		str = v.String()
+	default:
+		return fmt.Errorf("invalid value of PlanetWithExplicitDefault: %[1]T(%[1]v)", value)
+	}
+
+	var ok bool
+	*_p, ok = PlanetWithExplicitDefaultFromString(str)
+	if !ok {
+		return _enumer.NewParseError("PlanetWithExplicitDefault", str, PlanetWithExplicitDefaultStrings())
+	}
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface for PlanetWithExplicitDefault.
+func (_p PlanetWithExplicitDefault) MarshalText() ([]byte, error) {
+	return _p.AppendText(nil)
+}
+
+// AppendText implements the encoding.TextAppender interface for PlanetWithExplicitDefault.
+func (_p PlanetWithExplicitDefault) AppendText(b []byte) ([]byte, error) {
+	if err := _p.Validate(); err != nil {
+		return b, fmt.Errorf("Cannot marshal value %q as PlanetWithExplicitDefault. %w", _p, err)
+	}
+	return append(b, _p.String()...), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface for PlanetWithExplicitDefault.
+func (_p *PlanetWithExplicitDefault) UnmarshalText(text []byte) error {
+	str := string(text)
+
+	var ok bool
+	*_p, ok = PlanetWithExplicitDefaultFromString(str)
+	if !ok {
+		return _enumer.NewParseError("PlanetWithExplicitDefault", str, PlanetWithExplicitDefaultStrings())
+	}
+	return nil
+}
+
+// MarshalXML implements the xml.Marshaler interface for PlanetWithExplicitDefault.
+func (_p PlanetWithExplicitDefault) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if err := _p.Validate(); err != nil {
+		return fmt.Errorf("Cannot marshal value %q as PlanetWithExplicitDefault. %w", _p, err)
+	}
+	return e.EncodeElement(_p.String(), start)
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface for PlanetWithExplicitDefault.
+func (_p *PlanetWithExplicitDefault) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var str string
+	if err := d.DecodeElement(&str, &start); err != nil {
+		return err
+	}
+
+	var ok bool
+	*_p, ok = PlanetWithExplicitDefaultFromString(str)
+	if !ok {
+		return _enumer.NewParseError("PlanetWithExplicitDefault", str, PlanetWithExplicitDefaultStrings())
+	}
+	return nil
+}
+
+// MarshalXMLAttr implements the xml.MarshalerAttr interface for PlanetWithExplicitDefault.
+func (_p PlanetWithExplicitDefault) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	if err := _p.Validate(); err != nil {
+		return xml.Attr{}, fmt.Errorf("Cannot marshal value %q as PlanetWithExplicitDefault. %w", _p, err)
+	}
+	return xml.Attr{Name: name, Value: _p.String()}, nil
+}
+
+// UnmarshalXMLAttr implements the xml.UnmarshalerAttr interface for PlanetWithExplicitDefault.
+func (_p *PlanetWithExplicitDefault) UnmarshalXMLAttr(attr xml.Attr) error {
+	str := attr.Value
+
+	var ok bool
+	*_p, ok = PlanetWithExplicitDefaultFromString(str)
+	if !ok {
+		return _enumer.NewParseError("PlanetWithExplicitDefault", str, PlanetWithExplicitDefaultStrings())
+	}
+	return nil
+}
+
+// MarshalYAML implements a YAML Marshaler for PlanetWithExplicitDefault.
+func (_p PlanetWithExplicitDefault) MarshalYAML() (interface{}, error) {
+	if err := _p.Validate(); err != nil {
+		return nil, fmt.Errorf("Cannot marshal value %q as PlanetWithExplicitDefault. %w", _p, err)
+	}
+	return _p.String(), nil
+}
+
+// UnmarshalYAML implements a YAML Unmarshaler for PlanetWithExplicitDefault.
+func (_p *PlanetWithExplicitDefault) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var str string
+	if err := unmarshal(&str); err != nil {
+		return err
+	}
+
+	var ok bool
+	*_p, ok = PlanetWithExplicitDefaultFromString(str)
+	if !ok {
+		return _enumer.NewParseError("PlanetWithExplicitDefault", str, PlanetWithExplicitDefaultStrings())
+	}
+	return nil
+}
+
+var _PlanetWithExplicitDefaultCompletions = [8]string{
+	"Mercury",
+	"Venus",
+	"Earth",
+	"Mars",
+	"Jupiter",
+	"Saturn",
+	"Uranus",
+	"Neptune",
+}
+
+// PlanetWithExplicitDefaultUsage returns a usage hint of all String values of the enum, less the deprecated values,
+// e.g. for flag descriptions.
+func PlanetWithExplicitDefaultUsage() string {
+	return "one of: " + strings.Join(_PlanetWithExplicitDefaultStrings[:], "|")
+}
+
+// PlanetWithExplicitDefaultCompletions returns the completion candidates of the enum matching the given prefix,
+// less the deprecated values. Each candidate is formatted as "<value>" or "<value>\t<description>",
+// as expected by shell completions, e.g. of cobra's RegisterFlagCompletionFunc.
+func PlanetWithExplicitDefaultCompletions(toComplete string) []string {
+	out := make([]string, 0, len(_PlanetWithExplicitDefaultCompletions))
+	for _, c := range _PlanetWithExplicitDefaultCompletions {
+		if strings.HasPrefix(c, toComplete) {
+			out = append(out, c)
+		}
+	}
+	return out
+}
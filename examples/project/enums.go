@@ -15,7 +15,8 @@ const (
 // Timezone represents a set of 424 Timezones from TimeZoneDB.
 // Timezone has no default value, meaning it can only be deserialized from explicit values.
 // Note: The CSV has a 2-column layout.
-//go:enum -from=enums/timezones.csv
+// Note: Timezones are no legal GraphQL enum values, hence the serializers are configured explicitly.
+//go:enum -from=enums/timezones.csv -serializers=binary,json,sql,text,yaml
 type Timezone uint
 
 const (
@@ -33,3 +34,9 @@ type Currency uint
 // Note: The CSV has an 8-column layout. The columns 3-8 are added values.
 //go:enum -from=enums/countries.csv
 type CountryCode uint
+
+// Plan represents a set of subscription plans.
+// Note: The CSV has a reserved "deprecated" column to retire plans
+// and reserved "label(<language>)" columns with human-readable labels.
+//go:enum -from=enums/plans.csv
+type Plan uint
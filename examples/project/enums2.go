@@ -3,6 +3,14 @@ package project
 // Note: This file serves to ensure, that types from various files are identified.
 
 // AccountState represents various entity lifecycle states.
+//
+// Transitions:
+//
+//	Staged -> Provisioned
+//	Provisioned -> Activated, Deprovisioned
+//	Activated -> Deactivated
+//	Deactivated -> Activated, Deprovisioned
+//
 //go:enum -serializers=json,sql -transform=upper
 type AccountState uint
 
@@ -16,3 +24,36 @@ const (
 
 // NotAnEnum does not contain the magic comment and will therefore be ignored.
 type NotAnEnum uint
+
+// HTTPMethod represents a set of HTTP request methods.
+// Values are lower case by default, but can be set explicitly
+// via line comment.
+// It is registered in the enum registry for dynamic access.
+//
+//go:enum -transform=lower -serializers=flag,json,text -support=registry
+type HTTPMethod uint
+
+const (
+	// Requests a representation of the resource.
+	HTTPMethodGet HTTPMethod = iota + 1 // enum:"GET"
+	// Submits an entity to the resource.
+	HTTPMethodPost    // enum:"POST"
+	HTTPMethodOptions // just a comment
+	HTTPMethodPurge   // enum:"x-legacy-purge"
+)
+
+// PaymentMethod represents a set of accepted payment methods.
+// Deprecated payment methods can still be read, but are no longer offered.
+//
+//go:enum -transform=kebab -serializers=json,sql -support=slog,zap
+type PaymentMethod uint
+
+const (
+	PaymentMethodCreditCard PaymentMethod = iota + 1
+	// Deprecated: cheques are no longer accepted.
+	PaymentMethodCheque
+	// Deprecated: cheques are no longer accepted.
+	PaymentMethodCheck                      = PaymentMethodCheque
+	PaymentMethodBankTransfer PaymentMethod = iota
+	PaymentMethodDirectDebit                // Deprecated: replaced by bank transfers.
+)
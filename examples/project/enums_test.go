@@ -1,10 +1,27 @@
 package project
 
 import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"io"
+	"log/slog"
 	"testing"
 
+	"github.com/mvrahden/go-enumer/enum"
 	"github.com/mvrahden/go-enumer/pkg/utils"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+	"golang.org/x/text/language"
+)
+
+var (
+	// hint: interface assertions to ensure
+	// enums are compatible with the runtime package.
+	_ enum.Enum[HTTPMethod] = HTTPMethod(0)
+	_ enum.Enum[UserRole]   = UserRole(0)
+	_ enum.Enum[Timezone]   = Timezone(0)
+	_ enum.Enum[Plan]       = Plan(0)
 )
 
 func TestEnums(t *testing.T) {
@@ -16,7 +33,7 @@ func TestEnums(t *testing.T) {
 			})
 		})
 		t.Run("Missing Serializers", func(t *testing.T) {
-			utils.AssertMissingSerializationInterfacesFor[AccountState](t, []string{"binary", "graphql", "text", "yaml", "yaml.v3"})
+			utils.AssertMissingSerializationInterfacesFor[AccountState](t, []string{"binary", "flag", "graphql", "text", "yaml", "yaml.v3"})
 		})
 		t.Run("Serialization", func(t *testing.T) {
 			cfg := utils.TestConfig{HasDefault: true}
@@ -38,6 +55,29 @@ func TestEnums(t *testing.T) {
 				utils.AssertSerializationInterfacesFor[AccountState](t, idx, tC, cfg, serializers)
 			}
 		})
+		t.Run("Transitions", func(t *testing.T) {
+			require.Equal(t, []AccountState{AccountStateActivated, AccountStateDeprovisioned}, AccountStateProvisioned.Transitions())
+			require.True(t, AccountStateStaged.CanTransitionTo(AccountStateProvisioned))
+			require.True(t, AccountStateDeactivated.CanTransitionTo(AccountStateActivated))
+			require.False(t, AccountStateStaged.CanTransitionTo(AccountStateActivated))
+			require.False(t, AccountStateDeprovisioned.CanTransitionTo(AccountStateStaged))
+			require.True(t, AccountStateDeprovisioned.IsTerminal())
+			require.False(t, AccountStateActivated.IsTerminal())
+			require.Equal(t, "stateDiagram-v2\n"+
+				"\tstate \"STAGED\" as s0\n"+
+				"\tstate \"PROVISIONED\" as s1\n"+
+				"\tstate \"ACTIVATED\" as s2\n"+
+				"\tstate \"DEACTIVATED\" as s3\n"+
+				"\tstate \"DEPROVISIONED\" as s4\n"+
+				"\t[*] --> s0\n"+
+				"\ts0 --> s1\n"+
+				"\ts1 --> s2\n"+
+				"\ts1 --> s4\n"+
+				"\ts2 --> s3\n"+
+				"\ts3 --> s2\n"+
+				"\ts3 --> s4\n"+
+				"\ts4 --> [*]\n", AccountStateTransitionsMermaid())
+		})
 	})
 	t.Run("CountryCode", func(t *testing.T) {
 		t.Run("Value Sets", func(t *testing.T) {
@@ -108,6 +148,238 @@ func TestEnums(t *testing.T) {
 			}
 		})
 	})
+	t.Run("HTTPMethod", func(t *testing.T) {
+		t.Run("Value Sets", func(t *testing.T) {
+			t.Run("return copies", func(t *testing.T) {
+				utils.AssertNotSamePointer(t, _HTTPMethodStrings, HTTPMethodStrings())
+				utils.AssertNotSamePointer(t, _HTTPMethodValues, HTTPMethodValues())
+			})
+			t.Run("custom values bypass transformation", func(t *testing.T) {
+				require.Equal(t, []string{"GET", "POST", "options", "x-legacy-purge"}, HTTPMethodStrings())
+			})
+		})
+		t.Run("Registry", func(t *testing.T) {
+			typ, ok := enum.Lookup("github.com/mvrahden/go-enumer/examples/project.HTTPMethod")
+			require.True(t, ok)
+			require.Equal(t, "github.com/mvrahden/go-enumer/examples/project.HTTPMethod", typ.Name())
+			require.Equal(t, HTTPMethodStrings(), typ.Strings())
+
+			v, err := typ.Parse("POST")
+			require.NoError(t, err)
+			require.Equal(t, HTTPMethodPost, v)
+
+			_, err = typ.Parse("post")
+			require.ErrorIs(t, err, enum.ErrNoValidEnum)
+			require.ErrorIs(t, err, ErrNoValidEnum)
+
+			_, ok = enum.Lookup("github.com/mvrahden/go-enumer/examples/project.UserRole")
+			require.False(t, ok, "must only register enums supporting the registry")
+		})
+		t.Run("Parse errors", func(t *testing.T) {
+			var v HTTPMethod
+			err := json.Unmarshal([]byte(`"PSOT"`), &v)
+			require.ErrorIs(t, err, ErrNoValidEnum)
+			require.EqualError(t, err, "Value \"PSOT\" does not represent a HTTPMethod (did you mean \"POST\"?)")
+
+			var perr *enum.ParseError
+			require.ErrorAs(t, err, &perr)
+			require.Equal(t, "HTTPMethod", perr.Enum)
+			require.Equal(t, "PSOT", perr.Input)
+			require.Equal(t, HTTPMethodStrings(), perr.Valid)
+			require.Equal(t, []string{"POST"}, perr.Suggestions)
+		})
+		t.Run("Serialization", func(t *testing.T) {
+			cfg := utils.TestConfig{}
+			toPtr := utils.ToPointer[HTTPMethod]
+			testCases := []utils.TestCase{
+				{From: "", Enum: toPtr(0), Expected: utils.Expected{AsSerialized: "HTTPMethod(0)", IsInvalid: true}},
+				{From: "get", Enum: toPtr(0), Expected: utils.Expected{AsSerialized: "HTTPMethod(0)", IsInvalid: true}},
+				{From: "GET", Enum: toPtr(HTTPMethodGet), Expected: utils.Expected{AsSerialized: "GET"}},
+				{From: "POST", Enum: toPtr(HTTPMethodPost), Expected: utils.Expected{AsSerialized: "POST"}},
+				{From: "options", Enum: toPtr(HTTPMethodOptions), Expected: utils.Expected{AsSerialized: "options"}},
+				{From: "x-legacy-purge", Enum: toPtr(HTTPMethodPurge), Expected: utils.Expected{AsSerialized: "x-legacy-purge"}},
+			}
+			for idx, tC := range testCases {
+				serializers := []string{"flag", "json", "text"}
+				utils.AssertSerializationInterfacesFor[HTTPMethod](t, idx, tC, cfg, serializers)
+			}
+		})
+		t.Run("Flags", func(t *testing.T) {
+			v := HTTPMethodGet
+			fs := flag.NewFlagSet("test", flag.ContinueOnError)
+			fs.SetOutput(io.Discard)
+			fs.Var(&v, "method", "the HTTP method")
+			require.NoError(t, fs.Parse([]string{"-method=POST"}))
+			require.Equal(t, HTTPMethodPost, v)
+
+			err := fs.Parse([]string{"-method=PSOT"})
+			require.ErrorContains(t, err, "Value \"PSOT\" does not represent a HTTPMethod (did you mean \"POST\"?) (allowed values: GET, POST, options, x-legacy-purge)")
+			require.ErrorIs(t, v.Set("PSOT"), ErrNoValidEnum)
+			require.Equal(t, "HTTPMethod", v.Type())
+		})
+		t.Run("Completions", func(t *testing.T) {
+			require.Equal(t, "one of: GET|POST|options|x-legacy-purge", HTTPMethodUsage())
+			require.Equal(t, []string{
+				"GET\tRequests a representation of the resource.",
+				"POST\tSubmits an entity to the resource.",
+				"options",
+				"x-legacy-purge",
+			}, HTTPMethodCompletions(""))
+			require.Equal(t, []string{"POST\tSubmits an entity to the resource."}, HTTPMethodCompletions("PO"))
+			require.Empty(t, HTTPMethodCompletions("DELETE"))
+		})
+	})
+	t.Run("PaymentMethod", func(t *testing.T) {
+		t.Run("Logging", func(t *testing.T) {
+			t.Run("slog", func(t *testing.T) {
+				buf := bytes.NewBuffer(nil)
+				logger := slog.New(slog.NewJSONHandler(buf, &slog.HandlerOptions{
+					ReplaceAttr: func(_ []string, a slog.Attr) slog.Attr {
+						if a.Key == slog.TimeKey {
+							return slog.Attr{} // hint: omit time for stable output
+						}
+						return a
+					},
+				}))
+				logger.Info("paid", "method", PaymentMethodBankTransfer, "invalid", PaymentMethod(42))
+				require.JSONEq(t, `{
+					"level": "INFO",
+					"msg": "paid",
+					"method": {"value": "bank-transfer", "id": 3, "valid": true},
+					"invalid": {"value": "PaymentMethod(42)", "id": 42, "valid": false}
+				}`, buf.String())
+			})
+			t.Run("zap", func(t *testing.T) {
+				enc := zapcore.NewMapObjectEncoder()
+				require.NoError(t, PaymentMethodDirectDebit.MarshalLogObject(enc))
+				require.Equal(t, map[string]any{"value": "direct-debit", "id": uint64(4), "valid": true}, enc.Fields)
+
+				enc = zapcore.NewMapObjectEncoder()
+				require.NoError(t, PaymentMethod(0).MarshalLogObject(enc))
+				require.Equal(t, map[string]any{"value": "PaymentMethod(0)", "id": uint64(0), "valid": false}, enc.Fields)
+			})
+		})
+		t.Run("Value Sets", func(t *testing.T) {
+			t.Run("return copies", func(t *testing.T) {
+				utils.AssertNotSamePointer(t, _PaymentMethodActiveStrings, PaymentMethodActiveStrings())
+				utils.AssertNotSamePointer(t, _PaymentMethodActiveValues, PaymentMethodActiveValues())
+			})
+			t.Run("exclude deprecated values from active values", func(t *testing.T) {
+				require.Equal(t, []PaymentMethod{PaymentMethodCreditCard, PaymentMethodCheque, PaymentMethodBankTransfer, PaymentMethodDirectDebit}, PaymentMethodValues())
+				require.Equal(t, []PaymentMethod{PaymentMethodCreditCard, PaymentMethodBankTransfer}, PaymentMethodActiveValues())
+				require.Equal(t, []string{"credit-card", "bank-transfer"}, PaymentMethodActiveStrings())
+			})
+		})
+		t.Run("Deprecation", func(t *testing.T) {
+			require.False(t, PaymentMethodCreditCard.IsDeprecated())
+			require.True(t, PaymentMethodCheque.IsDeprecated())
+			require.False(t, PaymentMethodBankTransfer.IsDeprecated())
+			require.True(t, PaymentMethodDirectDebit.IsDeprecated())
+			require.True(t, PaymentMethodCheck.IsDeprecated())
+			t.Run("calls hook upon lookup of deprecated values", func(t *testing.T) {
+				var decoded []PaymentMethod
+				PaymentMethodOnDeprecated = func(v PaymentMethod) { decoded = append(decoded, v) }
+				t.Cleanup(func() { PaymentMethodOnDeprecated = nil })
+
+				var v PaymentMethod
+				require.NoError(t, v.UnmarshalJSON([]byte(`"credit-card"`)))
+				require.NoError(t, v.UnmarshalJSON([]byte(`"cheque"`)))
+				require.NoError(t, v.UnmarshalJSON([]byte(`"check"`)))
+				require.NoError(t, v.Scan("direct-debit"))
+				require.Equal(t, []PaymentMethod{PaymentMethodCheque, PaymentMethodCheck, PaymentMethodDirectDebit}, decoded)
+			})
+		})
+		t.Run("Serialization", func(t *testing.T) {
+			cfg := utils.TestConfig{}
+			toPtr := utils.ToPointer[PaymentMethod]
+			testCases := []utils.TestCase{
+				{From: "", Enum: toPtr(0), Expected: utils.Expected{AsSerialized: "PaymentMethod(0)", IsInvalid: true}},
+				{From: "credit-card", Enum: toPtr(PaymentMethodCreditCard), Expected: utils.Expected{AsSerialized: "credit-card"}},
+				{From: "cheque", Enum: toPtr(PaymentMethodCheque), Expected: utils.Expected{AsSerialized: "cheque"}},
+				{From: "bank-transfer", Enum: toPtr(PaymentMethodBankTransfer), Expected: utils.Expected{AsSerialized: "bank-transfer"}},
+				{From: "direct-debit", Enum: toPtr(PaymentMethodDirectDebit), Expected: utils.Expected{AsSerialized: "direct-debit"}},
+			}
+			for idx, tC := range testCases {
+				serializers := []string{"json", "sql"}
+				utils.AssertSerializationInterfacesFor[PaymentMethod](t, idx, tC, cfg, serializers)
+			}
+		})
+	})
+	t.Run("Plan", func(t *testing.T) {
+		t.Run("Value Sets", func(t *testing.T) {
+			t.Run("exclude deprecated values from active values", func(t *testing.T) {
+				require.Equal(t, []string{"Free", "Starter", "Team", "Business", "Legacy"}, PlanStrings())
+				require.Equal(t, []string{"Free", "Team", "Business"}, PlanActiveStrings())
+				require.Equal(t, []Plan{1, 3, 4}, PlanActiveValues())
+			})
+		})
+		t.Run("Completions", func(t *testing.T) {
+			// hint: the helpers are generated without the "flag" serializer
+			require.Equal(t, "one of: Free|Team|Business", PlanUsage())
+			require.Equal(t, []string{"Free", "Team", "Business"}, PlanCompletions(""))
+			require.Empty(t, PlanCompletions("Legacy"))
+		})
+		t.Run("Ordering", func(t *testing.T) {
+			// hint: the order column ranks "Legacy" between "Starter" and "Team"
+			require.Equal(t, []int{0, 1, 3, 4, 2}, []int{Plan(1).Index(), Plan(2).Index(), Plan(3).Index(), Plan(4).Index(), Plan(5).Index()})
+			require.True(t, Plan(5).Less(Plan(3)))
+			require.Equal(t, +1, Plan(3).Compare(Plan(5)))
+			require.Equal(t, -1, Plan(6).Index())
+
+			next, ok := Plan(2).Next()
+			require.True(t, ok)
+			require.Equal(t, Plan(5), next)
+			next, ok = Plan(5).Next()
+			require.True(t, ok)
+			require.Equal(t, Plan(3), next)
+			_, ok = Plan(4).Next()
+			require.False(t, ok)
+			prev, ok := Plan(3).Prev()
+			require.True(t, ok)
+			require.Equal(t, Plan(5), prev)
+			_, ok = Plan(1).Prev()
+			require.False(t, ok)
+		})
+		t.Run("Deprecation", func(t *testing.T) {
+			for _, v := range PlanValues() {
+				require.Equal(t, v.String() == "Starter" || v.String() == "Legacy", v.IsDeprecated(), v.String())
+			}
+		})
+		t.Run("Additional Data", func(t *testing.T) {
+			require.Equal(t, uint16(3), Plan(2).GetSeats())
+			require.Equal(t, uint16(50), Plan(4).GetSeats())
+		})
+		t.Run("Labels", func(t *testing.T) {
+			require.Equal(t, []language.Tag{language.English, language.German}, PlanLabelLanguages())
+			require.Equal(t, "Legacy plan", Plan(5).Label(language.English))
+			require.Equal(t, "Altvertrag", Plan(5).Label(language.German))
+			t.Run("falls back to closest language", func(t *testing.T) {
+				require.Equal(t, "Einsteiger", Plan(2).Label(language.MustParse("de-AT")))
+				require.Equal(t, "Starter", Plan(2).Label(language.BritishEnglish))
+			})
+			t.Run("falls back to first language", func(t *testing.T) {
+				require.Equal(t, "Free", Plan(1).Label(language.Japanese))
+				require.Equal(t, "Business", Plan(4).Label(language.German), "label is missing")
+			})
+			t.Run("falls back to String value", func(t *testing.T) {
+				require.Equal(t, "Plan(0)", Plan(0).Label(language.English))
+			})
+		})
+		t.Run("Serialization", func(t *testing.T) {
+			cfg := utils.TestConfig{}
+			toPtr := utils.ToPointer[Plan]
+			testCases := []utils.TestCase{
+				{From: "", Enum: toPtr(0), Expected: utils.Expected{AsSerialized: "Plan(0)", IsInvalid: true}},
+				{From: "Free", Enum: toPtr(1), Expected: utils.Expected{AsSerialized: "Free"}},
+				{From: "Starter", Enum: toPtr(2), Expected: utils.Expected{AsSerialized: "Starter"}},
+				{From: "Legacy", Enum: toPtr(5), Expected: utils.Expected{AsSerialized: "Legacy"}},
+			}
+			for idx, tC := range testCases {
+				serializers := []string{"binary", "graphql", "json", "sql", "text", "yaml"}
+				utils.AssertSerializationInterfacesFor[Plan](t, idx, tC, cfg, serializers)
+			}
+		})
+	})
 	t.Run("Timezone", func(t *testing.T) {
 		t.Run("Value Sets", func(t *testing.T) {
 			t.Run("return copies", func(t *testing.T) {
@@ -128,10 +400,13 @@ func TestEnums(t *testing.T) {
 				{From: "Europe/Mariehamn", Enum: toPtr(424), Expected: utils.Expected{AsSerialized: "Europe/Mariehamn"}},
 			}
 			for idx, tC := range testCases {
-				serializers := []string{"binary", "graphql", "json", "sql", "text", "yaml"}
+				serializers := []string{"binary", "json", "sql", "text", "yaml"}
 				utils.AssertSerializationInterfacesFor[Timezone](t, idx, tC, cfg, serializers)
 			}
 		})
+		t.Run("Missing Serializers", func(t *testing.T) {
+			utils.AssertMissingSerializationInterfacesFor[Timezone](t, []string{"graphql"})
+		})
 	})
 	t.Run("UserRole", func(t *testing.T) {
 		t.Run("Value Sets", func(t *testing.T) {
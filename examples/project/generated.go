@@ -5,19 +5,22 @@ package project
 import (
 	"database/sql/driver"
 	"encoding/json"
-	"errors"
 	"fmt"
+	_enumer "github.com/mvrahden/go-enumer/enum"
+	"go.uber.org/zap/zapcore"
+	"golang.org/x/text/language"
 	"io"
+	"log/slog"
 	"strconv"
+	"strings"
 )
 
 var (
-	ErrNoValidEnum = errors.New("not a valid enum")
+	ErrNoValidEnum = _enumer.ErrNoValidEnum
 )
 
 const (
-	_AccountStateString      = "STAGEDPROVISIONEDACTIVATEDDEACTIVATEDDEPROVISIONED"
-	_AccountStateLowerString = "stagedprovisionedactivateddeactivateddeprovisioned"
+	_AccountStateString = "STAGEDPROVISIONEDACTIVATEDDEACTIVATEDDEPROVISIONED"
 )
 
 var (
@@ -49,6 +52,11 @@ func AccountStateStrings() []string {
 	return cp[:]
 }
 
+// Values returns all values of the enum.
+func (AccountState) Values() []AccountState {
+	return AccountStateValues()
+}
+
 // IsValid tests whether the value is a valid enum value.
 func (_a AccountState) IsValid() bool {
 	return _a >= 0 && _a <= 4
@@ -73,6 +81,54 @@ func (_a AccountState) String() string {
 	return _AccountStateStrings[idx]
 }
 
+// Index returns the position of the value within the ordered values of the enum
+// (ignoring alternative values) or -1 if the value is invalid.
+func (_a AccountState) Index() int {
+	if !_a.IsValid() {
+		return -1
+	}
+	idx := int(_a)
+	return idx
+}
+
+// Compare returns -1, 0 or +1 depending on whether the value orders before,
+// equal to or after the other value. Invalid values order before all valid values.
+func (_a AccountState) Compare(other AccountState) int {
+	a, b := _a.Index(), other.Index()
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return +1
+	}
+	return 0
+}
+
+// Less tests whether the value orders before the other value.
+func (_a AccountState) Less(other AccountState) bool {
+	return _a.Compare(other) < 0
+}
+
+// Next returns the succeeding value in order of the enum.
+// It returns false if the value is invalid or the last value.
+func (_a AccountState) Next() (AccountState, bool) {
+	idx := _a.Index()
+	if idx == -1 || idx+1 == len(_AccountStateValues) {
+		return AccountState(0), false
+	}
+	return _AccountStateValues[idx+1], true
+}
+
+// Prev returns the preceding value in order of the enum.
+// It returns false if the value is invalid or the first value.
+func (_a AccountState) Prev() (AccountState, bool) {
+	idx := _a.Index()
+	if idx < 1 {
+		return AccountState(0), false
+	}
+	return _AccountStateValues[idx-1], true
+}
+
 var (
 	_AccountStateStringToValueMap = map[string]AccountState{
 		_AccountStateString[0:6]:   AccountStateStaged,
@@ -81,15 +137,20 @@ var (
 		_AccountStateString[26:37]: AccountStateDeactivated,
 		_AccountStateString[37:50]: AccountStateDeprovisioned,
 	}
-	_AccountStateLowerStringToValueMap = map[string]AccountState{
-		_AccountStateLowerString[0:6]:   AccountStateStaged,
-		_AccountStateLowerString[6:17]:  AccountStateProvisioned,
-		_AccountStateLowerString[17:26]: AccountStateActivated,
-		_AccountStateLowerString[26:37]: AccountStateDeactivated,
-		_AccountStateLowerString[37:50]: AccountStateDeprovisioned,
-	}
 )
 
+// _AccountStateLookupFold determines the enum value of the string regardless of its case
+// by Unicode simple folding. It scans the String values, as a fold table is only generated
+// for enums with the "ignore-case" feature.
+func _AccountStateLookupFold(raw string) (AccountState, bool) {
+	for idx := range _AccountStateStrings {
+		if strings.EqualFold(_AccountStateStrings[idx], raw) {
+			return _AccountStateValues[idx], true
+		}
+	}
+	return AccountState(0), false
+}
+
 // AccountStateFromString determines the enum value with an exact case match.
 func AccountStateFromString(raw string) (AccountState, bool) {
 	v, ok := _AccountStateStringToValueMap[raw]
@@ -99,13 +160,14 @@ func AccountStateFromString(raw string) (AccountState, bool) {
 	return v, true
 }
 
-// AccountStateFromStringIgnoreCase determines the enum value with a case-insensitive match.
+// AccountStateFromStringIgnoreCase determines the enum value with a case-insensitive match
+// by Unicode simple folding, regardless of the casing of raw.
 func AccountStateFromStringIgnoreCase(raw string) (AccountState, bool) {
 	v, ok := AccountStateFromString(raw)
 	if ok {
 		return v, ok
 	}
-	v, ok = _AccountStateLowerStringToValueMap[raw]
+	v, ok = _AccountStateLookupFold(raw)
 	if !ok {
 		return AccountState(0), false
 	}
@@ -133,7 +195,7 @@ func (_a *AccountState) UnmarshalJSON(data []byte) error {
 	var ok bool
 	*_a, ok = AccountStateFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a AccountState", str)
+		return _enumer.NewParseError("AccountState", str, AccountStateStrings())
 	}
 	return nil
 }
@@ -166,14 +228,89 @@ func (_a *AccountState) Scan(value interface{}) error {
 	var ok bool
 	*_a, ok = AccountStateFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a AccountState", str)
+		return _enumer.NewParseError("AccountState", str, AccountStateStrings())
+	}
+	return nil
+}
+
+var _AccountStateCompletions = [5]string{
+	"STAGED",
+	"PROVISIONED",
+	"ACTIVATED",
+	"DEACTIVATED",
+	"DEPROVISIONED",
+}
+
+// AccountStateUsage returns a usage hint of all String values of the enum, less the deprecated values,
+// e.g. for flag descriptions.
+func AccountStateUsage() string {
+	return "one of: " + strings.Join(_AccountStateStrings[:], "|")
+}
+
+// AccountStateCompletions returns the completion candidates of the enum matching the given prefix,
+// less the deprecated values. Each candidate is formatted as "<value>" or "<value>\t<description>",
+// as expected by shell completions, e.g. of cobra's RegisterFlagCompletionFunc.
+func AccountStateCompletions(toComplete string) []string {
+	out := make([]string, 0, len(_AccountStateCompletions))
+	for _, c := range _AccountStateCompletions {
+		if strings.HasPrefix(c, toComplete) {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+// CanTransitionTo tests whether the enum can transition to the next value.
+func (_a AccountState) CanTransitionTo(next AccountState) bool {
+	switch _a {
+	case AccountStateStaged:
+		return next == AccountStateProvisioned
+	case AccountStateProvisioned:
+		return next == AccountStateActivated || next == AccountStateDeprovisioned
+	case AccountStateActivated:
+		return next == AccountStateDeactivated
+	case AccountStateDeactivated:
+		return next == AccountStateActivated || next == AccountStateDeprovisioned
+	}
+	return false
+}
+
+// Transitions returns all values the enum can transition to.
+func (_a AccountState) Transitions() []AccountState {
+	switch _a {
+	case AccountStateStaged:
+		return []AccountState{AccountStateProvisioned}
+	case AccountStateProvisioned:
+		return []AccountState{AccountStateActivated, AccountStateDeprovisioned}
+	case AccountStateActivated:
+		return []AccountState{AccountStateDeactivated}
+	case AccountStateDeactivated:
+		return []AccountState{AccountStateActivated, AccountStateDeprovisioned}
 	}
 	return nil
 }
 
+// IsTerminal tests whether the enum is a valid value which cannot transition to any other value.
+func (_a AccountState) IsTerminal() bool {
+	switch _a {
+	case AccountStateStaged, AccountStateProvisioned, AccountStateActivated, AccountStateDeactivated:
+		return false
+	}
+	return _a.IsValid()
+}
+
+// AccountStateTransitionsDOT returns the state machine of the enum as Graphviz DOT graph.
+func AccountStateTransitionsDOT() string {
+	return "digraph AccountState {\n\t\"STAGED\" [shape=doublecircle];\n\t\"STAGED\" -> \"PROVISIONED\";\n\t\"PROVISIONED\" -> \"ACTIVATED\";\n\t\"PROVISIONED\" -> \"DEPROVISIONED\";\n\t\"ACTIVATED\" -> \"DEACTIVATED\";\n\t\"DEACTIVATED\" -> \"ACTIVATED\";\n\t\"DEACTIVATED\" -> \"DEPROVISIONED\";\n}\n"
+}
+
+// AccountStateTransitionsMermaid returns the state machine of the enum as Mermaid state diagram.
+func AccountStateTransitionsMermaid() string {
+	return "stateDiagram-v2\n\tstate \"STAGED\" as s0\n\tstate \"PROVISIONED\" as s1\n\tstate \"ACTIVATED\" as s2\n\tstate \"DEACTIVATED\" as s3\n\tstate \"DEPROVISIONED\" as s4\n\t[*] --> s0\n\ts0 --> s1\n\ts1 --> s2\n\ts1 --> s4\n\ts2 --> s3\n\ts3 --> s2\n\ts3 --> s4\n\ts4 --> [*]\n"
+}
+
 const (
-	_CountryCodeString      = "AFGALBDZAASMANDAGOAIAATAATGARGARMABWAUSAUTAZEBHSBHRBGDBRBBLRBELBLZBENBMUBTNBOLBIHBWABRAIOTVGBBRNBGRBFABDIKHMCMRCANCPVCYMCAFTCDCHLCHNCXRCCKCOLCOMCOKCRIHRVCUBCUWCYPCZECODDNKDJIDMADOMTLSECUEGYSLVGNQERIESTETHFLKFROFJIFINFRAPYFGABGMBGEODEUGHAGIBGRCGRLGRDGUMGTMGGYGINGNBGUYHTIHNDHKGHUNISLINDIDNIRNIRQIRLIMNISRITACIVJAMJPNJEYJORKAZKENKIRXKXKWTKGZLAOLVALBNLSOLBRLBYLIELTULUXMACMKDMDGMWIMYSMDVMLIMLTMHLMRTMUSMYTMEXFSMMDAMCOMNGMNEMSRMARMOZMMRNAMNRUNPLNLDANTNCLNZLNICNERNGANIUPRKMNPNOROMNPAKPLWPSEPANPNGPRYPERPHLPCNPOLPRTPRIQATCOGREUROURUSRWABLMSHNKNALCAMAFSPMVCTWSMSMRSTPSAUSENSRBSYCSLESGPSXMSVKSVNSLBSOMZAFKORSSDESPLKASDNSURSJMSWZSWECHESYRTWNTJKTZATHATGOTKLTONTTOTUNTURTKMTCATUVVIRUGAUKRAREGBRUSAURYUZBVUTVATVENVNMWLFESHYEMZMBZWE"
-	_CountryCodeLowerString = "afgalbdzaasmandagoaiaataatgargarmabwausautazebhsbhrbgdbrbblrbelblzbenbmubtnbolbihbwabraiotvgbbrnbgrbfabdikhmcmrcancpvcymcaftcdchlchncxrcckcolcomcokcrihrvcubcuwcypczecoddnkdjidmadomtlsecuegyslvgnqeriestethflkfrofjifinfrapyfgabgmbgeodeughagibgrcgrlgrdgumgtmggygingnbguyhtihndhkghunislindidnirnirqirlimnisritacivjamjpnjeyjorkazkenkirxkxkwtkgzlaolvalbnlsolbrlbylieltuluxmacmkdmdgmwimysmdvmlimltmhlmrtmusmytmexfsmmdamcomngmnemsrmarmozmmrnamnrunplnldantnclnzlnicnernganiuprkmnpnoromnpakplwpsepanpngpryperphlpcnpolprtpriqatcogreurourusrwablmshnknalcamafspmvctwsmsmrstpsausensrbsycslesgpsxmsvksvnslbsomzafkorssdesplkasdnsursjmswzswechesyrtwntjktzathatgotkltonttotunturtkmtcatuvvirugaukraregbrusauryuzbvutvatvenvnmwlfeshyemzmbzwe"
+	_CountryCodeString = "AFGALBDZAASMANDAGOAIAATAATGARGARMABWAUSAUTAZEBHSBHRBGDBRBBLRBELBLZBENBMUBTNBOLBIHBWABRAIOTVGBBRNBGRBFABDIKHMCMRCANCPVCYMCAFTCDCHLCHNCXRCCKCOLCOMCOKCRIHRVCUBCUWCYPCZECODDNKDJIDMADOMTLSECUEGYSLVGNQERIESTETHFLKFROFJIFINFRAPYFGABGMBGEODEUGHAGIBGRCGRLGRDGUMGTMGGYGINGNBGUYHTIHNDHKGHUNISLINDIDNIRNIRQIRLIMNISRITACIVJAMJPNJEYJORKAZKENKIRXKXKWTKGZLAOLVALBNLSOLBRLBYLIELTULUXMACMKDMDGMWIMYSMDVMLIMLTMHLMRTMUSMYTMEXFSMMDAMCOMNGMNEMSRMARMOZMMRNAMNRUNPLNLDANTNCLNZLNICNERNGANIUPRKMNPNOROMNPAKPLWPSEPANPNGPRYPERPHLPCNPOLPRTPRIQATCOGREUROURUSRWABLMSHNKNALCAMAFSPMVCTWSMSMRSTPSAUSENSRBSYCSLESGPSXMSVKSVNSLBSOMZAFKORSSDESPLKASDNSURSJMSWZSWECHESYRTWNTJKTZATHATGOTKLTONTTOTUNTURTKMTCATUVVIRUGAUKRAREGBRUSAURYUZBVUTVATVENVNMWLFESHYEMZMBZWE"
 )
 
 var (
@@ -442,6 +579,11 @@ func CountryCodeStrings() []string {
 	return cp[:]
 }
 
+// Values returns all values of the enum.
+func (CountryCode) Values() []CountryCode {
+	return CountryCodeValues()
+}
+
 // IsValid tests whether the value is a valid enum value.
 func (_c CountryCode) IsValid() bool {
 	return _c >= 1 && _c <= 240
@@ -466,6 +608,54 @@ func (_c CountryCode) String() string {
 	return _CountryCodeStrings[idx]
 }
 
+// Index returns the position of the value within the ordered values of the enum
+// (ignoring alternative values) or -1 if the value is invalid.
+func (_c CountryCode) Index() int {
+	if !_c.IsValid() {
+		return -1
+	}
+	idx := int(_c) - 1
+	return idx
+}
+
+// Compare returns -1, 0 or +1 depending on whether the value orders before,
+// equal to or after the other value. Invalid values order before all valid values.
+func (_c CountryCode) Compare(other CountryCode) int {
+	a, b := _c.Index(), other.Index()
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return +1
+	}
+	return 0
+}
+
+// Less tests whether the value orders before the other value.
+func (_c CountryCode) Less(other CountryCode) bool {
+	return _c.Compare(other) < 0
+}
+
+// Next returns the succeeding value in order of the enum.
+// It returns false if the value is invalid or the last value.
+func (_c CountryCode) Next() (CountryCode, bool) {
+	idx := _c.Index()
+	if idx == -1 || idx+1 == len(_CountryCodeValues) {
+		return CountryCode(0), false
+	}
+	return _CountryCodeValues[idx+1], true
+}
+
+// Prev returns the preceding value in order of the enum.
+// It returns false if the value is invalid or the first value.
+func (_c CountryCode) Prev() (CountryCode, bool) {
+	idx := _c.Index()
+	if idx < 1 {
+		return CountryCode(0), false
+	}
+	return _CountryCodeValues[idx-1], true
+}
+
 // GetCountryName returns the "country-name" of the enum value.
 func (_c CountryCode) GetCountryName() string {
 	if !_c.IsValid() {
@@ -769,250 +959,20 @@ var (
 		_CountryCodeString[714:717]: 239,
 		_CountryCodeString[717:720]: 240,
 	}
-	_CountryCodeLowerStringToValueMap = map[string]CountryCode{
-		_CountryCodeLowerString[0:3]:     1,
-		_CountryCodeLowerString[3:6]:     2,
-		_CountryCodeLowerString[6:9]:     3,
-		_CountryCodeLowerString[9:12]:    4,
-		_CountryCodeLowerString[12:15]:   5,
-		_CountryCodeLowerString[15:18]:   6,
-		_CountryCodeLowerString[18:21]:   7,
-		_CountryCodeLowerString[21:24]:   8,
-		_CountryCodeLowerString[24:27]:   9,
-		_CountryCodeLowerString[27:30]:   10,
-		_CountryCodeLowerString[30:33]:   11,
-		_CountryCodeLowerString[33:36]:   12,
-		_CountryCodeLowerString[36:39]:   13,
-		_CountryCodeLowerString[39:42]:   14,
-		_CountryCodeLowerString[42:45]:   15,
-		_CountryCodeLowerString[45:48]:   16,
-		_CountryCodeLowerString[48:51]:   17,
-		_CountryCodeLowerString[51:54]:   18,
-		_CountryCodeLowerString[54:57]:   19,
-		_CountryCodeLowerString[57:60]:   20,
-		_CountryCodeLowerString[60:63]:   21,
-		_CountryCodeLowerString[63:66]:   22,
-		_CountryCodeLowerString[66:69]:   23,
-		_CountryCodeLowerString[69:72]:   24,
-		_CountryCodeLowerString[72:75]:   25,
-		_CountryCodeLowerString[75:78]:   26,
-		_CountryCodeLowerString[78:81]:   27,
-		_CountryCodeLowerString[81:84]:   28,
-		_CountryCodeLowerString[84:87]:   29,
-		_CountryCodeLowerString[87:90]:   30,
-		_CountryCodeLowerString[90:93]:   31,
-		_CountryCodeLowerString[93:96]:   32,
-		_CountryCodeLowerString[96:99]:   33,
-		_CountryCodeLowerString[99:102]:  34,
-		_CountryCodeLowerString[102:105]: 35,
-		_CountryCodeLowerString[105:108]: 36,
-		_CountryCodeLowerString[108:111]: 37,
-		_CountryCodeLowerString[111:114]: 38,
-		_CountryCodeLowerString[114:117]: 39,
-		_CountryCodeLowerString[117:120]: 40,
-		_CountryCodeLowerString[120:123]: 41,
-		_CountryCodeLowerString[123:126]: 42,
-		_CountryCodeLowerString[126:129]: 43,
-		_CountryCodeLowerString[129:132]: 44,
-		_CountryCodeLowerString[132:135]: 45,
-		_CountryCodeLowerString[135:138]: 46,
-		_CountryCodeLowerString[138:141]: 47,
-		_CountryCodeLowerString[141:144]: 48,
-		_CountryCodeLowerString[144:147]: 49,
-		_CountryCodeLowerString[147:150]: 50,
-		_CountryCodeLowerString[150:153]: 51,
-		_CountryCodeLowerString[153:156]: 52,
-		_CountryCodeLowerString[156:159]: 53,
-		_CountryCodeLowerString[159:162]: 54,
-		_CountryCodeLowerString[162:165]: 55,
-		_CountryCodeLowerString[165:168]: 56,
-		_CountryCodeLowerString[168:171]: 57,
-		_CountryCodeLowerString[171:174]: 58,
-		_CountryCodeLowerString[174:177]: 59,
-		_CountryCodeLowerString[177:180]: 60,
-		_CountryCodeLowerString[180:183]: 61,
-		_CountryCodeLowerString[183:186]: 62,
-		_CountryCodeLowerString[186:189]: 63,
-		_CountryCodeLowerString[189:192]: 64,
-		_CountryCodeLowerString[192:195]: 65,
-		_CountryCodeLowerString[195:198]: 66,
-		_CountryCodeLowerString[198:201]: 67,
-		_CountryCodeLowerString[201:204]: 68,
-		_CountryCodeLowerString[204:207]: 69,
-		_CountryCodeLowerString[207:210]: 70,
-		_CountryCodeLowerString[210:213]: 71,
-		_CountryCodeLowerString[213:216]: 72,
-		_CountryCodeLowerString[216:219]: 73,
-		_CountryCodeLowerString[219:222]: 74,
-		_CountryCodeLowerString[222:225]: 75,
-		_CountryCodeLowerString[225:228]: 76,
-		_CountryCodeLowerString[228:231]: 77,
-		_CountryCodeLowerString[231:234]: 78,
-		_CountryCodeLowerString[234:237]: 79,
-		_CountryCodeLowerString[237:240]: 80,
-		_CountryCodeLowerString[240:243]: 81,
-		_CountryCodeLowerString[243:246]: 82,
-		_CountryCodeLowerString[246:249]: 83,
-		_CountryCodeLowerString[249:252]: 84,
-		_CountryCodeLowerString[252:255]: 85,
-		_CountryCodeLowerString[255:258]: 86,
-		_CountryCodeLowerString[258:261]: 87,
-		_CountryCodeLowerString[261:264]: 88,
-		_CountryCodeLowerString[264:267]: 89,
-		_CountryCodeLowerString[267:270]: 90,
-		_CountryCodeLowerString[270:273]: 91,
-		_CountryCodeLowerString[273:276]: 92,
-		_CountryCodeLowerString[276:279]: 93,
-		_CountryCodeLowerString[279:282]: 94,
-		_CountryCodeLowerString[282:285]: 95,
-		_CountryCodeLowerString[285:288]: 96,
-		_CountryCodeLowerString[288:291]: 97,
-		_CountryCodeLowerString[291:294]: 98,
-		_CountryCodeLowerString[294:297]: 99,
-		_CountryCodeLowerString[297:300]: 100,
-		_CountryCodeLowerString[300:303]: 101,
-		_CountryCodeLowerString[303:306]: 102,
-		_CountryCodeLowerString[306:309]: 103,
-		_CountryCodeLowerString[309:312]: 104,
-		_CountryCodeLowerString[312:315]: 105,
-		_CountryCodeLowerString[315:318]: 106,
-		_CountryCodeLowerString[318:321]: 107,
-		_CountryCodeLowerString[321:324]: 108,
-		_CountryCodeLowerString[324:327]: 109,
-		_CountryCodeLowerString[327:330]: 110,
-		_CountryCodeLowerString[330:333]: 111,
-		_CountryCodeLowerString[333:336]: 112,
-		_CountryCodeLowerString[336:339]: 113,
-		_CountryCodeLowerString[339:342]: 114,
-		_CountryCodeLowerString[342:345]: 115,
-		_CountryCodeLowerString[345:348]: 116,
-		_CountryCodeLowerString[348:351]: 117,
-		_CountryCodeLowerString[351:354]: 118,
-		_CountryCodeLowerString[354:357]: 119,
-		_CountryCodeLowerString[357:360]: 120,
-		_CountryCodeLowerString[360:363]: 121,
-		_CountryCodeLowerString[363:366]: 122,
-		_CountryCodeLowerString[366:369]: 123,
-		_CountryCodeLowerString[369:372]: 124,
-		_CountryCodeLowerString[372:375]: 125,
-		_CountryCodeLowerString[375:378]: 126,
-		_CountryCodeLowerString[378:381]: 127,
-		_CountryCodeLowerString[381:384]: 128,
-		_CountryCodeLowerString[384:387]: 129,
-		_CountryCodeLowerString[387:390]: 130,
-		_CountryCodeLowerString[390:393]: 131,
-		_CountryCodeLowerString[393:396]: 132,
-		_CountryCodeLowerString[396:399]: 133,
-		_CountryCodeLowerString[399:402]: 134,
-		_CountryCodeLowerString[402:405]: 135,
-		_CountryCodeLowerString[405:408]: 136,
-		_CountryCodeLowerString[408:411]: 137,
-		_CountryCodeLowerString[411:414]: 138,
-		_CountryCodeLowerString[414:417]: 139,
-		_CountryCodeLowerString[417:420]: 140,
-		_CountryCodeLowerString[420:423]: 141,
-		_CountryCodeLowerString[423:426]: 142,
-		_CountryCodeLowerString[426:429]: 143,
-		_CountryCodeLowerString[429:432]: 144,
-		_CountryCodeLowerString[432:435]: 145,
-		_CountryCodeLowerString[435:438]: 146,
-		_CountryCodeLowerString[438:441]: 147,
-		_CountryCodeLowerString[441:444]: 148,
-		_CountryCodeLowerString[444:447]: 149,
-		_CountryCodeLowerString[447:450]: 150,
-		_CountryCodeLowerString[450:453]: 151,
-		_CountryCodeLowerString[453:456]: 152,
-		_CountryCodeLowerString[456:459]: 153,
-		_CountryCodeLowerString[459:462]: 154,
-		_CountryCodeLowerString[462:465]: 155,
-		_CountryCodeLowerString[465:468]: 156,
-		_CountryCodeLowerString[468:471]: 157,
-		_CountryCodeLowerString[471:474]: 158,
-		_CountryCodeLowerString[474:477]: 159,
-		_CountryCodeLowerString[477:480]: 160,
-		_CountryCodeLowerString[480:483]: 161,
-		_CountryCodeLowerString[483:486]: 162,
-		_CountryCodeLowerString[486:489]: 163,
-		_CountryCodeLowerString[489:492]: 164,
-		_CountryCodeLowerString[492:495]: 165,
-		_CountryCodeLowerString[495:498]: 166,
-		_CountryCodeLowerString[498:501]: 167,
-		_CountryCodeLowerString[501:504]: 168,
-		_CountryCodeLowerString[504:507]: 169,
-		_CountryCodeLowerString[507:510]: 170,
-		_CountryCodeLowerString[510:513]: 171,
-		_CountryCodeLowerString[513:516]: 172,
-		_CountryCodeLowerString[516:519]: 173,
-		_CountryCodeLowerString[519:522]: 174,
-		_CountryCodeLowerString[522:525]: 175,
-		_CountryCodeLowerString[525:528]: 176,
-		_CountryCodeLowerString[528:531]: 177,
-		_CountryCodeLowerString[531:534]: 178,
-		_CountryCodeLowerString[534:537]: 179,
-		_CountryCodeLowerString[537:540]: 180,
-		_CountryCodeLowerString[540:543]: 181,
-		_CountryCodeLowerString[543:546]: 182,
-		_CountryCodeLowerString[546:549]: 183,
-		_CountryCodeLowerString[549:552]: 184,
-		_CountryCodeLowerString[552:555]: 185,
-		_CountryCodeLowerString[555:558]: 186,
-		_CountryCodeLowerString[558:561]: 187,
-		_CountryCodeLowerString[561:564]: 188,
-		_CountryCodeLowerString[564:567]: 189,
-		_CountryCodeLowerString[567:570]: 190,
-		_CountryCodeLowerString[570:573]: 191,
-		_CountryCodeLowerString[573:576]: 192,
-		_CountryCodeLowerString[576:579]: 193,
-		_CountryCodeLowerString[579:582]: 194,
-		_CountryCodeLowerString[582:585]: 195,
-		_CountryCodeLowerString[585:588]: 196,
-		_CountryCodeLowerString[588:591]: 197,
-		_CountryCodeLowerString[591:594]: 198,
-		_CountryCodeLowerString[594:597]: 199,
-		_CountryCodeLowerString[597:600]: 200,
-		_CountryCodeLowerString[600:603]: 201,
-		_CountryCodeLowerString[603:606]: 202,
-		_CountryCodeLowerString[606:609]: 203,
-		_CountryCodeLowerString[609:612]: 204,
-		_CountryCodeLowerString[612:615]: 205,
-		_CountryCodeLowerString[615:618]: 206,
-		_CountryCodeLowerString[618:621]: 207,
-		_CountryCodeLowerString[621:624]: 208,
-		_CountryCodeLowerString[624:627]: 209,
-		_CountryCodeLowerString[627:630]: 210,
-		_CountryCodeLowerString[630:633]: 211,
-		_CountryCodeLowerString[633:636]: 212,
-		_CountryCodeLowerString[636:639]: 213,
-		_CountryCodeLowerString[639:642]: 214,
-		_CountryCodeLowerString[642:645]: 215,
-		_CountryCodeLowerString[645:648]: 216,
-		_CountryCodeLowerString[648:651]: 217,
-		_CountryCodeLowerString[651:654]: 218,
-		_CountryCodeLowerString[654:657]: 219,
-		_CountryCodeLowerString[657:660]: 220,
-		_CountryCodeLowerString[660:663]: 221,
-		_CountryCodeLowerString[663:666]: 222,
-		_CountryCodeLowerString[666:669]: 223,
-		_CountryCodeLowerString[669:672]: 224,
-		_CountryCodeLowerString[672:675]: 225,
-		_CountryCodeLowerString[675:678]: 226,
-		_CountryCodeLowerString[678:681]: 227,
-		_CountryCodeLowerString[681:684]: 228,
-		_CountryCodeLowerString[684:687]: 229,
-		_CountryCodeLowerString[687:690]: 230,
-		_CountryCodeLowerString[690:693]: 231,
-		_CountryCodeLowerString[693:696]: 232,
-		_CountryCodeLowerString[696:699]: 233,
-		_CountryCodeLowerString[699:702]: 234,
-		_CountryCodeLowerString[702:705]: 235,
-		_CountryCodeLowerString[705:708]: 236,
-		_CountryCodeLowerString[708:711]: 237,
-		_CountryCodeLowerString[711:714]: 238,
-		_CountryCodeLowerString[714:717]: 239,
-		_CountryCodeLowerString[717:720]: 240,
-	}
 )
 
+// _CountryCodeLookupFold determines the enum value of the string regardless of its case
+// by Unicode simple folding. It scans the String values, as a fold table is only generated
+// for enums with the "ignore-case" feature.
+func _CountryCodeLookupFold(raw string) (CountryCode, bool) {
+	for idx := range _CountryCodeStrings {
+		if strings.EqualFold(_CountryCodeStrings[idx], raw) {
+			return _CountryCodeValues[idx], true
+		}
+	}
+	return CountryCode(0), false
+}
+
 // CountryCodeFromString determines the enum value with an exact case match.
 func CountryCodeFromString(raw string) (CountryCode, bool) {
 	v, ok := _CountryCodeStringToValueMap[raw]
@@ -1022,13 +982,14 @@ func CountryCodeFromString(raw string) (CountryCode, bool) {
 	return v, true
 }
 
-// CountryCodeFromStringIgnoreCase determines the enum value with a case-insensitive match.
+// CountryCodeFromStringIgnoreCase determines the enum value with a case-insensitive match
+// by Unicode simple folding, regardless of the casing of raw.
 func CountryCodeFromStringIgnoreCase(raw string) (CountryCode, bool) {
 	v, ok := CountryCodeFromString(raw)
 	if ok {
 		return v, ok
 	}
-	v, ok = _CountryCodeLowerStringToValueMap[raw]
+	v, ok = _CountryCodeLookupFold(raw)
 	if !ok {
 		return CountryCode(0), false
 	}
@@ -1037,10 +998,15 @@ func CountryCodeFromStringIgnoreCase(raw string) (CountryCode, bool) {
 
 // MarshalBinary implements the encoding.BinaryMarshaler interface for CountryCode.
 func (_c CountryCode) MarshalBinary() ([]byte, error) {
+	return _c.AppendBinary(nil)
+}
+
+// AppendBinary implements the encoding.BinaryAppender interface for CountryCode.
+func (_c CountryCode) AppendBinary(b []byte) ([]byte, error) {
 	if err := _c.Validate(); err != nil {
-		return nil, fmt.Errorf("Cannot marshal value %q as CountryCode. %w", _c, err)
+		return b, fmt.Errorf("Cannot marshal value %q as CountryCode. %w", _c, err)
 	}
-	return []byte(_c.String()), nil
+	return append(b, _c.String()...), nil
 }
 
 // UnmarshalBinary implements the encoding.BinaryUnmarshaler interface for CountryCode.
@@ -1053,7 +1019,7 @@ func (_c *CountryCode) UnmarshalBinary(text []byte) error {
 	var ok bool
 	*_c, ok = CountryCodeFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a CountryCode", str)
+		return _enumer.NewParseError("CountryCode", str, CountryCodeStrings())
 	}
 	return nil
 }
@@ -1083,7 +1049,7 @@ func (_c *CountryCode) UnmarshalGQL(value interface{}) error {
 	var ok bool
 	*_c, ok = CountryCodeFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a CountryCode", str)
+		return _enumer.NewParseError("CountryCode", str, CountryCodeStrings())
 	}
 	return nil
 }
@@ -1109,7 +1075,7 @@ func (_c *CountryCode) UnmarshalJSON(data []byte) error {
 	var ok bool
 	*_c, ok = CountryCodeFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a CountryCode", str)
+		return _enumer.NewParseError("CountryCode", str, CountryCodeStrings())
 	}
 	return nil
 }
@@ -1142,17 +1108,22 @@ func (_c *CountryCode) Scan(value interface{}) error {
 	var ok bool
 	*_c, ok = CountryCodeFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a CountryCode", str)
+		return _enumer.NewParseError("CountryCode", str, CountryCodeStrings())
 	}
 	return nil
 }
 
 // MarshalText implements the encoding.TextMarshaler interface for CountryCode.
 func (_c CountryCode) MarshalText() ([]byte, error) {
+	return _c.AppendText(nil)
+}
+
+// AppendText implements the encoding.TextAppender interface for CountryCode.
+func (_c CountryCode) AppendText(b []byte) ([]byte, error) {
 	if err := _c.Validate(); err != nil {
-		return nil, fmt.Errorf("Cannot marshal value %q as CountryCode. %w", _c, err)
+		return b, fmt.Errorf("Cannot marshal value %q as CountryCode. %w", _c, err)
 	}
-	return []byte(_c.String()), nil
+	return append(b, _c.String()...), nil
 }
 
 // UnmarshalText implements the encoding.TextUnmarshaler interface for CountryCode.
@@ -1165,7 +1136,7 @@ func (_c *CountryCode) UnmarshalText(text []byte) error {
 	var ok bool
 	*_c, ok = CountryCodeFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a CountryCode", str)
+		return _enumer.NewParseError("CountryCode", str, CountryCodeStrings())
 	}
 	return nil
 }
@@ -1191,14 +1162,275 @@ func (_c *CountryCode) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	var ok bool
 	*_c, ok = CountryCodeFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a CountryCode", str)
+		return _enumer.NewParseError("CountryCode", str, CountryCodeStrings())
 	}
 	return nil
 }
 
+var _CountryCodeCompletions = [240]string{
+	"AFG",
+	"ALB",
+	"DZA",
+	"ASM",
+	"AND",
+	"AGO",
+	"AIA",
+	"ATA",
+	"ATG",
+	"ARG",
+	"ARM",
+	"ABW",
+	"AUS",
+	"AUT",
+	"AZE",
+	"BHS",
+	"BHR",
+	"BGD",
+	"BRB",
+	"BLR",
+	"BEL",
+	"BLZ",
+	"BEN",
+	"BMU",
+	"BTN",
+	"BOL",
+	"BIH",
+	"BWA",
+	"BRA",
+	"IOT",
+	"VGB",
+	"BRN",
+	"BGR",
+	"BFA",
+	"BDI",
+	"KHM",
+	"CMR",
+	"CAN",
+	"CPV",
+	"CYM",
+	"CAF",
+	"TCD",
+	"CHL",
+	"CHN",
+	"CXR",
+	"CCK",
+	"COL",
+	"COM",
+	"COK",
+	"CRI",
+	"HRV",
+	"CUB",
+	"CUW",
+	"CYP",
+	"CZE",
+	"COD",
+	"DNK",
+	"DJI",
+	"DMA",
+	"DOM",
+	"TLS",
+	"ECU",
+	"EGY",
+	"SLV",
+	"GNQ",
+	"ERI",
+	"EST",
+	"ETH",
+	"FLK",
+	"FRO",
+	"FJI",
+	"FIN",
+	"FRA",
+	"PYF",
+	"GAB",
+	"GMB",
+	"GEO",
+	"DEU",
+	"GHA",
+	"GIB",
+	"GRC",
+	"GRL",
+	"GRD",
+	"GUM",
+	"GTM",
+	"GGY",
+	"GIN",
+	"GNB",
+	"GUY",
+	"HTI",
+	"HND",
+	"HKG",
+	"HUN",
+	"ISL",
+	"IND",
+	"IDN",
+	"IRN",
+	"IRQ",
+	"IRL",
+	"IMN",
+	"ISR",
+	"ITA",
+	"CIV",
+	"JAM",
+	"JPN",
+	"JEY",
+	"JOR",
+	"KAZ",
+	"KEN",
+	"KIR",
+	"XKX",
+	"KWT",
+	"KGZ",
+	"LAO",
+	"LVA",
+	"LBN",
+	"LSO",
+	"LBR",
+	"LBY",
+	"LIE",
+	"LTU",
+	"LUX",
+	"MAC",
+	"MKD",
+	"MDG",
+	"MWI",
+	"MYS",
+	"MDV",
+	"MLI",
+	"MLT",
+	"MHL",
+	"MRT",
+	"MUS",
+	"MYT",
+	"MEX",
+	"FSM",
+	"MDA",
+	"MCO",
+	"MNG",
+	"MNE",
+	"MSR",
+	"MAR",
+	"MOZ",
+	"MMR",
+	"NAM",
+	"NRU",
+	"NPL",
+	"NLD",
+	"ANT",
+	"NCL",
+	"NZL",
+	"NIC",
+	"NER",
+	"NGA",
+	"NIU",
+	"PRK",
+	"MNP",
+	"NOR",
+	"OMN",
+	"PAK",
+	"PLW",
+	"PSE",
+	"PAN",
+	"PNG",
+	"PRY",
+	"PER",
+	"PHL",
+	"PCN",
+	"POL",
+	"PRT",
+	"PRI",
+	"QAT",
+	"COG",
+	"REU",
+	"ROU",
+	"RUS",
+	"RWA",
+	"BLM",
+	"SHN",
+	"KNA",
+	"LCA",
+	"MAF",
+	"SPM",
+	"VCT",
+	"WSM",
+	"SMR",
+	"STP",
+	"SAU",
+	"SEN",
+	"SRB",
+	"SYC",
+	"SLE",
+	"SGP",
+	"SXM",
+	"SVK",
+	"SVN",
+	"SLB",
+	"SOM",
+	"ZAF",
+	"KOR",
+	"SSD",
+	"ESP",
+	"LKA",
+	"SDN",
+	"SUR",
+	"SJM",
+	"SWZ",
+	"SWE",
+	"CHE",
+	"SYR",
+	"TWN",
+	"TJK",
+	"TZA",
+	"THA",
+	"TGO",
+	"TKL",
+	"TON",
+	"TTO",
+	"TUN",
+	"TUR",
+	"TKM",
+	"TCA",
+	"TUV",
+	"VIR",
+	"UGA",
+	"UKR",
+	"ARE",
+	"GBR",
+	"USA",
+	"URY",
+	"UZB",
+	"VUT",
+	"VAT",
+	"VEN",
+	"VNM",
+	"WLF",
+	"ESH",
+	"YEM",
+	"ZMB",
+	"ZWE",
+}
+
+// CountryCodeUsage returns a usage hint of all String values of the enum, less the deprecated values,
+// e.g. for flag descriptions.
+func CountryCodeUsage() string {
+	return "one of: " + strings.Join(_CountryCodeStrings[:], "|")
+}
+
+// CountryCodeCompletions returns the completion candidates of the enum matching the given prefix,
+// less the deprecated values. Each candidate is formatted as "<value>" or "<value>\t<description>",
+// as expected by shell completions, e.g. of cobra's RegisterFlagCompletionFunc.
+func CountryCodeCompletions(toComplete string) []string {
+	out := make([]string, 0, len(_CountryCodeCompletions))
+	for _, c := range _CountryCodeCompletions {
+		if strings.HasPrefix(c, toComplete) {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
 const (
-	_CurrencyString      = "USDEURJPYGBPAUD"
-	_CurrencyLowerString = "usdeurjpygbpaud"
+	_CurrencyString = "USDEURJPYGBPAUD"
 )
 
 var (
@@ -1229,6 +1461,11 @@ func CurrencyStrings() []string {
 	return cp[:]
 }
 
+// Values returns all values of the enum.
+func (Currency) Values() []Currency {
+	return CurrencyValues()
+}
+
 // IsValid tests whether the value is a valid enum value.
 func (_c Currency) IsValid() bool {
 	return _c >= 1 && _c <= 5
@@ -1253,6 +1490,54 @@ func (_c Currency) String() string {
 	return _CurrencyStrings[idx]
 }
 
+// Index returns the position of the value within the ordered values of the enum
+// (ignoring alternative values) or -1 if the value is invalid.
+func (_c Currency) Index() int {
+	if !_c.IsValid() {
+		return -1
+	}
+	idx := int(_c) - 1
+	return idx
+}
+
+// Compare returns -1, 0 or +1 depending on whether the value orders before,
+// equal to or after the other value. Invalid values order before all valid values.
+func (_c Currency) Compare(other Currency) int {
+	a, b := _c.Index(), other.Index()
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return +1
+	}
+	return 0
+}
+
+// Less tests whether the value orders before the other value.
+func (_c Currency) Less(other Currency) bool {
+	return _c.Compare(other) < 0
+}
+
+// Next returns the succeeding value in order of the enum.
+// It returns false if the value is invalid or the last value.
+func (_c Currency) Next() (Currency, bool) {
+	idx := _c.Index()
+	if idx == -1 || idx+1 == len(_CurrencyValues) {
+		return Currency(0), false
+	}
+	return _CurrencyValues[idx+1], true
+}
+
+// Prev returns the preceding value in order of the enum.
+// It returns false if the value is invalid or the first value.
+func (_c Currency) Prev() (Currency, bool) {
+	idx := _c.Index()
+	if idx < 1 {
+		return Currency(0), false
+	}
+	return _CurrencyValues[idx-1], true
+}
+
 // GetCurrencyName returns the "currency-name" of the enum value.
 func (_c Currency) GetCurrencyName() string {
 	if !_c.IsValid() {
@@ -1291,15 +1576,20 @@ var (
 		_CurrencyString[9:12]:  4,
 		_CurrencyString[12:15]: 5,
 	}
-	_CurrencyLowerStringToValueMap = map[string]Currency{
-		_CurrencyLowerString[0:3]:   1,
-		_CurrencyLowerString[3:6]:   2,
-		_CurrencyLowerString[6:9]:   3,
-		_CurrencyLowerString[9:12]:  4,
-		_CurrencyLowerString[12:15]: 5,
-	}
 )
 
+// _CurrencyLookupFold determines the enum value of the string regardless of its case
+// by Unicode simple folding. It scans the String values, as a fold table is only generated
+// for enums with the "ignore-case" feature.
+func _CurrencyLookupFold(raw string) (Currency, bool) {
+	for idx := range _CurrencyStrings {
+		if strings.EqualFold(_CurrencyStrings[idx], raw) {
+			return _CurrencyValues[idx], true
+		}
+	}
+	return Currency(0), false
+}
+
 // CurrencyFromString determines the enum value with an exact case match.
 func CurrencyFromString(raw string) (Currency, bool) {
 	v, ok := _CurrencyStringToValueMap[raw]
@@ -1309,13 +1599,14 @@ func CurrencyFromString(raw string) (Currency, bool) {
 	return v, true
 }
 
-// CurrencyFromStringIgnoreCase determines the enum value with a case-insensitive match.
+// CurrencyFromStringIgnoreCase determines the enum value with a case-insensitive match
+// by Unicode simple folding, regardless of the casing of raw.
 func CurrencyFromStringIgnoreCase(raw string) (Currency, bool) {
 	v, ok := CurrencyFromString(raw)
 	if ok {
 		return v, ok
 	}
-	v, ok = _CurrencyLowerStringToValueMap[raw]
+	v, ok = _CurrencyLookupFold(raw)
 	if !ok {
 		return Currency(0), false
 	}
@@ -1324,10 +1615,15 @@ func CurrencyFromStringIgnoreCase(raw string) (Currency, bool) {
 
 // MarshalBinary implements the encoding.BinaryMarshaler interface for Currency.
 func (_c Currency) MarshalBinary() ([]byte, error) {
+	return _c.AppendBinary(nil)
+}
+
+// AppendBinary implements the encoding.BinaryAppender interface for Currency.
+func (_c Currency) AppendBinary(b []byte) ([]byte, error) {
 	if err := _c.Validate(); err != nil {
-		return nil, fmt.Errorf("Cannot marshal value %q as Currency. %w", _c, err)
+		return b, fmt.Errorf("Cannot marshal value %q as Currency. %w", _c, err)
 	}
-	return []byte(_c.String()), nil
+	return append(b, _c.String()...), nil
 }
 
 // UnmarshalBinary implements the encoding.BinaryUnmarshaler interface for Currency.
@@ -1340,7 +1636,7 @@ func (_c *Currency) UnmarshalBinary(text []byte) error {
 	var ok bool
 	*_c, ok = CurrencyFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a Currency", str)
+		return _enumer.NewParseError("Currency", str, CurrencyStrings())
 	}
 	return nil
 }
@@ -1370,7 +1666,7 @@ func (_c *Currency) UnmarshalGQL(value interface{}) error {
 	var ok bool
 	*_c, ok = CurrencyFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a Currency", str)
+		return _enumer.NewParseError("Currency", str, CurrencyStrings())
 	}
 	return nil
 }
@@ -1396,7 +1692,7 @@ func (_c *Currency) UnmarshalJSON(data []byte) error {
 	var ok bool
 	*_c, ok = CurrencyFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a Currency", str)
+		return _enumer.NewParseError("Currency", str, CurrencyStrings())
 	}
 	return nil
 }
@@ -1429,17 +1725,22 @@ func (_c *Currency) Scan(value interface{}) error {
 	var ok bool
 	*_c, ok = CurrencyFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a Currency", str)
+		return _enumer.NewParseError("Currency", str, CurrencyStrings())
 	}
 	return nil
 }
 
 // MarshalText implements the encoding.TextMarshaler interface for Currency.
 func (_c Currency) MarshalText() ([]byte, error) {
+	return _c.AppendText(nil)
+}
+
+// AppendText implements the encoding.TextAppender interface for Currency.
+func (_c Currency) AppendText(b []byte) ([]byte, error) {
 	if err := _c.Validate(); err != nil {
-		return nil, fmt.Errorf("Cannot marshal value %q as Currency. %w", _c, err)
+		return b, fmt.Errorf("Cannot marshal value %q as Currency. %w", _c, err)
 	}
-	return []byte(_c.String()), nil
+	return append(b, _c.String()...), nil
 }
 
 // UnmarshalText implements the encoding.TextUnmarshaler interface for Currency.
@@ -1452,7 +1753,7 @@ func (_c *Currency) UnmarshalText(text []byte) error {
 	var ok bool
 	*_c, ok = CurrencyFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a Currency", str)
+		return _enumer.NewParseError("Currency", str, CurrencyStrings())
 	}
 	return nil
 }
@@ -1478,167 +1779,1227 @@ func (_c *Currency) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	var ok bool
 	*_c, ok = CurrencyFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a Currency", str)
+		return _enumer.NewParseError("Currency", str, CurrencyStrings())
 	}
 	return nil
 }
 
+var _CurrencyCompletions = [5]string{
+	"USD",
+	"EUR",
+	"JPY",
+	"GBP",
+	"AUD",
+}
+
+// CurrencyUsage returns a usage hint of all String values of the enum, less the deprecated values,
+// e.g. for flag descriptions.
+func CurrencyUsage() string {
+	return "one of: " + strings.Join(_CurrencyStrings[:], "|")
+}
+
+// CurrencyCompletions returns the completion candidates of the enum matching the given prefix,
+// less the deprecated values. Each candidate is formatted as "<value>" or "<value>\t<description>",
+// as expected by shell completions, e.g. of cobra's RegisterFlagCompletionFunc.
+func CurrencyCompletions(toComplete string) []string {
+	out := make([]string, 0, len(_CurrencyCompletions))
+	for _, c := range _CurrencyCompletions {
+		if strings.HasPrefix(c, toComplete) {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
 const (
-	_TimezoneString      = "Asia/KabulEurope/TiraneAfrica/AlgiersPacific/Pago_PagoEurope/AndorraAfrica/LuandaAmerica/AnguillaAntarctica/CaseyAntarctica/DavisAntarctica/DumontDUrvilleAntarctica/MawsonAntarctica/McMurdoAntarctica/PalmerAntarctica/RotheraAntarctica/SyowaAntarctica/TrollAntarctica/VostokAmerica/AntiguaAmerica/Argentina/Buenos_AiresAmerica/Argentina/CatamarcaAmerica/Argentina/CordobaAmerica/Argentina/JujuyAmerica/Argentina/La_RiojaAmerica/Argentina/MendozaAmerica/Argentina/Rio_GallegosAmerica/Argentina/SaltaAmerica/Argentina/San_JuanAmerica/Argentina/San_LuisAmerica/Argentina/TucumanAmerica/Argentina/UshuaiaAsia/YerevanAmerica/ArubaAntarctica/MacquarieAustralia/AdelaideAustralia/BrisbaneAustralia/Broken_HillAustralia/DarwinAustralia/EuclaAustralia/HobartAustralia/LindemanAustralia/Lord_HoweAustralia/MelbourneAustralia/PerthAustralia/SydneyEurope/ViennaAsia/BakuAmerica/NassauAsia/BahrainAsia/DhakaAmerica/BarbadosEurope/MinskEurope/BrusselsAmerica/BelizeAfrica/Porto-NovoAtlantic/BermudaAsia/ThimphuAmerica/La_PazAmerica/KralendijkEurope/SarajevoAfrica/GaboroneAmerica/AraguainaAmerica/BahiaAmerica/BelemAmerica/Boa_VistaAmerica/Campo_GrandeAmerica/CuiabaAmerica/EirunepeAmerica/FortalezaAmerica/MaceioAmerica/ManausAmerica/NoronhaAmerica/Porto_VelhoAmerica/RecifeAmerica/Rio_BrancoAmerica/SantaremAmerica/Sao_PauloIndian/ChagosAsia/BruneiEurope/SofiaAfrica/OuagadougouAfrica/BujumburaAsia/Phnom_PenhAfrica/DoualaAmerica/AtikokanAmerica/Blanc-SablonAmerica/Cambridge_BayAmerica/CrestonAmerica/DawsonAmerica/Dawson_CreekAmerica/EdmontonAmerica/Fort_NelsonAmerica/Glace_BayAmerica/Goose_BayAmerica/HalifaxAmerica/InuvikAmerica/IqaluitAmerica/MonctonAmerica/NipigonAmerica/PangnirtungAmerica/Rainy_RiverAmerica/Rankin_InletAmerica/ReginaAmerica/ResoluteAmerica/St_JohnsAmerica/Swift_CurrentAmerica/Thunder_BayAmerica/TorontoAmerica/VancouverAmerica/WhitehorseAmerica/WinnipegAmerica/YellowknifeAtlantic/Cape_VerdeAmerica/CaymanAfrica/BanguiAfrica/NdjamenaAmerica/Punta_ArenasAmerica/SantiagoPacific/EasterAsia/ShanghaiAsia/UrumqiIndian/ChristmasIndian/CocosAmerica/BogotaIndian/ComoroAfrica/BrazzavilleAfrica/KinshasaAfrica/LubumbashiPacific/RarotongaAmerica/Costa_RicaEurope/ZagrebAmerica/HavanaAmerica/CuracaoAsia/FamagustaAsia/NicosiaEurope/PragueAfrica/AbidjanEurope/CopenhagenAfrica/DjiboutiAmerica/DominicaAmerica/Santo_DomingoAmerica/GuayaquilPacific/GalapagosAfrica/CairoAmerica/El_SalvadorAfrica/MalaboAfrica/AsmaraEurope/TallinnAfrica/Addis_AbabaAtlantic/StanleyAtlantic/FaroePacific/FijiEurope/HelsinkiEurope/ParisAmerica/CayennePacific/GambierPacific/MarquesasPacific/TahitiIndian/KerguelenAfrica/LibrevilleAfrica/BanjulAsia/TbilisiEurope/BerlinEurope/BusingenAfrica/AccraEurope/GibraltarEurope/AthensAmerica/DanmarkshavnAmerica/NuukAmerica/ScoresbysundAmerica/ThuleAmerica/GrenadaAmerica/GuadeloupePacific/GuamAmerica/GuatemalaEurope/GuernseyAfrica/ConakryAfrica/BissauAmerica/GuyanaAmerica/Port-au-PrinceEurope/VaticanAmerica/TegucigalpaAsia/Hong_KongEurope/BudapestAtlantic/ReykjavikAsia/KolkataAsia/JakartaAsia/JayapuraAsia/MakassarAsia/PontianakAsia/TehranAsia/BaghdadEurope/DublinEurope/Isle_of_ManAsia/JerusalemEurope/RomeAmerica/JamaicaAsia/TokyoEurope/JerseyAsia/AmmanAsia/AlmatyAsia/AqtauAsia/AqtobeAsia/AtyrauAsia/OralAsia/QostanayAsia/QyzylordaAfrica/NairobiPacific/KantonPacific/KiritimatiPacific/TarawaAsia/PyongyangAsia/SeoulAsia/KuwaitAsia/BishkekAsia/VientianeEurope/RigaAsia/BeirutAfrica/MaseruAfrica/MonroviaAfrica/TripoliEurope/VaduzEurope/VilniusEurope/LuxembourgAsia/MacauEurope/SkopjeIndian/AntananarivoAfrica/BlantyreAsia/Kuala_LumpurAsia/KuchingIndian/MaldivesAfrica/BamakoEurope/MaltaPacific/KwajaleinPacific/MajuroAmerica/MartiniqueAfrica/NouakchottIndian/MauritiusIndian/MayotteAmerica/Bahia_BanderasAmerica/CancunAmerica/ChihuahuaAmerica/HermosilloAmerica/MatamorosAmerica/MazatlanAmerica/MeridaAmerica/Mexico_CityAmerica/MonterreyAmerica/OjinagaAmerica/TijuanaPacific/ChuukPacific/KosraePacific/PohnpeiEurope/ChisinauEurope/MonacoAsia/ChoibalsanAsia/HovdAsia/UlaanbaatarEurope/PodgoricaAmerica/MontserratAfrica/CasablancaAfrica/MaputoAsia/YangonAfrica/WindhoekPacific/NauruAsia/KathmanduEurope/AmsterdamPacific/NoumeaPacific/AucklandPacific/ChathamAmerica/ManaguaAfrica/NiameyAfrica/LagosPacific/NiuePacific/NorfolkPacific/SaipanEurope/OsloAsia/MuscatAsia/KarachiPacific/PalauAsia/GazaAsia/HebronAmerica/PanamaPacific/BougainvillePacific/Port_MoresbyAmerica/AsuncionAmerica/LimaAsia/ManilaPacific/PitcairnEurope/WarsawAtlantic/AzoresAtlantic/MadeiraEurope/LisbonAmerica/Puerto_RicoAsia/QatarEurope/BucharestAsia/AnadyrAsia/BarnaulAsia/ChitaAsia/IrkutskAsia/KamchatkaAsia/KhandygaAsia/KrasnoyarskAsia/MagadanAsia/NovokuznetskAsia/NovosibirskAsia/OmskAsia/SakhalinAsia/SrednekolymskAsia/TomskAsia/Ust-NeraAsia/VladivostokAsia/YakutskAsia/YekaterinburgEurope/AstrakhanEurope/KaliningradEurope/KirovEurope/MoscowEurope/SamaraEurope/SaratovEurope/UlyanovskEurope/VolgogradAfrica/KigaliIndian/ReunionAmerica/St_BarthelemyAtlantic/St_HelenaAmerica/St_KittsAmerica/St_LuciaAmerica/MarigotAmerica/MiquelonAmerica/St_VincentPacific/ApiaEurope/San_MarinoAfrica/Sao_TomeAsia/RiyadhAfrica/DakarEurope/BelgradeIndian/MaheAfrica/FreetownAsia/SingaporeAmerica/Lower_PrincesEurope/BratislavaEurope/LjubljanaPacific/GuadalcanalAfrica/MogadishuAfrica/JohannesburgAtlantic/South_GeorgiaAfrica/JubaAfrica/CeutaAtlantic/CanaryEurope/MadridAsia/ColomboAfrica/KhartoumAmerica/ParamariboArctic/LongyearbyenAfrica/MbabaneEurope/StockholmEurope/ZurichAsia/DamascusAsia/TaipeiAsia/DushanbeAfrica/Dar_es_SalaamAsia/BangkokAsia/DiliAfrica/LomePacific/FakaofoPacific/TongatapuAmerica/Port_of_SpainAfrica/TunisEurope/IstanbulAsia/AshgabatAmerica/Grand_TurkPacific/FunafutiAfrica/KampalaEurope/KievEurope/SimferopolEurope/UzhgorodEurope/ZaporozhyeAsia/DubaiEurope/LondonAmerica/AdakAmerica/AnchorageAmerica/BoiseAmerica/ChicagoAmerica/DenverAmerica/DetroitAmerica/Indiana/IndianapolisAmerica/Indiana/KnoxAmerica/Indiana/MarengoAmerica/Indiana/PetersburgAmerica/Indiana/Tell_CityAmerica/Indiana/VevayAmerica/Indiana/VincennesAmerica/Indiana/WinamacAmerica/JuneauAmerica/Kentucky/LouisvilleAmerica/Kentucky/MonticelloAmerica/Los_AngelesAmerica/MenomineeAmerica/MetlakatlaAmerica/New_YorkAmerica/NomeAmerica/North_Dakota/BeulahAmerica/North_Dakota/CenterAmerica/North_Dakota/New_SalemAmerica/PhoenixAmerica/SitkaAmerica/YakutatPacific/HonoluluPacific/MidwayPacific/WakeAmerica/MontevideoAsia/SamarkandAsia/TashkentPacific/EfateAmerica/CaracasAsia/Ho_Chi_MinhAmerica/TortolaAmerica/St_ThomasPacific/WallisAfrica/El_AaiunAsia/AdenAfrica/LusakaAfrica/HarareEurope/Mariehamn"
-	_TimezoneLowerString = "asia/kabuleurope/tiraneafrica/algierspacific/pago_pagoeurope/andorraafrica/luandaamerica/anguillaantarctica/caseyantarctica/davisantarctica/dumontdurvilleantarctica/mawsonantarctica/mcmurdoantarctica/palmerantarctica/rotheraantarctica/syowaantarctica/trollantarctica/vostokamerica/antiguaamerica/argentina/buenos_airesamerica/argentina/catamarcaamerica/argentina/cordobaamerica/argentina/jujuyamerica/argentina/la_riojaamerica/argentina/mendozaamerica/argentina/rio_gallegosamerica/argentina/saltaamerica/argentina/san_juanamerica/argentina/san_luisamerica/argentina/tucumanamerica/argentina/ushuaiaasia/yerevanamerica/arubaantarctica/macquarieaustralia/adelaideaustralia/brisbaneaustralia/broken_hillaustralia/darwinaustralia/euclaaustralia/hobartaustralia/lindemanaustralia/lord_howeaustralia/melbourneaustralia/perthaustralia/sydneyeurope/viennaasia/bakuamerica/nassauasia/bahrainasia/dhakaamerica/barbadoseurope/minskeurope/brusselsamerica/belizeafrica/porto-novoatlantic/bermudaasia/thimphuamerica/la_pazamerica/kralendijkeurope/sarajevoafrica/gaboroneamerica/araguainaamerica/bahiaamerica/belemamerica/boa_vistaamerica/campo_grandeamerica/cuiabaamerica/eirunepeamerica/fortalezaamerica/maceioamerica/manausamerica/noronhaamerica/porto_velhoamerica/recifeamerica/rio_brancoamerica/santaremamerica/sao_pauloindian/chagosasia/bruneieurope/sofiaafrica/ouagadougouafrica/bujumburaasia/phnom_penhafrica/doualaamerica/atikokanamerica/blanc-sablonamerica/cambridge_bayamerica/crestonamerica/dawsonamerica/dawson_creekamerica/edmontonamerica/fort_nelsonamerica/glace_bayamerica/goose_bayamerica/halifaxamerica/inuvikamerica/iqaluitamerica/monctonamerica/nipigonamerica/pangnirtungamerica/rainy_riveramerica/rankin_inletamerica/reginaamerica/resoluteamerica/st_johnsamerica/swift_currentamerica/thunder_bayamerica/torontoamerica/vancouveramerica/whitehorseamerica/winnipegamerica/yellowknifeatlantic/cape_verdeamerica/caymanafrica/banguiafrica/ndjamenaamerica/punta_arenasamerica/santiagopacific/easterasia/shanghaiasia/urumqiindian/christmasindian/cocosamerica/bogotaindian/comoroafrica/brazzavilleafrica/kinshasaafrica/lubumbashipacific/rarotongaamerica/costa_ricaeurope/zagrebamerica/havanaamerica/curacaoasia/famagustaasia/nicosiaeurope/pragueafrica/abidjaneurope/copenhagenafrica/djiboutiamerica/dominicaamerica/santo_domingoamerica/guayaquilpacific/galapagosafrica/cairoamerica/el_salvadorafrica/malaboafrica/asmaraeurope/tallinnafrica/addis_ababaatlantic/stanleyatlantic/faroepacific/fijieurope/helsinkieurope/parisamerica/cayennepacific/gambierpacific/marquesaspacific/tahitiindian/kerguelenafrica/librevilleafrica/banjulasia/tbilisieurope/berlineurope/busingenafrica/accraeurope/gibraltareurope/athensamerica/danmarkshavnamerica/nuukamerica/scoresbysundamerica/thuleamerica/grenadaamerica/guadeloupepacific/guamamerica/guatemalaeurope/guernseyafrica/conakryafrica/bissauamerica/guyanaamerica/port-au-princeeurope/vaticanamerica/tegucigalpaasia/hong_kongeurope/budapestatlantic/reykjavikasia/kolkataasia/jakartaasia/jayapuraasia/makassarasia/pontianakasia/tehranasia/baghdadeurope/dublineurope/isle_of_manasia/jerusalemeurope/romeamerica/jamaicaasia/tokyoeurope/jerseyasia/ammanasia/almatyasia/aqtauasia/aqtobeasia/atyrauasia/oralasia/qostanayasia/qyzylordaafrica/nairobipacific/kantonpacific/kiritimatipacific/tarawaasia/pyongyangasia/seoulasia/kuwaitasia/bishkekasia/vientianeeurope/rigaasia/beirutafrica/maseruafrica/monroviaafrica/tripolieurope/vaduzeurope/vilniuseurope/luxembourgasia/macaueurope/skopjeindian/antananarivoafrica/blantyreasia/kuala_lumpurasia/kuchingindian/maldivesafrica/bamakoeurope/maltapacific/kwajaleinpacific/majuroamerica/martiniqueafrica/nouakchottindian/mauritiusindian/mayotteamerica/bahia_banderasamerica/cancunamerica/chihuahuaamerica/hermosilloamerica/matamorosamerica/mazatlanamerica/meridaamerica/mexico_cityamerica/monterreyamerica/ojinagaamerica/tijuanapacific/chuukpacific/kosraepacific/pohnpeieurope/chisinaueurope/monacoasia/choibalsanasia/hovdasia/ulaanbaatareurope/podgoricaamerica/montserratafrica/casablancaafrica/maputoasia/yangonafrica/windhoekpacific/nauruasia/kathmandueurope/amsterdampacific/noumeapacific/aucklandpacific/chathamamerica/managuaafrica/niameyafrica/lagospacific/niuepacific/norfolkpacific/saipaneurope/osloasia/muscatasia/karachipacific/palauasia/gazaasia/hebronamerica/panamapacific/bougainvillepacific/port_moresbyamerica/asuncionamerica/limaasia/manilapacific/pitcairneurope/warsawatlantic/azoresatlantic/madeiraeurope/lisbonamerica/puerto_ricoasia/qatareurope/bucharestasia/anadyrasia/barnaulasia/chitaasia/irkutskasia/kamchatkaasia/khandygaasia/krasnoyarskasia/magadanasia/novokuznetskasia/novosibirskasia/omskasia/sakhalinasia/srednekolymskasia/tomskasia/ust-neraasia/vladivostokasia/yakutskasia/yekaterinburgeurope/astrakhaneurope/kaliningradeurope/kiroveurope/moscoweurope/samaraeurope/saratoveurope/ulyanovskeurope/volgogradafrica/kigaliindian/reunionamerica/st_barthelemyatlantic/st_helenaamerica/st_kittsamerica/st_luciaamerica/marigotamerica/miquelonamerica/st_vincentpacific/apiaeurope/san_marinoafrica/sao_tomeasia/riyadhafrica/dakareurope/belgradeindian/maheafrica/freetownasia/singaporeamerica/lower_princeseurope/bratislavaeurope/ljubljanapacific/guadalcanalafrica/mogadishuafrica/johannesburgatlantic/south_georgiaafrica/jubaafrica/ceutaatlantic/canaryeurope/madridasia/colomboafrica/khartoumamerica/paramariboarctic/longyearbyenafrica/mbabaneeurope/stockholmeurope/zurichasia/damascusasia/taipeiasia/dushanbeafrica/dar_es_salaamasia/bangkokasia/diliafrica/lomepacific/fakaofopacific/tongatapuamerica/port_of_spainafrica/tuniseurope/istanbulasia/ashgabatamerica/grand_turkpacific/funafutiafrica/kampalaeurope/kieveurope/simferopoleurope/uzhgorodeurope/zaporozhyeasia/dubaieurope/londonamerica/adakamerica/anchorageamerica/boiseamerica/chicagoamerica/denveramerica/detroitamerica/indiana/indianapolisamerica/indiana/knoxamerica/indiana/marengoamerica/indiana/petersburgamerica/indiana/tell_cityamerica/indiana/vevayamerica/indiana/vincennesamerica/indiana/winamacamerica/juneauamerica/kentucky/louisvilleamerica/kentucky/monticelloamerica/los_angelesamerica/menomineeamerica/metlakatlaamerica/new_yorkamerica/nomeamerica/north_dakota/beulahamerica/north_dakota/centeramerica/north_dakota/new_salemamerica/phoenixamerica/sitkaamerica/yakutatpacific/honolulupacific/midwaypacific/wakeamerica/montevideoasia/samarkandasia/tashkentpacific/efateamerica/caracasasia/ho_chi_minhamerica/tortolaamerica/st_thomaspacific/wallisafrica/el_aaiunasia/adenafrica/lusakaafrica/harareeurope/mariehamn"
+	_HTTPMethodString = "GETPOSToptionsx-legacy-purge"
 )
 
 var (
-	_TimezoneValues  = [424]Timezone{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55, 56, 57, 58, 59, 60, 61, 62, 63, 64, 65, 66, 67, 68, 69, 70, 71, 72, 73, 74, 75, 76, 77, 78, 79, 80, 81, 82, 83, 84, 85, 86, 87, 88, 89, 90, 91, 92, 93, 94, 95, 96, 97, 98, 99, 100, 101, 102, 103, 104, 105, 106, 107, 108, 109, 110, 111, 112, 113, 114, 115, 116, 117, 118, 119, 120, 121, 122, 123, 124, 125, 126, 127, 128, 129, 130, 131, 132, 133, 134, 135, 136, 137, 138, 139, 140, 141, 142, 143, 144, 145, 146, 147, 148, 149, 150, 151, 152, 153, 154, 155, 156, 157, 158, 159, 160, 161, 162, 163, 164, 165, 166, 167, 168, 169, 170, 171, 172, 173, 174, 175, 176, 177, 178, 179, 180, 181, 182, 183, 184, 185, 186, 187, 188, 189, 190, 191, 192, 193, 194, 195, 196, 197, 198, 199, 200, 201, 202, 203, 204, 205, 206, 207, 208, 209, 210, 211, 212, 213, 214, 215, 216, 217, 218, 219, 220, 221, 222, 223, 224, 225, 226, 227, 228, 229, 230, 231, 232, 233, 234, 235, 236, 237, 238, 239, 240, 241, 242, 243, 244, 245, 246, 247, 248, 249, 250, 251, 252, 253, 254, 255, 256, 257, 258, 259, 260, 261, 262, 263, 264, 265, 266, 267, 268, 269, 270, 271, 272, 273, 274, 275, 276, 277, 278, 279, 280, 281, 282, 283, 284, 285, 286, 287, 288, 289, 290, 291, 292, 293, 294, 295, 296, 297, 298, 299, 300, 301, 302, 303, 304, 305, 306, 307, 308, 309, 310, 311, 312, 313, 314, 315, 316, 317, 318, 319, 320, 321, 322, 323, 324, 325, 326, 327, 328, 329, 330, 331, 332, 333, 334, 335, 336, 337, 338, 339, 340, 341, 342, 343, 344, 345, 346, 347, 348, 349, 350, 351, 352, 353, 354, 355, 356, 357, 358, 359, 360, 361, 362, 363, 364, 365, 366, 367, 368, 369, 370, 371, 372, 373, 374, 375, 376, 377, 378, 379, 380, 381, 382, 383, 384, 385, 386, 387, 388, 389, 390, 391, 392, 393, 394, 395, 396, 397, 398, 399, 400, 401, 402, 403, 404, 405, 406, 407, 408, 409, 410, 411, 412, 413, 414, 415, 416, 417, 418, 419, 420, 421, 422, 423, 424}
-	_TimezoneStrings = [424]string{_TimezoneString[0:10], _TimezoneString[10:23], _TimezoneString[23:37], _TimezoneString[37:54], _TimezoneString[54:68], _TimezoneString[68:81], _TimezoneString[81:97], _TimezoneString[97:113], _TimezoneString[113:129], _TimezoneString[129:154], _TimezoneString[154:171], _TimezoneString[171:189], _TimezoneString[189:206], _TimezoneString[206:224], _TimezoneString[224:240], _TimezoneString[240:256], _TimezoneString[256:273], _TimezoneString[273:288], _TimezoneString[288:318], _TimezoneString[318:345], _TimezoneString[345:370], _TimezoneString[370:393], _TimezoneString[393:419], _TimezoneString[419:444], _TimezoneString[444:474], _TimezoneString[474:497], _TimezoneString[497:523], _TimezoneString[523:549], _TimezoneString[549:574], _TimezoneString[574:599], _TimezoneString[599:611], _TimezoneString[611:624], _TimezoneString[624:644], _TimezoneString[644:662], _TimezoneString[662:680], _TimezoneString[680:701], _TimezoneString[701:717], _TimezoneString[717:732], _TimezoneString[732:748], _TimezoneString[748:766], _TimezoneString[766:785], _TimezoneString[785:804], _TimezoneString[804:819], _TimezoneString[819:835], _TimezoneString[835:848], _TimezoneString[848:857], _TimezoneString[857:871], _TimezoneString[871:883], _TimezoneString[883:893], _TimezoneString[893:909], _TimezoneString[909:921], _TimezoneString[921:936], _TimezoneString[936:950], _TimezoneString[950:967], _TimezoneString[967:983], _TimezoneString[983:995], _TimezoneString[995:1009], _TimezoneString[1009:1027], _TimezoneString[1027:1042], _TimezoneString[1042:1057], _TimezoneString[1057:1074], _TimezoneString[1074:1087], _TimezoneString[1087:1100], _TimezoneString[1100:1117], _TimezoneString[1117:1137], _TimezoneString[1137:1151], _TimezoneString[1151:1167], _TimezoneString[1167:1184], _TimezoneString[1184:1198], _TimezoneString[1198:1212], _TimezoneString[1212:1227], _TimezoneString[1227:1246], _TimezoneString[1246:1260], _TimezoneString[1260:1278], _TimezoneString[1278:1294], _TimezoneString[1294:1311], _TimezoneString[1311:1324], _TimezoneString[1324:1335], _TimezoneString[1335:1347], _TimezoneString[1347:1365], _TimezoneString[1365:1381], _TimezoneString[1381:1396], _TimezoneString[1396:1409], _TimezoneString[1409:1425], _TimezoneString[1425:1445], _TimezoneString[1445:1466], _TimezoneString[1466:1481], _TimezoneString[1481:1495], _TimezoneString[1495:1515], _TimezoneString[1515:1531], _TimezoneString[1531:1550], _TimezoneString[1550:1567], _TimezoneString[1567:1584], _TimezoneString[1584:1599], _TimezoneString[1599:1613], _TimezoneString[1613:1628], _TimezoneString[1628:1643], _TimezoneString[1643:1658], _TimezoneString[1658:1677], _TimezoneString[1677:1696], _TimezoneString[1696:1716], _TimezoneString[1716:1730], _TimezoneString[1730:1746], _TimezoneString[1746:1762], _TimezoneString[1762:1783], _TimezoneString[1783:1802], _TimezoneString[1802:1817], _TimezoneString[1817:1834], _TimezoneString[1834:1852], _TimezoneString[1852:1868], _TimezoneString[1868:1887], _TimezoneString[1887:1906], _TimezoneString[1906:1920], _TimezoneString[1920:1933], _TimezoneString[1933:1948], _TimezoneString[1948:1968], _TimezoneString[1968:1984], _TimezoneString[1984:1998], _TimezoneString[1998:2011], _TimezoneString[2011:2022], _TimezoneString[2022:2038], _TimezoneString[2038:2050], _TimezoneString[2050:2064], _TimezoneString[2064:2077], _TimezoneString[2077:2095], _TimezoneString[2095:2110], _TimezoneString[2110:2127], _TimezoneString[2127:2144], _TimezoneString[2144:2162], _TimezoneString[2162:2175], _TimezoneString[2175:2189], _TimezoneString[2189:2204], _TimezoneString[2204:2218], _TimezoneString[2218:2230], _TimezoneString[2230:2243], _TimezoneString[2243:2257], _TimezoneString[2257:2274], _TimezoneString[2274:2289], _TimezoneString[2289:2305], _TimezoneString[2305:2326], _TimezoneString[2326:2343], _TimezoneString[2343:2360], _TimezoneString[2360:2372], _TimezoneString[2372:2391], _TimezoneString[2391:2404], _TimezoneString[2404:2417], _TimezoneString[2417:2431], _TimezoneString[2431:2449], _TimezoneString[2449:2465], _TimezoneString[2465:2479], _TimezoneString[2479:2491], _TimezoneString[2491:2506], _TimezoneString[2506:2518], _TimezoneString[2518:2533], _TimezoneString[2533:2548], _TimezoneString[2548:2565], _TimezoneString[2565:2579], _TimezoneString[2579:2595], _TimezoneString[2595:2612], _TimezoneString[2612:2625], _TimezoneString[2625:2637], _TimezoneString[2637:2650], _TimezoneString[2650:2665], _TimezoneString[2665:2677], _TimezoneString[2677:2693], _TimezoneString[2693:2706], _TimezoneString[2706:2726], _TimezoneString[2726:2738], _TimezoneString[2738:2758], _TimezoneString[2758:2771], _TimezoneString[2771:2786], _TimezoneString[2786:2804], _TimezoneString[2804:2816], _TimezoneString[2816:2833], _TimezoneString[2833:2848], _TimezoneString[2848:2862], _TimezoneString[2862:2875], _TimezoneString[2875:2889], _TimezoneString[2889:2911], _TimezoneString[2911:2925], _TimezoneString[2925:2944], _TimezoneString[2944:2958], _TimezoneString[2958:2973], _TimezoneString[2973:2991], _TimezoneString[2991:3003], _TimezoneString[3003:3015], _TimezoneString[3015:3028], _TimezoneString[3028:3041], _TimezoneString[3041:3055], _TimezoneString[3055:3066], _TimezoneString[3066:3078], _TimezoneString[3078:3091], _TimezoneString[3091:3109], _TimezoneString[3109:3123], _TimezoneString[3123:3134], _TimezoneString[3134:3149], _TimezoneString[3149:3159], _TimezoneString[3159:3172], _TimezoneString[3172:3182], _TimezoneString[3182:3193], _TimezoneString[3193:3203], _TimezoneString[3203:3214], _TimezoneString[3214:3225], _TimezoneString[3225:3234], _TimezoneString[3234:3247], _TimezoneString[3247:3261], _TimezoneString[3261:3275], _TimezoneString[3275:3289], _TimezoneString[3289:3307], _TimezoneString[3307:3321], _TimezoneString[3321:3335], _TimezoneString[3335:3345], _TimezoneString[3345:3356], _TimezoneString[3356:3368], _TimezoneString[3368:3382], _TimezoneString[3382:3393], _TimezoneString[3393:3404], _TimezoneString[3404:3417], _TimezoneString[3417:3432], _TimezoneString[3432:3446], _TimezoneString[3446:3458], _TimezoneString[3458:3472], _TimezoneString[3472:3489], _TimezoneString[3489:3499], _TimezoneString[3499:3512], _TimezoneString[3512:3531], _TimezoneString[3531:3546], _TimezoneString[3546:3563], _TimezoneString[3563:3575], _TimezoneString[3575:3590], _TimezoneString[3590:3603], _TimezoneString[3603:3615], _TimezoneString[3615:3632], _TimezoneString[3632:3646], _TimezoneString[3646:3664], _TimezoneString[3664:3681], _TimezoneString[3681:3697], _TimezoneString[3697:3711], _TimezoneString[3711:3733], _TimezoneString[3733:3747], _TimezoneString[3747:3764], _TimezoneString[3764:3782], _TimezoneString[3782:3799], _TimezoneString[3799:3815], _TimezoneString[3815:3829], _TimezoneString[3829:3848], _TimezoneString[3848:3865], _TimezoneString[3865:3880], _TimezoneString[3880:3895], _TimezoneString[3895:3908], _TimezoneString[3908:3922], _TimezoneString[3922:3937], _TimezoneString[3937:3952], _TimezoneString[3952:3965], _TimezoneString[3965:3980], _TimezoneString[3980:3989], _TimezoneString[3989:4005], _TimezoneString[4005:4021], _TimezoneString[4021:4039], _TimezoneString[4039:4056], _TimezoneString[4056:4069], _TimezoneString[4069:4080], _TimezoneString[4080:4095], _TimezoneString[4095:4108], _TimezoneString[4108:4122], _TimezoneString[4122:4138], _TimezoneString[4138:4152], _TimezoneString[4152:4168], _TimezoneString[4168:4183], _TimezoneString[4183:4198], _TimezoneString[4198:4211], _TimezoneString[4211:4223], _TimezoneString[4223:4235], _TimezoneString[4235:4250], _TimezoneString[4250:4264], _TimezoneString[4264:4275], _TimezoneString[4275:4286], _TimezoneString[4286:4298], _TimezoneString[4298:4311], _TimezoneString[4311:4320], _TimezoneString[4320:4331], _TimezoneString[4331:4345], _TimezoneString[4345:4365], _TimezoneString[4365:4385], _TimezoneString[4385:4401], _TimezoneString[4401:4413], _TimezoneString[4413:4424], _TimezoneString[4424:4440], _TimezoneString[4440:4453], _TimezoneString[4453:4468], _TimezoneString[4468:4484], _TimezoneString[4484:4497], _TimezoneString[4497:4516], _TimezoneString[4516:4526], _TimezoneString[4526:4542], _TimezoneString[4542:4553], _TimezoneString[4553:4565], _TimezoneString[4565:4575], _TimezoneString[4575:4587], _TimezoneString[4587:4601], _TimezoneString[4601:4614], _TimezoneString[4614:4630], _TimezoneString[4630:4642], _TimezoneString[4642:4659], _TimezoneString[4659:4675], _TimezoneString[4675:4684], _TimezoneString[4684:4697], _TimezoneString[4697:4715], _TimezoneString[4715:4725], _TimezoneString[4725:4738], _TimezoneString[4738:4754], _TimezoneString[4754:4766], _TimezoneString[4766:4784], _TimezoneString[4784:4800], _TimezoneString[4800:4818], _TimezoneString[4818:4830], _TimezoneString[4830:4843], _TimezoneString[4843:4856], _TimezoneString[4856:4870], _TimezoneString[4870:4886], _TimezoneString[4886:4902], _TimezoneString[4902:4915], _TimezoneString[4915:4929], _TimezoneString[4929:4950], _TimezoneString[4950:4968], _TimezoneString[4968:4984], _TimezoneString[4984:5000], _TimezoneString[5000:5015], _TimezoneString[5015:5031], _TimezoneString[5031:5049], _TimezoneString[5049:5061], _TimezoneString[5061:5078], _TimezoneString[5078:5093], _TimezoneString[5093:5104], _TimezoneString[5104:5116], _TimezoneString[5116:5131], _TimezoneString[5131:5142], _TimezoneString[5142:5157], _TimezoneString[5157:5171], _TimezoneString[5171:5192], _TimezoneString[5192:5209], _TimezoneString[5209:5225], _TimezoneString[5225:5244], _TimezoneString[5244:5260], _TimezoneString[5260:5279], _TimezoneString[5279:5301], _TimezoneString[5301:5312], _TimezoneString[5312:5324], _TimezoneString[5324:5339], _TimezoneString[5339:5352], _TimezoneString[5352:5364], _TimezoneString[5364:5379], _TimezoneString[5379:5397], _TimezoneString[5397:5416], _TimezoneString[5416:5430], _TimezoneString[5430:5446], _TimezoneString[5446:5459], _TimezoneString[5459:5472], _TimezoneString[5472:5483], _TimezoneString[5483:5496], _TimezoneString[5496:5516], _TimezoneString[5516:5528], _TimezoneString[5528:5537], _TimezoneString[5537:5548], _TimezoneString[5548:5563], _TimezoneString[5563:5580], _TimezoneString[5580:5601], _TimezoneString[5601:5613], _TimezoneString[5613:5628], _TimezoneString[5628:5641], _TimezoneString[5641:5659], _TimezoneString[5659:5675], _TimezoneString[5675:5689], _TimezoneString[5689:5700], _TimezoneString[5700:5717], _TimezoneString[5717:5732], _TimezoneString[5732:5749], _TimezoneString[5749:5759], _TimezoneString[5759:5772], _TimezoneString[5772:5784], _TimezoneString[5784:5801], _TimezoneString[5801:5814], _TimezoneString[5814:5829], _TimezoneString[5829:5843], _TimezoneString[5843:5858], _TimezoneString[5858:5886], _TimezoneString[5886:5906], _TimezoneString[5906:5929], _TimezoneString[5929:5955], _TimezoneString[5955:5980], _TimezoneString[5980:6001], _TimezoneString[6001:6026], _TimezoneString[6026:6049], _TimezoneString[6049:6063], _TimezoneString[6063:6090], _TimezoneString[6090:6117], _TimezoneString[6117:6136], _TimezoneString[6136:6153], _TimezoneString[6153:6171], _TimezoneString[6171:6187], _TimezoneString[6187:6199], _TimezoneString[6199:6226], _TimezoneString[6226:6253], _TimezoneString[6253:6283], _TimezoneString[6283:6298], _TimezoneString[6298:6311], _TimezoneString[6311:6326], _TimezoneString[6326:6342], _TimezoneString[6342:6356], _TimezoneString[6356:6368], _TimezoneString[6368:6386], _TimezoneString[6386:6400], _TimezoneString[6400:6413], _TimezoneString[6413:6426], _TimezoneString[6426:6441], _TimezoneString[6441:6457], _TimezoneString[6457:6472], _TimezoneString[6472:6489], _TimezoneString[6489:6503], _TimezoneString[6503:6518], _TimezoneString[6518:6527], _TimezoneString[6527:6540], _TimezoneString[6540:6553], _TimezoneString[6553:6569]}
+	_HTTPMethodValues  = [4]HTTPMethod{1, 2, 3, 4}
+	_HTTPMethodStrings = [4]string{_HTTPMethodString[0:3], _HTTPMethodString[3:7], _HTTPMethodString[7:14], _HTTPMethodString[14:28]}
 )
 
-// TimezoneValues returns all values of the enum.
-func TimezoneValues() []Timezone {
-	cp := _TimezoneValues
+// _HTTPMethodNoOp is a compile time assertion.
+// An "invalid argument/out of bounds" compiler error signifies that the enum values have changed.
+// Re-run the enumer command to generate an updated version of HTTPMethod.
+func _HTTPMethodNoOp() {
+	var x [1]struct{}
+	_ = x[HTTPMethodGet-(1)]
+	_ = x[HTTPMethodPost-(2)]
+	_ = x[HTTPMethodOptions-(3)]
+	_ = x[HTTPMethodPurge-(4)]
+}
+
+// HTTPMethodValues returns all values of the enum.
+func HTTPMethodValues() []HTTPMethod {
+	cp := _HTTPMethodValues
 	return cp[:]
 }
 
-// TimezoneStrings returns a slice of all String values of the enum.
-func TimezoneStrings() []string {
-	cp := _TimezoneStrings
+// HTTPMethodStrings returns a slice of all String values of the enum.
+func HTTPMethodStrings() []string {
+	cp := _HTTPMethodStrings
 	return cp[:]
 }
 
+// Values returns all values of the enum.
+func (HTTPMethod) Values() []HTTPMethod {
+	return HTTPMethodValues()
+}
+
 // IsValid tests whether the value is a valid enum value.
-func (_t Timezone) IsValid() bool {
-	return _t >= 1 && _t <= 424
+func (_h HTTPMethod) IsValid() bool {
+	return _h >= 1 && _h <= 4
 }
 
 // Validate whether the value is within the range of enum values.
-func (_t Timezone) Validate() error {
-	if !_t.IsValid() {
-		return fmt.Errorf("Timezone(%d) is %w", _t, ErrNoValidEnum)
+func (_h HTTPMethod) Validate() error {
+	if !_h.IsValid() {
+		return fmt.Errorf("HTTPMethod(%d) is %w", _h, ErrNoValidEnum)
 	}
 	return nil
 }
 
 // String returns the string of the enum value.
 // If the enum value is invalid, it will produce a string
-// of the following pattern Timezone(%d) instead.
-func (_t Timezone) String() string {
-	if !_t.IsValid() {
-		return fmt.Sprintf("Timezone(%d)", _t)
+// of the following pattern HTTPMethod(%d) instead.
+func (_h HTTPMethod) String() string {
+	if !_h.IsValid() {
+		return fmt.Sprintf("HTTPMethod(%d)", _h)
 	}
-	idx := uint(_t) - 1
-	return _TimezoneStrings[idx]
+	idx := uint(_h) - 1
+	return _HTTPMethodStrings[idx]
+}
+
+// Index returns the position of the value within the ordered values of the enum
+// (ignoring alternative values) or -1 if the value is invalid.
+func (_h HTTPMethod) Index() int {
+	if !_h.IsValid() {
+		return -1
+	}
+	idx := int(_h) - 1
+	return idx
+}
+
+// Compare returns -1, 0 or +1 depending on whether the value orders before,
+// equal to or after the other value. Invalid values order before all valid values.
+func (_h HTTPMethod) Compare(other HTTPMethod) int {
+	a, b := _h.Index(), other.Index()
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return +1
+	}
+	return 0
+}
+
+// Less tests whether the value orders before the other value.
+func (_h HTTPMethod) Less(other HTTPMethod) bool {
+	return _h.Compare(other) < 0
+}
+
+// Next returns the succeeding value in order of the enum.
+// It returns false if the value is invalid or the last value.
+func (_h HTTPMethod) Next() (HTTPMethod, bool) {
+	idx := _h.Index()
+	if idx == -1 || idx+1 == len(_HTTPMethodValues) {
+		return HTTPMethod(0), false
+	}
+	return _HTTPMethodValues[idx+1], true
+}
+
+// Prev returns the preceding value in order of the enum.
+// It returns false if the value is invalid or the first value.
+func (_h HTTPMethod) Prev() (HTTPMethod, bool) {
+	idx := _h.Index()
+	if idx < 1 {
+		return HTTPMethod(0), false
+	}
+	return _HTTPMethodValues[idx-1], true
 }
 
 var (
-	_TimezoneStringToValueMap = map[string]Timezone{
-		_TimezoneString[0:10]:      1,
-		_TimezoneString[10:23]:     2,
-		_TimezoneString[23:37]:     3,
-		_TimezoneString[37:54]:     4,
-		_TimezoneString[54:68]:     5,
-		_TimezoneString[68:81]:     6,
-		_TimezoneString[81:97]:     7,
-		_TimezoneString[97:113]:    8,
-		_TimezoneString[113:129]:   9,
-		_TimezoneString[129:154]:   10,
-		_TimezoneString[154:171]:   11,
-		_TimezoneString[171:189]:   12,
-		_TimezoneString[189:206]:   13,
-		_TimezoneString[206:224]:   14,
-		_TimezoneString[224:240]:   15,
-		_TimezoneString[240:256]:   16,
-		_TimezoneString[256:273]:   17,
-		_TimezoneString[273:288]:   18,
-		_TimezoneString[288:318]:   19,
-		_TimezoneString[318:345]:   20,
-		_TimezoneString[345:370]:   21,
-		_TimezoneString[370:393]:   22,
-		_TimezoneString[393:419]:   23,
-		_TimezoneString[419:444]:   24,
-		_TimezoneString[444:474]:   25,
-		_TimezoneString[474:497]:   26,
-		_TimezoneString[497:523]:   27,
-		_TimezoneString[523:549]:   28,
-		_TimezoneString[549:574]:   29,
-		_TimezoneString[574:599]:   30,
-		_TimezoneString[599:611]:   31,
-		_TimezoneString[611:624]:   32,
-		_TimezoneString[624:644]:   33,
-		_TimezoneString[644:662]:   34,
-		_TimezoneString[662:680]:   35,
-		_TimezoneString[680:701]:   36,
-		_TimezoneString[701:717]:   37,
-		_TimezoneString[717:732]:   38,
-		_TimezoneString[732:748]:   39,
-		_TimezoneString[748:766]:   40,
-		_TimezoneString[766:785]:   41,
-		_TimezoneString[785:804]:   42,
-		_TimezoneString[804:819]:   43,
-		_TimezoneString[819:835]:   44,
-		_TimezoneString[835:848]:   45,
-		_TimezoneString[848:857]:   46,
-		_TimezoneString[857:871]:   47,
-		_TimezoneString[871:883]:   48,
-		_TimezoneString[883:893]:   49,
-		_TimezoneString[893:909]:   50,
-		_TimezoneString[909:921]:   51,
-		_TimezoneString[921:936]:   52,
-		_TimezoneString[936:950]:   53,
-		_TimezoneString[950:967]:   54,
-		_TimezoneString[967:983]:   55,
-		_TimezoneString[983:995]:   56,
-		_TimezoneString[995:1009]:  57,
-		_TimezoneString[1009:1027]: 58,
-		_TimezoneString[1027:1042]: 59,
-		_TimezoneString[1042:1057]: 60,
-		_TimezoneString[1057:1074]: 61,
-		_TimezoneString[1074:1087]: 62,
-		_TimezoneString[1087:1100]: 63,
-		_TimezoneString[1100:1117]: 64,
-		_TimezoneString[1117:1137]: 65,
-		_TimezoneString[1137:1151]: 66,
-		_TimezoneString[1151:1167]: 67,
-		_TimezoneString[1167:1184]: 68,
-		_TimezoneString[1184:1198]: 69,
-		_TimezoneString[1198:1212]: 70,
-		_TimezoneString[1212:1227]: 71,
-		_TimezoneString[1227:1246]: 72,
-		_TimezoneString[1246:1260]: 73,
-		_TimezoneString[1260:1278]: 74,
-		_TimezoneString[1278:1294]: 75,
-		_TimezoneString[1294:1311]: 76,
-		_TimezoneString[1311:1324]: 77,
-		_TimezoneString[1324:1335]: 78,
-		_TimezoneString[1335:1347]: 79,
-		_TimezoneString[1347:1365]: 80,
-		_TimezoneString[1365:1381]: 81,
-		_TimezoneString[1381:1396]: 82,
-		_TimezoneString[1396:1409]: 83,
-		_TimezoneString[1409:1425]: 84,
-		_TimezoneString[1425:1445]: 85,
-		_TimezoneString[1445:1466]: 86,
-		_TimezoneString[1466:1481]: 87,
-		_TimezoneString[1481:1495]: 88,
-		_TimezoneString[1495:1515]: 89,
-		_TimezoneString[1515:1531]: 90,
-		_TimezoneString[1531:1550]: 91,
-		_TimezoneString[1550:1567]: 92,
-		_TimezoneString[1567:1584]: 93,
-		_TimezoneString[1584:1599]: 94,
-		_TimezoneString[1599:1613]: 95,
-		_TimezoneString[1613:1628]: 96,
-		_TimezoneString[1628:1643]: 97,
-		_TimezoneString[1643:1658]: 98,
-		_TimezoneString[1658:1677]: 99,
-		_TimezoneString[1677:1696]: 100,
-		_TimezoneString[1696:1716]: 101,
-		_TimezoneString[1716:1730]: 102,
-		_TimezoneString[1730:1746]: 103,
-		_TimezoneString[1746:1762]: 104,
-		_TimezoneString[1762:1783]: 105,
-		_TimezoneString[1783:1802]: 106,
-		_TimezoneString[1802:1817]: 107,
-		_TimezoneString[1817:1834]: 108,
+	_HTTPMethodStringToValueMap = map[string]HTTPMethod{
+		_HTTPMethodString[0:3]:   HTTPMethodGet,
+		_HTTPMethodString[3:7]:   HTTPMethodPost,
+		_HTTPMethodString[7:14]:  HTTPMethodOptions,
+		_HTTPMethodString[14:28]: HTTPMethodPurge,
+	}
+)
+
+// _HTTPMethodLookupFold determines the enum value of the string regardless of its case
+// by Unicode simple folding. It scans the String values, as a fold table is only generated
+// for enums with the "ignore-case" feature.
+func _HTTPMethodLookupFold(raw string) (HTTPMethod, bool) {
+	for idx := range _HTTPMethodStrings {
+		if strings.EqualFold(_HTTPMethodStrings[idx], raw) {
+			return _HTTPMethodValues[idx], true
+		}
+	}
+	return HTTPMethod(0), false
+}
+
+// HTTPMethodFromString determines the enum value with an exact case match.
+func HTTPMethodFromString(raw string) (HTTPMethod, bool) {
+	v, ok := _HTTPMethodStringToValueMap[raw]
+	if !ok {
+		return HTTPMethod(0), false
+	}
+	return v, true
+}
+
+// HTTPMethodFromStringIgnoreCase determines the enum value with a case-insensitive match
+// by Unicode simple folding, regardless of the casing of raw.
+func HTTPMethodFromStringIgnoreCase(raw string) (HTTPMethod, bool) {
+	v, ok := HTTPMethodFromString(raw)
+	if ok {
+		return v, ok
+	}
+	v, ok = _HTTPMethodLookupFold(raw)
+	if !ok {
+		return HTTPMethod(0), false
+	}
+	return v, true
+}
+
+// Set implements the flag.Value interface for HTTPMethod.
+// It also satisfies the pflag.Value interface (github.com/spf13/pflag) along with Type.
+func (_h *HTTPMethod) Set(value string) error {
+	v, ok := HTTPMethodFromString(value)
+	if !ok {
+		return fmt.Errorf("%w (allowed values: %s)", _enumer.NewParseError("HTTPMethod", value, HTTPMethodStrings()), strings.Join(HTTPMethodStrings(), ", "))
+	}
+	*_h = v
+	return nil
+}
+
+// Type returns the name of the value type for the pflag.Value interface (github.com/spf13/pflag).
+func (HTTPMethod) Type() string {
+	return "HTTPMethod"
+}
+
+// Decode implements the envconfig.Decoder interface (github.com/kelseyhightower/envconfig) for HTTPMethod.
+func (_h *HTTPMethod) Decode(value string) error {
+	return _h.Set(value)
+}
+
+// MarshalJSON implements the json.Marshaler interface for HTTPMethod.
+func (_h HTTPMethod) MarshalJSON() ([]byte, error) {
+	if err := _h.Validate(); err != nil {
+		return nil, fmt.Errorf("Cannot marshal value %q as HTTPMethod. %w", _h, err)
+	}
+	return json.Marshal(_h.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for HTTPMethod.
+func (_h *HTTPMethod) UnmarshalJSON(data []byte) error {
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return fmt.Errorf("HTTPMethod should be a string, got %q", data)
+	}
+	if len(str) == 0 {
+		return fmt.Errorf("HTTPMethod cannot be derived from empty string")
+	}
+
+	var ok bool
+	*_h, ok = HTTPMethodFromString(str)
+	if !ok {
+		return _enumer.NewParseError("HTTPMethod", str, HTTPMethodStrings())
+	}
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface for HTTPMethod.
+func (_h HTTPMethod) MarshalText() ([]byte, error) {
+	return _h.AppendText(nil)
+}
+
+// AppendText implements the encoding.TextAppender interface for HTTPMethod.
+func (_h HTTPMethod) AppendText(b []byte) ([]byte, error) {
+	if err := _h.Validate(); err != nil {
+		return b, fmt.Errorf("Cannot marshal value %q as HTTPMethod. %w", _h, err)
+	}
+	return append(b, _h.String()...), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface for HTTPMethod.
+func (_h *HTTPMethod) UnmarshalText(text []byte) error {
+	str := string(text)
+	if len(str) == 0 {
+		return fmt.Errorf("HTTPMethod cannot be derived from empty string")
+	}
+
+	var ok bool
+	*_h, ok = HTTPMethodFromString(str)
+	if !ok {
+		return _enumer.NewParseError("HTTPMethod", str, HTTPMethodStrings())
+	}
+	return nil
+}
+
+func init() {
+	_enumer.Register("github.com/mvrahden/go-enumer/examples/project.HTTPMethod", HTTPMethodValues, HTTPMethodFromString)
+}
+
+var _HTTPMethodCompletions = [4]string{
+	"GET\tRequests a representation of the resource.",
+	"POST\tSubmits an entity to the resource.",
+	"options",
+	"x-legacy-purge",
+}
+
+// HTTPMethodUsage returns a usage hint of all String values of the enum, less the deprecated values,
+// e.g. for flag descriptions.
+func HTTPMethodUsage() string {
+	return "one of: " + strings.Join(_HTTPMethodStrings[:], "|")
+}
+
+// HTTPMethodCompletions returns the completion candidates of the enum matching the given prefix,
+// less the deprecated values. Each candidate is formatted as "<value>" or "<value>\t<description>",
+// as expected by shell completions, e.g. of cobra's RegisterFlagCompletionFunc.
+func HTTPMethodCompletions(toComplete string) []string {
+	out := make([]string, 0, len(_HTTPMethodCompletions))
+	for _, c := range _HTTPMethodCompletions {
+		if strings.HasPrefix(c, toComplete) {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+const (
+	_PaymentMethodString = "credit-cardchequecheckbank-transferdirect-debit"
+)
+
+var (
+	_PaymentMethodValues        = [4]PaymentMethod{1, 2, 3, 4}
+	_PaymentMethodStrings       = [4]string{_PaymentMethodString[0:11], _PaymentMethodString[11:17], _PaymentMethodString[22:35], _PaymentMethodString[35:47]}
+	_PaymentMethodActiveValues  = [2]PaymentMethod{1, 3}
+	_PaymentMethodActiveStrings = [2]string{_PaymentMethodString[0:11], _PaymentMethodString[22:35]}
+)
+
+// _PaymentMethodNoOp is a compile time assertion.
+// An "invalid argument/out of bounds" compiler error signifies that the enum values have changed.
+// Re-run the enumer command to generate an updated version of PaymentMethod.
+func _PaymentMethodNoOp() {
+	var x [1]struct{}
+	_ = x[PaymentMethodCreditCard-(1)]
+	_ = x[PaymentMethodCheque-(2)]
+	_ = x[PaymentMethodCheck-(2)]
+	_ = x[PaymentMethodBankTransfer-(3)]
+	_ = x[PaymentMethodDirectDebit-(4)]
+}
+
+// PaymentMethodValues returns all values of the enum.
+func PaymentMethodValues() []PaymentMethod {
+	cp := _PaymentMethodValues
+	return cp[:]
+}
+
+// PaymentMethodStrings returns a slice of all String values of the enum.
+func PaymentMethodStrings() []string {
+	cp := _PaymentMethodStrings
+	return cp[:]
+}
+
+// Values returns all values of the enum.
+func (PaymentMethod) Values() []PaymentMethod {
+	return PaymentMethodValues()
+}
+
+// PaymentMethodActiveValues returns all values of the enum, less the deprecated values.
+func PaymentMethodActiveValues() []PaymentMethod {
+	cp := _PaymentMethodActiveValues
+	return cp[:]
+}
+
+// PaymentMethodActiveStrings returns a slice of all String values of the enum, less the deprecated values.
+func PaymentMethodActiveStrings() []string {
+	cp := _PaymentMethodActiveStrings
+	return cp[:]
+}
+
+// IsValid tests whether the value is a valid enum value.
+func (_p PaymentMethod) IsValid() bool {
+	return _p >= 1 && _p <= 4
+}
+
+// Validate whether the value is within the range of enum values.
+func (_p PaymentMethod) Validate() error {
+	if !_p.IsValid() {
+		return fmt.Errorf("PaymentMethod(%d) is %w", _p, ErrNoValidEnum)
+	}
+	return nil
+}
+
+// String returns the string of the enum value.
+// If the enum value is invalid, it will produce a string
+// of the following pattern PaymentMethod(%d) instead.
+func (_p PaymentMethod) String() string {
+	if !_p.IsValid() {
+		return fmt.Sprintf("PaymentMethod(%d)", _p)
+	}
+	idx := uint(_p) - 1
+	return _PaymentMethodStrings[idx]
+}
+
+// Index returns the position of the value within the ordered values of the enum
+// (ignoring alternative values) or -1 if the value is invalid.
+func (_p PaymentMethod) Index() int {
+	if !_p.IsValid() {
+		return -1
+	}
+	idx := int(_p) - 1
+	return idx
+}
+
+// Compare returns -1, 0 or +1 depending on whether the value orders before,
+// equal to or after the other value. Invalid values order before all valid values.
+func (_p PaymentMethod) Compare(other PaymentMethod) int {
+	a, b := _p.Index(), other.Index()
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return +1
+	}
+	return 0
+}
+
+// Less tests whether the value orders before the other value.
+func (_p PaymentMethod) Less(other PaymentMethod) bool {
+	return _p.Compare(other) < 0
+}
+
+// Next returns the succeeding value in order of the enum.
+// It returns false if the value is invalid or the last value.
+func (_p PaymentMethod) Next() (PaymentMethod, bool) {
+	idx := _p.Index()
+	if idx == -1 || idx+1 == len(_PaymentMethodValues) {
+		return PaymentMethod(0), false
+	}
+	return _PaymentMethodValues[idx+1], true
+}
+
+// Prev returns the preceding value in order of the enum.
+// It returns false if the value is invalid or the first value.
+func (_p PaymentMethod) Prev() (PaymentMethod, bool) {
+	idx := _p.Index()
+	if idx < 1 {
+		return PaymentMethod(0), false
+	}
+	return _PaymentMethodValues[idx-1], true
+}
+
+// IsDeprecated tests whether the value is deprecated.
+// Deprecated values can still be deserialized, but should no longer be used.
+func (_p PaymentMethod) IsDeprecated() bool {
+	switch _p {
+	case 2, 4:
+		return true
+	}
+	return false
+}
+
+var (
+	_PaymentMethodStringToValueMap = map[string]PaymentMethod{
+		_PaymentMethodString[0:11]:  PaymentMethodCreditCard,
+		_PaymentMethodString[11:17]: PaymentMethodCheque,
+		_PaymentMethodString[17:22]: PaymentMethodCheck,
+		_PaymentMethodString[22:35]: PaymentMethodBankTransfer,
+		_PaymentMethodString[35:47]: PaymentMethodDirectDebit,
+	}
+)
+
+// _PaymentMethodLookupFold determines the enum value of the string regardless of its case
+// by Unicode simple folding. It scans the String values, as a fold table is only generated
+// for enums with the "ignore-case" feature.
+func _PaymentMethodLookupFold(raw string) (PaymentMethod, bool) {
+	for idx := range _PaymentMethodStrings {
+		if strings.EqualFold(_PaymentMethodStrings[idx], raw) {
+			return _PaymentMethodValues[idx], true
+		}
+	}
+	if strings.EqualFold(_PaymentMethodString[17:22], raw) {
+		return PaymentMethodCheck, true
+	}
+	return PaymentMethod(0), false
+}
+
+// PaymentMethodOnDeprecated is an optional hook, which is called whenever
+// a deprecated value of PaymentMethod is determined from its string representation.
+var PaymentMethodOnDeprecated func(v PaymentMethod)
+
+// PaymentMethodFromString determines the enum value with an exact case match.
+func PaymentMethodFromString(raw string) (PaymentMethod, bool) {
+	v, ok := _PaymentMethodStringToValueMap[raw]
+	if !ok {
+		return PaymentMethod(0), false
+	}
+	if PaymentMethodOnDeprecated != nil && v.IsDeprecated() {
+		PaymentMethodOnDeprecated(v)
+	}
+	return v, true
+}
+
+// PaymentMethodFromStringIgnoreCase determines the enum value with a case-insensitive match
+// by Unicode simple folding, regardless of the casing of raw.
+func PaymentMethodFromStringIgnoreCase(raw string) (PaymentMethod, bool) {
+	v, ok := PaymentMethodFromString(raw)
+	if ok {
+		return v, ok
+	}
+	v, ok = _PaymentMethodLookupFold(raw)
+	if !ok {
+		return PaymentMethod(0), false
+	}
+	if PaymentMethodOnDeprecated != nil && v.IsDeprecated() {
+		PaymentMethodOnDeprecated(v)
+	}
+	return v, true
+}
+
+// MarshalJSON implements the json.Marshaler interface for PaymentMethod.
+func (_p PaymentMethod) MarshalJSON() ([]byte, error) {
+	if err := _p.Validate(); err != nil {
+		return nil, fmt.Errorf("Cannot marshal value %q as PaymentMethod. %w", _p, err)
+	}
+	return json.Marshal(_p.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for PaymentMethod.
+func (_p *PaymentMethod) UnmarshalJSON(data []byte) error {
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return fmt.Errorf("PaymentMethod should be a string, got %q", data)
+	}
+	if len(str) == 0 {
+		return fmt.Errorf("PaymentMethod cannot be derived from empty string")
+	}
+
+	var ok bool
+	*_p, ok = PaymentMethodFromString(str)
+	if !ok {
+		return _enumer.NewParseError("PaymentMethod", str, PaymentMethodStrings())
+	}
+	return nil
+}
+
+// Value implements the sql/driver.Valuer interface for PaymentMethod.
+func (_p PaymentMethod) Value() (driver.Value, error) {
+	if err := _p.Validate(); err != nil {
+		return nil, fmt.Errorf("Cannot serialize value %q as PaymentMethod. %w", _p, err)
+	}
+	return _p.String(), nil
+}
+
+// Scan implements the sql/driver.Scanner interface for PaymentMethod.
+func (_p *PaymentMethod) Scan(value interface{}) error {
+	var str string
+	switch v := value.(type) {
+	case []byte:
+		str = string(v)
+	case string:
+		str = v
+	case fmt.Stringer:
+		str = v.String()
+	default:
+		return fmt.Errorf("invalid value of PaymentMethod: %[1]T(%[1]v)", value)
+	}
+	if len(str) == 0 {
+		return fmt.Errorf("PaymentMethod cannot be derived from empty string")
+	}
+
+	var ok bool
+	*_p, ok = PaymentMethodFromString(str)
+	if !ok {
+		return _enumer.NewParseError("PaymentMethod", str, PaymentMethodStrings())
+	}
+	return nil
+}
+
+// LogValue implements the slog.LogValuer interface for PaymentMethod.
+// It logs the String value, the numeric id and whether the value is valid.
+func (_p PaymentMethod) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("value", _p.String()),
+		slog.Uint64("id", uint64(_p)),
+		slog.Bool("valid", _p.IsValid()),
+	)
+}
+
+// MarshalLogObject implements the zapcore.ObjectMarshaler interface for PaymentMethod.
+// It logs the String value, the numeric id and whether the value is valid.
+func (_p PaymentMethod) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddString("value", _p.String())
+	enc.AddUint64("id", uint64(_p))
+	enc.AddBool("valid", _p.IsValid())
+	return nil
+}
+
+var _PaymentMethodCompletions = [2]string{
+	"credit-card",
+	"bank-transfer",
+}
+
+// PaymentMethodUsage returns a usage hint of all String values of the enum, less the deprecated values,
+// e.g. for flag descriptions.
+func PaymentMethodUsage() string {
+	return "one of: " + strings.Join(_PaymentMethodActiveStrings[:], "|")
+}
+
+// PaymentMethodCompletions returns the completion candidates of the enum matching the given prefix,
+// less the deprecated values. Each candidate is formatted as "<value>" or "<value>\t<description>",
+// as expected by shell completions, e.g. of cobra's RegisterFlagCompletionFunc.
+func PaymentMethodCompletions(toComplete string) []string {
+	out := make([]string, 0, len(_PaymentMethodCompletions))
+	for _, c := range _PaymentMethodCompletions {
+		if strings.HasPrefix(c, toComplete) {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+const (
+	_PlanString = "FreeStarterTeamBusinessLegacy"
+)
+
+var (
+	_PlanValues         = [5]Plan{1, 2, 3, 4, 5}
+	_PlanStrings        = [5]string{_PlanString[0:4], _PlanString[4:11], _PlanString[11:15], _PlanString[15:23], _PlanString[23:29]}
+	_PlanActiveValues   = [3]Plan{1, 3, 4}
+	_PlanActiveStrings  = [3]string{_PlanString[0:4], _PlanString[11:15], _PlanString[15:23]}
+	_PlanOrderedValues  = [5]Plan{1, 2, 5, 3, 4}
+	_PlanIndices        = [5]int{0, 1, 3, 4, 2}
+	_PlanLabelLanguages = [2]language.Tag{language.MustParse("en"), language.MustParse("de")}
+	_PlanLabelMatcher   = language.NewMatcher(_PlanLabelLanguages[:])
+	_PlanLabels         = [5][2]string{
+		{"Free", "Kostenlos"},
+		{"Starter", "Einsteiger"},
+		{"Team", "Team"},
+		{"Business", ""},
+		{"Legacy plan", "Altvertrag"},
+	}
+	_PlanAdditionalData = [5]struct {
+		Seats uint16
+	}{
+		{1},
+		{3},
+		{10},
+		{50},
+		{5},
+	}
+)
+
+// PlanValues returns all values of the enum.
+func PlanValues() []Plan {
+	cp := _PlanValues
+	return cp[:]
+}
+
+// PlanStrings returns a slice of all String values of the enum.
+func PlanStrings() []string {
+	cp := _PlanStrings
+	return cp[:]
+}
+
+// Values returns all values of the enum.
+func (Plan) Values() []Plan {
+	return PlanValues()
+}
+
+// PlanActiveValues returns all values of the enum, less the deprecated values.
+func PlanActiveValues() []Plan {
+	cp := _PlanActiveValues
+	return cp[:]
+}
+
+// PlanActiveStrings returns a slice of all String values of the enum, less the deprecated values.
+func PlanActiveStrings() []string {
+	cp := _PlanActiveStrings
+	return cp[:]
+}
+
+// IsValid tests whether the value is a valid enum value.
+func (_p Plan) IsValid() bool {
+	return _p >= 1 && _p <= 5
+}
+
+// Validate whether the value is within the range of enum values.
+func (_p Plan) Validate() error {
+	if !_p.IsValid() {
+		return fmt.Errorf("Plan(%d) is %w", _p, ErrNoValidEnum)
+	}
+	return nil
+}
+
+// String returns the string of the enum value.
+// If the enum value is invalid, it will produce a string
+// of the following pattern Plan(%d) instead.
+func (_p Plan) String() string {
+	if !_p.IsValid() {
+		return fmt.Sprintf("Plan(%d)", _p)
+	}
+	idx := uint(_p) - 1
+	return _PlanStrings[idx]
+}
+
+// Index returns the position of the value within the ordered values of the enum
+// (ignoring alternative values) or -1 if the value is invalid.
+func (_p Plan) Index() int {
+	if !_p.IsValid() {
+		return -1
+	}
+	idx := int(_p) - 1
+	return _PlanIndices[idx]
+}
+
+// Compare returns -1, 0 or +1 depending on whether the value orders before,
+// equal to or after the other value. Invalid values order before all valid values.
+func (_p Plan) Compare(other Plan) int {
+	a, b := _p.Index(), other.Index()
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return +1
+	}
+	return 0
+}
+
+// Less tests whether the value orders before the other value.
+func (_p Plan) Less(other Plan) bool {
+	return _p.Compare(other) < 0
+}
+
+// Next returns the succeeding value in order of the enum.
+// It returns false if the value is invalid or the last value.
+func (_p Plan) Next() (Plan, bool) {
+	idx := _p.Index()
+	if idx == -1 || idx+1 == len(_PlanOrderedValues) {
+		return Plan(0), false
+	}
+	return _PlanOrderedValues[idx+1], true
+}
+
+// Prev returns the preceding value in order of the enum.
+// It returns false if the value is invalid or the first value.
+func (_p Plan) Prev() (Plan, bool) {
+	idx := _p.Index()
+	if idx < 1 {
+		return Plan(0), false
+	}
+	return _PlanOrderedValues[idx-1], true
+}
+
+// IsDeprecated tests whether the value is deprecated.
+// Deprecated values can still be deserialized, but should no longer be used.
+func (_p Plan) IsDeprecated() bool {
+	switch _p {
+	case 2, 5:
+		return true
+	}
+	return false
+}
+
+// PlanLabelLanguages returns the languages of the enum's labels.
+// The first language is used as fallback language.
+func PlanLabelLanguages() []language.Tag {
+	cp := _PlanLabelLanguages
+	return cp[:]
+}
+
+// Label returns the human-readable label of the enum value in the given language.
+// It falls back to the closest available language, then to the label of the
+// fallback language and finally to the String value of the enum.
+func (_p Plan) Label(lang language.Tag) string {
+	if !_p.IsValid() {
+		return _p.String()
+	}
+	idx := uint(_p) - 1
+	labels := _PlanLabels[idx]
+	_, langIdx, _ := _PlanLabelMatcher.Match(lang)
+	if len(labels[langIdx]) > 0 {
+		return labels[langIdx]
+	}
+	if len(labels[0]) > 0 {
+		return labels[0]
+	}
+	return _p.String()
+}
+
+// GetSeats returns the "seats" of the enum value.
+func (_p Plan) GetSeats() uint16 {
+	if !_p.IsValid() {
+		panic(fmt.Errorf("Forbidden access to additional enum data of %q. err: %w", _p, ErrNoValidEnum))
+	}
+	idx := uint(_p) - 1
+	d := _PlanAdditionalData[idx]
+	return d.Seats
+}
+
+var (
+	_PlanStringToValueMap = map[string]Plan{
+		_PlanString[0:4]:   1,
+		_PlanString[4:11]:  2,
+		_PlanString[11:15]: 3,
+		_PlanString[15:23]: 4,
+		_PlanString[23:29]: 5,
+	}
+)
+
+// _PlanLookupFold determines the enum value of the string regardless of its case
+// by Unicode simple folding. It scans the String values, as a fold table is only generated
+// for enums with the "ignore-case" feature.
+func _PlanLookupFold(raw string) (Plan, bool) {
+	for idx := range _PlanStrings {
+		if strings.EqualFold(_PlanStrings[idx], raw) {
+			return _PlanValues[idx], true
+		}
+	}
+	return Plan(0), false
+}
+
+// PlanOnDeprecated is an optional hook, which is called whenever
+// a deprecated value of Plan is determined from its string representation.
+var PlanOnDeprecated func(v Plan)
+
+// PlanFromString determines the enum value with an exact case match.
+func PlanFromString(raw string) (Plan, bool) {
+	v, ok := _PlanStringToValueMap[raw]
+	if !ok {
+		return Plan(0), false
+	}
+	if PlanOnDeprecated != nil && v.IsDeprecated() {
+		PlanOnDeprecated(v)
+	}
+	return v, true
+}
+
+// PlanFromStringIgnoreCase determines the enum value with a case-insensitive match
+// by Unicode simple folding, regardless of the casing of raw.
+func PlanFromStringIgnoreCase(raw string) (Plan, bool) {
+	v, ok := PlanFromString(raw)
+	if ok {
+		return v, ok
+	}
+	v, ok = _PlanLookupFold(raw)
+	if !ok {
+		return Plan(0), false
+	}
+	if PlanOnDeprecated != nil && v.IsDeprecated() {
+		PlanOnDeprecated(v)
+	}
+	return v, true
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface for Plan.
+func (_p Plan) MarshalBinary() ([]byte, error) {
+	return _p.AppendBinary(nil)
+}
+
+// AppendBinary implements the encoding.BinaryAppender interface for Plan.
+func (_p Plan) AppendBinary(b []byte) ([]byte, error) {
+	if err := _p.Validate(); err != nil {
+		return b, fmt.Errorf("Cannot marshal value %q as Plan. %w", _p, err)
+	}
+	return append(b, _p.String()...), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface for Plan.
+func (_p *Plan) UnmarshalBinary(text []byte) error {
+	str := string(text)
+	if len(str) == 0 {
+		return fmt.Errorf("Plan cannot be derived from empty string")
+	}
+
+	var ok bool
+	*_p, ok = PlanFromString(str)
+	if !ok {
+		return _enumer.NewParseError("Plan", str, PlanStrings())
+	}
+	return nil
+}
+
+// MarshalGQL implements the graphql.Marshaler interface for Plan.
+func (_p Plan) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(_p.String()))
+}
+
+// UnmarshalGQL implements the graphql.Unmarshaler interface for Plan.
+func (_p *Plan) UnmarshalGQL(value interface{}) error {
+	var str string
+	switch v := value.(type) {
+	case []byte:
+		str = string(v)
+	case string:
+		str = v
+	case fmt.Stringer:
+		str = v.String()
+	default:
+		return fmt.Errorf("invalid value of Plan: %[1]T(%[1]v)", value)
+	}
+	if len(str) == 0 {
+		return fmt.Errorf("Plan cannot be derived from empty string")
+	}
+
+	var ok bool
+	*_p, ok = PlanFromString(str)
+	if !ok {
+		return _enumer.NewParseError("Plan", str, PlanStrings())
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface for Plan.
+func (_p Plan) MarshalJSON() ([]byte, error) {
+	if err := _p.Validate(); err != nil {
+		return nil, fmt.Errorf("Cannot marshal value %q as Plan. %w", _p, err)
+	}
+	return json.Marshal(_p.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for Plan.
+func (_p *Plan) UnmarshalJSON(data []byte) error {
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return fmt.Errorf("Plan should be a string, got %q", data)
+	}
+	if len(str) == 0 {
+		return fmt.Errorf("Plan cannot be derived from empty string")
+	}
+
+	var ok bool
+	*_p, ok = PlanFromString(str)
+	if !ok {
+		return _enumer.NewParseError("Plan", str, PlanStrings())
+	}
+	return nil
+}
+
+// Value implements the sql/driver.Valuer interface for Plan.
+func (_p Plan) Value() (driver.Value, error) {
+	if err := _p.Validate(); err != nil {
+		return nil, fmt.Errorf("Cannot serialize value %q as Plan. %w", _p, err)
+	}
+	return _p.String(), nil
+}
+
+// Scan implements the sql/driver.Scanner interface for Plan.
+func (_p *Plan) Scan(value interface{}) error {
+	var str string
+	switch v := value.(type) {
+	case []byte:
+		str = string(v)
+	case string:
+		str = v
+	case fmt.Stringer:
+		str = v.String()
+	default:
+		return fmt.Errorf("invalid value of Plan: %[1]T(%[1]v)", value)
+	}
+	if len(str) == 0 {
+		return fmt.Errorf("Plan cannot be derived from empty string")
+	}
+
+	var ok bool
+	*_p, ok = PlanFromString(str)
+	if !ok {
+		return _enumer.NewParseError("Plan", str, PlanStrings())
+	}
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface for Plan.
+func (_p Plan) MarshalText() ([]byte, error) {
+	return _p.AppendText(nil)
+}
+
+// AppendText implements the encoding.TextAppender interface for Plan.
+func (_p Plan) AppendText(b []byte) ([]byte, error) {
+	if err := _p.Validate(); err != nil {
+		return b, fmt.Errorf("Cannot marshal value %q as Plan. %w", _p, err)
+	}
+	return append(b, _p.String()...), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface for Plan.
+func (_p *Plan) UnmarshalText(text []byte) error {
+	str := string(text)
+	if len(str) == 0 {
+		return fmt.Errorf("Plan cannot be derived from empty string")
+	}
+
+	var ok bool
+	*_p, ok = PlanFromString(str)
+	if !ok {
+		return _enumer.NewParseError("Plan", str, PlanStrings())
+	}
+	return nil
+}
+
+// MarshalYAML implements a YAML Marshaler for Plan.
+func (_p Plan) MarshalYAML() (interface{}, error) {
+	if err := _p.Validate(); err != nil {
+		return nil, fmt.Errorf("Cannot marshal value %q as Plan. %w", _p, err)
+	}
+	return _p.String(), nil
+}
+
+// UnmarshalYAML implements a YAML Unmarshaler for Plan.
+func (_p *Plan) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var str string
+	if err := unmarshal(&str); err != nil {
+		return err
+	}
+	if len(str) == 0 {
+		return fmt.Errorf("Plan cannot be derived from empty string")
+	}
+
+	var ok bool
+	*_p, ok = PlanFromString(str)
+	if !ok {
+		return _enumer.NewParseError("Plan", str, PlanStrings())
+	}
+	return nil
+}
+
+var _PlanCompletions = [3]string{
+	"Free",
+	"Team",
+	"Business",
+}
+
+// PlanUsage returns a usage hint of all String values of the enum, less the deprecated values,
+// e.g. for flag descriptions.
+func PlanUsage() string {
+	return "one of: " + strings.Join(_PlanActiveStrings[:], "|")
+}
+
+// PlanCompletions returns the completion candidates of the enum matching the given prefix,
+// less the deprecated values. Each candidate is formatted as "<value>" or "<value>\t<description>",
+// as expected by shell completions, e.g. of cobra's RegisterFlagCompletionFunc.
+func PlanCompletions(toComplete string) []string {
+	out := make([]string, 0, len(_PlanCompletions))
+	for _, c := range _PlanCompletions {
+		if strings.HasPrefix(c, toComplete) {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+const (
+	_TimezoneString = "Asia/KabulEurope/TiraneAfrica/AlgiersPacific/Pago_PagoEurope/AndorraAfrica/LuandaAmerica/AnguillaAntarctica/CaseyAntarctica/DavisAntarctica/DumontDUrvilleAntarctica/MawsonAntarctica/McMurdoAntarctica/PalmerAntarctica/RotheraAntarctica/SyowaAntarctica/TrollAntarctica/VostokAmerica/AntiguaAmerica/Argentina/Buenos_AiresAmerica/Argentina/CatamarcaAmerica/Argentina/CordobaAmerica/Argentina/JujuyAmerica/Argentina/La_RiojaAmerica/Argentina/MendozaAmerica/Argentina/Rio_GallegosAmerica/Argentina/SaltaAmerica/Argentina/San_JuanAmerica/Argentina/San_LuisAmerica/Argentina/TucumanAmerica/Argentina/UshuaiaAsia/YerevanAmerica/ArubaAntarctica/MacquarieAustralia/AdelaideAustralia/BrisbaneAustralia/Broken_HillAustralia/DarwinAustralia/EuclaAustralia/HobartAustralia/LindemanAustralia/Lord_HoweAustralia/MelbourneAustralia/PerthAustralia/SydneyEurope/ViennaAsia/BakuAmerica/NassauAsia/BahrainAsia/DhakaAmerica/BarbadosEurope/MinskEurope/BrusselsAmerica/BelizeAfrica/Porto-NovoAtlantic/BermudaAsia/ThimphuAmerica/La_PazAmerica/KralendijkEurope/SarajevoAfrica/GaboroneAmerica/AraguainaAmerica/BahiaAmerica/BelemAmerica/Boa_VistaAmerica/Campo_GrandeAmerica/CuiabaAmerica/EirunepeAmerica/FortalezaAmerica/MaceioAmerica/ManausAmerica/NoronhaAmerica/Porto_VelhoAmerica/RecifeAmerica/Rio_BrancoAmerica/SantaremAmerica/Sao_PauloIndian/ChagosAsia/BruneiEurope/SofiaAfrica/OuagadougouAfrica/BujumburaAsia/Phnom_PenhAfrica/DoualaAmerica/AtikokanAmerica/Blanc-SablonAmerica/Cambridge_BayAmerica/CrestonAmerica/DawsonAmerica/Dawson_CreekAmerica/EdmontonAmerica/Fort_NelsonAmerica/Glace_BayAmerica/Goose_BayAmerica/HalifaxAmerica/InuvikAmerica/IqaluitAmerica/MonctonAmerica/NipigonAmerica/PangnirtungAmerica/Rainy_RiverAmerica/Rankin_InletAmerica/ReginaAmerica/ResoluteAmerica/St_JohnsAmerica/Swift_CurrentAmerica/Thunder_BayAmerica/TorontoAmerica/VancouverAmerica/WhitehorseAmerica/WinnipegAmerica/YellowknifeAtlantic/Cape_VerdeAmerica/CaymanAfrica/BanguiAfrica/NdjamenaAmerica/Punta_ArenasAmerica/SantiagoPacific/EasterAsia/ShanghaiAsia/UrumqiIndian/ChristmasIndian/CocosAmerica/BogotaIndian/ComoroAfrica/BrazzavilleAfrica/KinshasaAfrica/LubumbashiPacific/RarotongaAmerica/Costa_RicaEurope/ZagrebAmerica/HavanaAmerica/CuracaoAsia/FamagustaAsia/NicosiaEurope/PragueAfrica/AbidjanEurope/CopenhagenAfrica/DjiboutiAmerica/DominicaAmerica/Santo_DomingoAmerica/GuayaquilPacific/GalapagosAfrica/CairoAmerica/El_SalvadorAfrica/MalaboAfrica/AsmaraEurope/TallinnAfrica/Addis_AbabaAtlantic/StanleyAtlantic/FaroePacific/FijiEurope/HelsinkiEurope/ParisAmerica/CayennePacific/GambierPacific/MarquesasPacific/TahitiIndian/KerguelenAfrica/LibrevilleAfrica/BanjulAsia/TbilisiEurope/BerlinEurope/BusingenAfrica/AccraEurope/GibraltarEurope/AthensAmerica/DanmarkshavnAmerica/NuukAmerica/ScoresbysundAmerica/ThuleAmerica/GrenadaAmerica/GuadeloupePacific/GuamAmerica/GuatemalaEurope/GuernseyAfrica/ConakryAfrica/BissauAmerica/GuyanaAmerica/Port-au-PrinceEurope/VaticanAmerica/TegucigalpaAsia/Hong_KongEurope/BudapestAtlantic/ReykjavikAsia/KolkataAsia/JakartaAsia/JayapuraAsia/MakassarAsia/PontianakAsia/TehranAsia/BaghdadEurope/DublinEurope/Isle_of_ManAsia/JerusalemEurope/RomeAmerica/JamaicaAsia/TokyoEurope/JerseyAsia/AmmanAsia/AlmatyAsia/AqtauAsia/AqtobeAsia/AtyrauAsia/OralAsia/QostanayAsia/QyzylordaAfrica/NairobiPacific/KantonPacific/KiritimatiPacific/TarawaAsia/PyongyangAsia/SeoulAsia/KuwaitAsia/BishkekAsia/VientianeEurope/RigaAsia/BeirutAfrica/MaseruAfrica/MonroviaAfrica/TripoliEurope/VaduzEurope/VilniusEurope/LuxembourgAsia/MacauEurope/SkopjeIndian/AntananarivoAfrica/BlantyreAsia/Kuala_LumpurAsia/KuchingIndian/MaldivesAfrica/BamakoEurope/MaltaPacific/KwajaleinPacific/MajuroAmerica/MartiniqueAfrica/NouakchottIndian/MauritiusIndian/MayotteAmerica/Bahia_BanderasAmerica/CancunAmerica/ChihuahuaAmerica/HermosilloAmerica/MatamorosAmerica/MazatlanAmerica/MeridaAmerica/Mexico_CityAmerica/MonterreyAmerica/OjinagaAmerica/TijuanaPacific/ChuukPacific/KosraePacific/PohnpeiEurope/ChisinauEurope/MonacoAsia/ChoibalsanAsia/HovdAsia/UlaanbaatarEurope/PodgoricaAmerica/MontserratAfrica/CasablancaAfrica/MaputoAsia/YangonAfrica/WindhoekPacific/NauruAsia/KathmanduEurope/AmsterdamPacific/NoumeaPacific/AucklandPacific/ChathamAmerica/ManaguaAfrica/NiameyAfrica/LagosPacific/NiuePacific/NorfolkPacific/SaipanEurope/OsloAsia/MuscatAsia/KarachiPacific/PalauAsia/GazaAsia/HebronAmerica/PanamaPacific/BougainvillePacific/Port_MoresbyAmerica/AsuncionAmerica/LimaAsia/ManilaPacific/PitcairnEurope/WarsawAtlantic/AzoresAtlantic/MadeiraEurope/LisbonAmerica/Puerto_RicoAsia/QatarEurope/BucharestAsia/AnadyrAsia/BarnaulAsia/ChitaAsia/IrkutskAsia/KamchatkaAsia/KhandygaAsia/KrasnoyarskAsia/MagadanAsia/NovokuznetskAsia/NovosibirskAsia/OmskAsia/SakhalinAsia/SrednekolymskAsia/TomskAsia/Ust-NeraAsia/VladivostokAsia/YakutskAsia/YekaterinburgEurope/AstrakhanEurope/KaliningradEurope/KirovEurope/MoscowEurope/SamaraEurope/SaratovEurope/UlyanovskEurope/VolgogradAfrica/KigaliIndian/ReunionAmerica/St_BarthelemyAtlantic/St_HelenaAmerica/St_KittsAmerica/St_LuciaAmerica/MarigotAmerica/MiquelonAmerica/St_VincentPacific/ApiaEurope/San_MarinoAfrica/Sao_TomeAsia/RiyadhAfrica/DakarEurope/BelgradeIndian/MaheAfrica/FreetownAsia/SingaporeAmerica/Lower_PrincesEurope/BratislavaEurope/LjubljanaPacific/GuadalcanalAfrica/MogadishuAfrica/JohannesburgAtlantic/South_GeorgiaAfrica/JubaAfrica/CeutaAtlantic/CanaryEurope/MadridAsia/ColomboAfrica/KhartoumAmerica/ParamariboArctic/LongyearbyenAfrica/MbabaneEurope/StockholmEurope/ZurichAsia/DamascusAsia/TaipeiAsia/DushanbeAfrica/Dar_es_SalaamAsia/BangkokAsia/DiliAfrica/LomePacific/FakaofoPacific/TongatapuAmerica/Port_of_SpainAfrica/TunisEurope/IstanbulAsia/AshgabatAmerica/Grand_TurkPacific/FunafutiAfrica/KampalaEurope/KievEurope/SimferopolEurope/UzhgorodEurope/ZaporozhyeAsia/DubaiEurope/LondonAmerica/AdakAmerica/AnchorageAmerica/BoiseAmerica/ChicagoAmerica/DenverAmerica/DetroitAmerica/Indiana/IndianapolisAmerica/Indiana/KnoxAmerica/Indiana/MarengoAmerica/Indiana/PetersburgAmerica/Indiana/Tell_CityAmerica/Indiana/VevayAmerica/Indiana/VincennesAmerica/Indiana/WinamacAmerica/JuneauAmerica/Kentucky/LouisvilleAmerica/Kentucky/MonticelloAmerica/Los_AngelesAmerica/MenomineeAmerica/MetlakatlaAmerica/New_YorkAmerica/NomeAmerica/North_Dakota/BeulahAmerica/North_Dakota/CenterAmerica/North_Dakota/New_SalemAmerica/PhoenixAmerica/SitkaAmerica/YakutatPacific/HonoluluPacific/MidwayPacific/WakeAmerica/MontevideoAsia/SamarkandAsia/TashkentPacific/EfateAmerica/CaracasAsia/Ho_Chi_MinhAmerica/TortolaAmerica/St_ThomasPacific/WallisAfrica/El_AaiunAsia/AdenAfrica/LusakaAfrica/HarareEurope/Mariehamn"
+)
+
+var (
+	_TimezoneValues  = [424]Timezone{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55, 56, 57, 58, 59, 60, 61, 62, 63, 64, 65, 66, 67, 68, 69, 70, 71, 72, 73, 74, 75, 76, 77, 78, 79, 80, 81, 82, 83, 84, 85, 86, 87, 88, 89, 90, 91, 92, 93, 94, 95, 96, 97, 98, 99, 100, 101, 102, 103, 104, 105, 106, 107, 108, 109, 110, 111, 112, 113, 114, 115, 116, 117, 118, 119, 120, 121, 122, 123, 124, 125, 126, 127, 128, 129, 130, 131, 132, 133, 134, 135, 136, 137, 138, 139, 140, 141, 142, 143, 144, 145, 146, 147, 148, 149, 150, 151, 152, 153, 154, 155, 156, 157, 158, 159, 160, 161, 162, 163, 164, 165, 166, 167, 168, 169, 170, 171, 172, 173, 174, 175, 176, 177, 178, 179, 180, 181, 182, 183, 184, 185, 186, 187, 188, 189, 190, 191, 192, 193, 194, 195, 196, 197, 198, 199, 200, 201, 202, 203, 204, 205, 206, 207, 208, 209, 210, 211, 212, 213, 214, 215, 216, 217, 218, 219, 220, 221, 222, 223, 224, 225, 226, 227, 228, 229, 230, 231, 232, 233, 234, 235, 236, 237, 238, 239, 240, 241, 242, 243, 244, 245, 246, 247, 248, 249, 250, 251, 252, 253, 254, 255, 256, 257, 258, 259, 260, 261, 262, 263, 264, 265, 266, 267, 268, 269, 270, 271, 272, 273, 274, 275, 276, 277, 278, 279, 280, 281, 282, 283, 284, 285, 286, 287, 288, 289, 290, 291, 292, 293, 294, 295, 296, 297, 298, 299, 300, 301, 302, 303, 304, 305, 306, 307, 308, 309, 310, 311, 312, 313, 314, 315, 316, 317, 318, 319, 320, 321, 322, 323, 324, 325, 326, 327, 328, 329, 330, 331, 332, 333, 334, 335, 336, 337, 338, 339, 340, 341, 342, 343, 344, 345, 346, 347, 348, 349, 350, 351, 352, 353, 354, 355, 356, 357, 358, 359, 360, 361, 362, 363, 364, 365, 366, 367, 368, 369, 370, 371, 372, 373, 374, 375, 376, 377, 378, 379, 380, 381, 382, 383, 384, 385, 386, 387, 388, 389, 390, 391, 392, 393, 394, 395, 396, 397, 398, 399, 400, 401, 402, 403, 404, 405, 406, 407, 408, 409, 410, 411, 412, 413, 414, 415, 416, 417, 418, 419, 420, 421, 422, 423, 424}
+	_TimezoneStrings = [424]string{_TimezoneString[0:10], _TimezoneString[10:23], _TimezoneString[23:37], _TimezoneString[37:54], _TimezoneString[54:68], _TimezoneString[68:81], _TimezoneString[81:97], _TimezoneString[97:113], _TimezoneString[113:129], _TimezoneString[129:154], _TimezoneString[154:171], _TimezoneString[171:189], _TimezoneString[189:206], _TimezoneString[206:224], _TimezoneString[224:240], _TimezoneString[240:256], _TimezoneString[256:273], _TimezoneString[273:288], _TimezoneString[288:318], _TimezoneString[318:345], _TimezoneString[345:370], _TimezoneString[370:393], _TimezoneString[393:419], _TimezoneString[419:444], _TimezoneString[444:474], _TimezoneString[474:497], _TimezoneString[497:523], _TimezoneString[523:549], _TimezoneString[549:574], _TimezoneString[574:599], _TimezoneString[599:611], _TimezoneString[611:624], _TimezoneString[624:644], _TimezoneString[644:662], _TimezoneString[662:680], _TimezoneString[680:701], _TimezoneString[701:717], _TimezoneString[717:732], _TimezoneString[732:748], _TimezoneString[748:766], _TimezoneString[766:785], _TimezoneString[785:804], _TimezoneString[804:819], _TimezoneString[819:835], _TimezoneString[835:848], _TimezoneString[848:857], _TimezoneString[857:871], _TimezoneString[871:883], _TimezoneString[883:893], _TimezoneString[893:909], _TimezoneString[909:921], _TimezoneString[921:936], _TimezoneString[936:950], _TimezoneString[950:967], _TimezoneString[967:983], _TimezoneString[983:995], _TimezoneString[995:1009], _TimezoneString[1009:1027], _TimezoneString[1027:1042], _TimezoneString[1042:1057], _TimezoneString[1057:1074], _TimezoneString[1074:1087], _TimezoneString[1087:1100], _TimezoneString[1100:1117], _TimezoneString[1117:1137], _TimezoneString[1137:1151], _TimezoneString[1151:1167], _TimezoneString[1167:1184], _TimezoneString[1184:1198], _TimezoneString[1198:1212], _TimezoneString[1212:1227], _TimezoneString[1227:1246], _TimezoneString[1246:1260], _TimezoneString[1260:1278], _TimezoneString[1278:1294], _TimezoneString[1294:1311], _TimezoneString[1311:1324], _TimezoneString[1324:1335], _TimezoneString[1335:1347], _TimezoneString[1347:1365], _TimezoneString[1365:1381], _TimezoneString[1381:1396], _TimezoneString[1396:1409], _TimezoneString[1409:1425], _TimezoneString[1425:1445], _TimezoneString[1445:1466], _TimezoneString[1466:1481], _TimezoneString[1481:1495], _TimezoneString[1495:1515], _TimezoneString[1515:1531], _TimezoneString[1531:1550], _TimezoneString[1550:1567], _TimezoneString[1567:1584], _TimezoneString[1584:1599], _TimezoneString[1599:1613], _TimezoneString[1613:1628], _TimezoneString[1628:1643], _TimezoneString[1643:1658], _TimezoneString[1658:1677], _TimezoneString[1677:1696], _TimezoneString[1696:1716], _TimezoneString[1716:1730], _TimezoneString[1730:1746], _TimezoneString[1746:1762], _TimezoneString[1762:1783], _TimezoneString[1783:1802], _TimezoneString[1802:1817], _TimezoneString[1817:1834], _TimezoneString[1834:1852], _TimezoneString[1852:1868], _TimezoneString[1868:1887], _TimezoneString[1887:1906], _TimezoneString[1906:1920], _TimezoneString[1920:1933], _TimezoneString[1933:1948], _TimezoneString[1948:1968], _TimezoneString[1968:1984], _TimezoneString[1984:1998], _TimezoneString[1998:2011], _TimezoneString[2011:2022], _TimezoneString[2022:2038], _TimezoneString[2038:2050], _TimezoneString[2050:2064], _TimezoneString[2064:2077], _TimezoneString[2077:2095], _TimezoneString[2095:2110], _TimezoneString[2110:2127], _TimezoneString[2127:2144], _TimezoneString[2144:2162], _TimezoneString[2162:2175], _TimezoneString[2175:2189], _TimezoneString[2189:2204], _TimezoneString[2204:2218], _TimezoneString[2218:2230], _TimezoneString[2230:2243], _TimezoneString[2243:2257], _TimezoneString[2257:2274], _TimezoneString[2274:2289], _TimezoneString[2289:2305], _TimezoneString[2305:2326], _TimezoneString[2326:2343], _TimezoneString[2343:2360], _TimezoneString[2360:2372], _TimezoneString[2372:2391], _TimezoneString[2391:2404], _TimezoneString[2404:2417], _TimezoneString[2417:2431], _TimezoneString[2431:2449], _TimezoneString[2449:2465], _TimezoneString[2465:2479], _TimezoneString[2479:2491], _TimezoneString[2491:2506], _TimezoneString[2506:2518], _TimezoneString[2518:2533], _TimezoneString[2533:2548], _TimezoneString[2548:2565], _TimezoneString[2565:2579], _TimezoneString[2579:2595], _TimezoneString[2595:2612], _TimezoneString[2612:2625], _TimezoneString[2625:2637], _TimezoneString[2637:2650], _TimezoneString[2650:2665], _TimezoneString[2665:2677], _TimezoneString[2677:2693], _TimezoneString[2693:2706], _TimezoneString[2706:2726], _TimezoneString[2726:2738], _TimezoneString[2738:2758], _TimezoneString[2758:2771], _TimezoneString[2771:2786], _TimezoneString[2786:2804], _TimezoneString[2804:2816], _TimezoneString[2816:2833], _TimezoneString[2833:2848], _TimezoneString[2848:2862], _TimezoneString[2862:2875], _TimezoneString[2875:2889], _TimezoneString[2889:2911], _TimezoneString[2911:2925], _TimezoneString[2925:2944], _TimezoneString[2944:2958], _TimezoneString[2958:2973], _TimezoneString[2973:2991], _TimezoneString[2991:3003], _TimezoneString[3003:3015], _TimezoneString[3015:3028], _TimezoneString[3028:3041], _TimezoneString[3041:3055], _TimezoneString[3055:3066], _TimezoneString[3066:3078], _TimezoneString[3078:3091], _TimezoneString[3091:3109], _TimezoneString[3109:3123], _TimezoneString[3123:3134], _TimezoneString[3134:3149], _TimezoneString[3149:3159], _TimezoneString[3159:3172], _TimezoneString[3172:3182], _TimezoneString[3182:3193], _TimezoneString[3193:3203], _TimezoneString[3203:3214], _TimezoneString[3214:3225], _TimezoneString[3225:3234], _TimezoneString[3234:3247], _TimezoneString[3247:3261], _TimezoneString[3261:3275], _TimezoneString[3275:3289], _TimezoneString[3289:3307], _TimezoneString[3307:3321], _TimezoneString[3321:3335], _TimezoneString[3335:3345], _TimezoneString[3345:3356], _TimezoneString[3356:3368], _TimezoneString[3368:3382], _TimezoneString[3382:3393], _TimezoneString[3393:3404], _TimezoneString[3404:3417], _TimezoneString[3417:3432], _TimezoneString[3432:3446], _TimezoneString[3446:3458], _TimezoneString[3458:3472], _TimezoneString[3472:3489], _TimezoneString[3489:3499], _TimezoneString[3499:3512], _TimezoneString[3512:3531], _TimezoneString[3531:3546], _TimezoneString[3546:3563], _TimezoneString[3563:3575], _TimezoneString[3575:3590], _TimezoneString[3590:3603], _TimezoneString[3603:3615], _TimezoneString[3615:3632], _TimezoneString[3632:3646], _TimezoneString[3646:3664], _TimezoneString[3664:3681], _TimezoneString[3681:3697], _TimezoneString[3697:3711], _TimezoneString[3711:3733], _TimezoneString[3733:3747], _TimezoneString[3747:3764], _TimezoneString[3764:3782], _TimezoneString[3782:3799], _TimezoneString[3799:3815], _TimezoneString[3815:3829], _TimezoneString[3829:3848], _TimezoneString[3848:3865], _TimezoneString[3865:3880], _TimezoneString[3880:3895], _TimezoneString[3895:3908], _TimezoneString[3908:3922], _TimezoneString[3922:3937], _TimezoneString[3937:3952], _TimezoneString[3952:3965], _TimezoneString[3965:3980], _TimezoneString[3980:3989], _TimezoneString[3989:4005], _TimezoneString[4005:4021], _TimezoneString[4021:4039], _TimezoneString[4039:4056], _TimezoneString[4056:4069], _TimezoneString[4069:4080], _TimezoneString[4080:4095], _TimezoneString[4095:4108], _TimezoneString[4108:4122], _TimezoneString[4122:4138], _TimezoneString[4138:4152], _TimezoneString[4152:4168], _TimezoneString[4168:4183], _TimezoneString[4183:4198], _TimezoneString[4198:4211], _TimezoneString[4211:4223], _TimezoneString[4223:4235], _TimezoneString[4235:4250], _TimezoneString[4250:4264], _TimezoneString[4264:4275], _TimezoneString[4275:4286], _TimezoneString[4286:4298], _TimezoneString[4298:4311], _TimezoneString[4311:4320], _TimezoneString[4320:4331], _TimezoneString[4331:4345], _TimezoneString[4345:4365], _TimezoneString[4365:4385], _TimezoneString[4385:4401], _TimezoneString[4401:4413], _TimezoneString[4413:4424], _TimezoneString[4424:4440], _TimezoneString[4440:4453], _TimezoneString[4453:4468], _TimezoneString[4468:4484], _TimezoneString[4484:4497], _TimezoneString[4497:4516], _TimezoneString[4516:4526], _TimezoneString[4526:4542], _TimezoneString[4542:4553], _TimezoneString[4553:4565], _TimezoneString[4565:4575], _TimezoneString[4575:4587], _TimezoneString[4587:4601], _TimezoneString[4601:4614], _TimezoneString[4614:4630], _TimezoneString[4630:4642], _TimezoneString[4642:4659], _TimezoneString[4659:4675], _TimezoneString[4675:4684], _TimezoneString[4684:4697], _TimezoneString[4697:4715], _TimezoneString[4715:4725], _TimezoneString[4725:4738], _TimezoneString[4738:4754], _TimezoneString[4754:4766], _TimezoneString[4766:4784], _TimezoneString[4784:4800], _TimezoneString[4800:4818], _TimezoneString[4818:4830], _TimezoneString[4830:4843], _TimezoneString[4843:4856], _TimezoneString[4856:4870], _TimezoneString[4870:4886], _TimezoneString[4886:4902], _TimezoneString[4902:4915], _TimezoneString[4915:4929], _TimezoneString[4929:4950], _TimezoneString[4950:4968], _TimezoneString[4968:4984], _TimezoneString[4984:5000], _TimezoneString[5000:5015], _TimezoneString[5015:5031], _TimezoneString[5031:5049], _TimezoneString[5049:5061], _TimezoneString[5061:5078], _TimezoneString[5078:5093], _TimezoneString[5093:5104], _TimezoneString[5104:5116], _TimezoneString[5116:5131], _TimezoneString[5131:5142], _TimezoneString[5142:5157], _TimezoneString[5157:5171], _TimezoneString[5171:5192], _TimezoneString[5192:5209], _TimezoneString[5209:5225], _TimezoneString[5225:5244], _TimezoneString[5244:5260], _TimezoneString[5260:5279], _TimezoneString[5279:5301], _TimezoneString[5301:5312], _TimezoneString[5312:5324], _TimezoneString[5324:5339], _TimezoneString[5339:5352], _TimezoneString[5352:5364], _TimezoneString[5364:5379], _TimezoneString[5379:5397], _TimezoneString[5397:5416], _TimezoneString[5416:5430], _TimezoneString[5430:5446], _TimezoneString[5446:5459], _TimezoneString[5459:5472], _TimezoneString[5472:5483], _TimezoneString[5483:5496], _TimezoneString[5496:5516], _TimezoneString[5516:5528], _TimezoneString[5528:5537], _TimezoneString[5537:5548], _TimezoneString[5548:5563], _TimezoneString[5563:5580], _TimezoneString[5580:5601], _TimezoneString[5601:5613], _TimezoneString[5613:5628], _TimezoneString[5628:5641], _TimezoneString[5641:5659], _TimezoneString[5659:5675], _TimezoneString[5675:5689], _TimezoneString[5689:5700], _TimezoneString[5700:5717], _TimezoneString[5717:5732], _TimezoneString[5732:5749], _TimezoneString[5749:5759], _TimezoneString[5759:5772], _TimezoneString[5772:5784], _TimezoneString[5784:5801], _TimezoneString[5801:5814], _TimezoneString[5814:5829], _TimezoneString[5829:5843], _TimezoneString[5843:5858], _TimezoneString[5858:5886], _TimezoneString[5886:5906], _TimezoneString[5906:5929], _TimezoneString[5929:5955], _TimezoneString[5955:5980], _TimezoneString[5980:6001], _TimezoneString[6001:6026], _TimezoneString[6026:6049], _TimezoneString[6049:6063], _TimezoneString[6063:6090], _TimezoneString[6090:6117], _TimezoneString[6117:6136], _TimezoneString[6136:6153], _TimezoneString[6153:6171], _TimezoneString[6171:6187], _TimezoneString[6187:6199], _TimezoneString[6199:6226], _TimezoneString[6226:6253], _TimezoneString[6253:6283], _TimezoneString[6283:6298], _TimezoneString[6298:6311], _TimezoneString[6311:6326], _TimezoneString[6326:6342], _TimezoneString[6342:6356], _TimezoneString[6356:6368], _TimezoneString[6368:6386], _TimezoneString[6386:6400], _TimezoneString[6400:6413], _TimezoneString[6413:6426], _TimezoneString[6426:6441], _TimezoneString[6441:6457], _TimezoneString[6457:6472], _TimezoneString[6472:6489], _TimezoneString[6489:6503], _TimezoneString[6503:6518], _TimezoneString[6518:6527], _TimezoneString[6527:6540], _TimezoneString[6540:6553], _TimezoneString[6553:6569]}
+)
+
+// TimezoneValues returns all values of the enum.
+func TimezoneValues() []Timezone {
+	cp := _TimezoneValues
+	return cp[:]
+}
+
+// TimezoneStrings returns a slice of all String values of the enum.
+func TimezoneStrings() []string {
+	cp := _TimezoneStrings
+	return cp[:]
+}
+
+// Values returns all values of the enum.
+func (Timezone) Values() []Timezone {
+	return TimezoneValues()
+}
+
+// IsValid tests whether the value is a valid enum value.
+func (_t Timezone) IsValid() bool {
+	return _t >= 1 && _t <= 424
+}
+
+// Validate whether the value is within the range of enum values.
+func (_t Timezone) Validate() error {
+	if !_t.IsValid() {
+		return fmt.Errorf("Timezone(%d) is %w", _t, ErrNoValidEnum)
+	}
+	return nil
+}
+
+// String returns the string of the enum value.
+// If the enum value is invalid, it will produce a string
+// of the following pattern Timezone(%d) instead.
+func (_t Timezone) String() string {
+	if !_t.IsValid() {
+		return fmt.Sprintf("Timezone(%d)", _t)
+	}
+	idx := uint(_t) - 1
+	return _TimezoneStrings[idx]
+}
+
+// Index returns the position of the value within the ordered values of the enum
+// (ignoring alternative values) or -1 if the value is invalid.
+func (_t Timezone) Index() int {
+	if !_t.IsValid() {
+		return -1
+	}
+	idx := int(_t) - 1
+	return idx
+}
+
+// Compare returns -1, 0 or +1 depending on whether the value orders before,
+// equal to or after the other value. Invalid values order before all valid values.
+func (_t Timezone) Compare(other Timezone) int {
+	a, b := _t.Index(), other.Index()
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return +1
+	}
+	return 0
+}
+
+// Less tests whether the value orders before the other value.
+func (_t Timezone) Less(other Timezone) bool {
+	return _t.Compare(other) < 0
+}
+
+// Next returns the succeeding value in order of the enum.
+// It returns false if the value is invalid or the last value.
+func (_t Timezone) Next() (Timezone, bool) {
+	idx := _t.Index()
+	if idx == -1 || idx+1 == len(_TimezoneValues) {
+		return Timezone(0), false
+	}
+	return _TimezoneValues[idx+1], true
+}
+
+// Prev returns the preceding value in order of the enum.
+// It returns false if the value is invalid or the first value.
+func (_t Timezone) Prev() (Timezone, bool) {
+	idx := _t.Index()
+	if idx < 1 {
+		return Timezone(0), false
+	}
+	return _TimezoneValues[idx-1], true
+}
+
+var (
+	_TimezoneStringToValueMap = map[string]Timezone{
+		_TimezoneString[0:10]:      1,
+		_TimezoneString[10:23]:     2,
+		_TimezoneString[23:37]:     3,
+		_TimezoneString[37:54]:     4,
+		_TimezoneString[54:68]:     5,
+		_TimezoneString[68:81]:     6,
+		_TimezoneString[81:97]:     7,
+		_TimezoneString[97:113]:    8,
+		_TimezoneString[113:129]:   9,
+		_TimezoneString[129:154]:   10,
+		_TimezoneString[154:171]:   11,
+		_TimezoneString[171:189]:   12,
+		_TimezoneString[189:206]:   13,
+		_TimezoneString[206:224]:   14,
+		_TimezoneString[224:240]:   15,
+		_TimezoneString[240:256]:   16,
+		_TimezoneString[256:273]:   17,
+		_TimezoneString[273:288]:   18,
+		_TimezoneString[288:318]:   19,
+		_TimezoneString[318:345]:   20,
+		_TimezoneString[345:370]:   21,
+		_TimezoneString[370:393]:   22,
+		_TimezoneString[393:419]:   23,
+		_TimezoneString[419:444]:   24,
+		_TimezoneString[444:474]:   25,
+		_TimezoneString[474:497]:   26,
+		_TimezoneString[497:523]:   27,
+		_TimezoneString[523:549]:   28,
+		_TimezoneString[549:574]:   29,
+		_TimezoneString[574:599]:   30,
+		_TimezoneString[599:611]:   31,
+		_TimezoneString[611:624]:   32,
+		_TimezoneString[624:644]:   33,
+		_TimezoneString[644:662]:   34,
+		_TimezoneString[662:680]:   35,
+		_TimezoneString[680:701]:   36,
+		_TimezoneString[701:717]:   37,
+		_TimezoneString[717:732]:   38,
+		_TimezoneString[732:748]:   39,
+		_TimezoneString[748:766]:   40,
+		_TimezoneString[766:785]:   41,
+		_TimezoneString[785:804]:   42,
+		_TimezoneString[804:819]:   43,
+		_TimezoneString[819:835]:   44,
+		_TimezoneString[835:848]:   45,
+		_TimezoneString[848:857]:   46,
+		_TimezoneString[857:871]:   47,
+		_TimezoneString[871:883]:   48,
+		_TimezoneString[883:893]:   49,
+		_TimezoneString[893:909]:   50,
+		_TimezoneString[909:921]:   51,
+		_TimezoneString[921:936]:   52,
+		_TimezoneString[936:950]:   53,
+		_TimezoneString[950:967]:   54,
+		_TimezoneString[967:983]:   55,
+		_TimezoneString[983:995]:   56,
+		_TimezoneString[995:1009]:  57,
+		_TimezoneString[1009:1027]: 58,
+		_TimezoneString[1027:1042]: 59,
+		_TimezoneString[1042:1057]: 60,
+		_TimezoneString[1057:1074]: 61,
+		_TimezoneString[1074:1087]: 62,
+		_TimezoneString[1087:1100]: 63,
+		_TimezoneString[1100:1117]: 64,
+		_TimezoneString[1117:1137]: 65,
+		_TimezoneString[1137:1151]: 66,
+		_TimezoneString[1151:1167]: 67,
+		_TimezoneString[1167:1184]: 68,
+		_TimezoneString[1184:1198]: 69,
+		_TimezoneString[1198:1212]: 70,
+		_TimezoneString[1212:1227]: 71,
+		_TimezoneString[1227:1246]: 72,
+		_TimezoneString[1246:1260]: 73,
+		_TimezoneString[1260:1278]: 74,
+		_TimezoneString[1278:1294]: 75,
+		_TimezoneString[1294:1311]: 76,
+		_TimezoneString[1311:1324]: 77,
+		_TimezoneString[1324:1335]: 78,
+		_TimezoneString[1335:1347]: 79,
+		_TimezoneString[1347:1365]: 80,
+		_TimezoneString[1365:1381]: 81,
+		_TimezoneString[1381:1396]: 82,
+		_TimezoneString[1396:1409]: 83,
+		_TimezoneString[1409:1425]: 84,
+		_TimezoneString[1425:1445]: 85,
+		_TimezoneString[1445:1466]: 86,
+		_TimezoneString[1466:1481]: 87,
+		_TimezoneString[1481:1495]: 88,
+		_TimezoneString[1495:1515]: 89,
+		_TimezoneString[1515:1531]: 90,
+		_TimezoneString[1531:1550]: 91,
+		_TimezoneString[1550:1567]: 92,
+		_TimezoneString[1567:1584]: 93,
+		_TimezoneString[1584:1599]: 94,
+		_TimezoneString[1599:1613]: 95,
+		_TimezoneString[1613:1628]: 96,
+		_TimezoneString[1628:1643]: 97,
+		_TimezoneString[1643:1658]: 98,
+		_TimezoneString[1658:1677]: 99,
+		_TimezoneString[1677:1696]: 100,
+		_TimezoneString[1696:1716]: 101,
+		_TimezoneString[1716:1730]: 102,
+		_TimezoneString[1730:1746]: 103,
+		_TimezoneString[1746:1762]: 104,
+		_TimezoneString[1762:1783]: 105,
+		_TimezoneString[1783:1802]: 106,
+		_TimezoneString[1802:1817]: 107,
+		_TimezoneString[1817:1834]: 108,
 		_TimezoneString[1834:1852]: 109,
 		_TimezoneString[1852:1868]: 110,
 		_TimezoneString[1868:1887]: 111,
@@ -1956,434 +3317,20 @@ var (
 		_TimezoneString[6540:6553]: 423,
 		_TimezoneString[6553:6569]: 424,
 	}
-	_TimezoneLowerStringToValueMap = map[string]Timezone{
-		_TimezoneLowerString[0:10]:      1,
-		_TimezoneLowerString[10:23]:     2,
-		_TimezoneLowerString[23:37]:     3,
-		_TimezoneLowerString[37:54]:     4,
-		_TimezoneLowerString[54:68]:     5,
-		_TimezoneLowerString[68:81]:     6,
-		_TimezoneLowerString[81:97]:     7,
-		_TimezoneLowerString[97:113]:    8,
-		_TimezoneLowerString[113:129]:   9,
-		_TimezoneLowerString[129:154]:   10,
-		_TimezoneLowerString[154:171]:   11,
-		_TimezoneLowerString[171:189]:   12,
-		_TimezoneLowerString[189:206]:   13,
-		_TimezoneLowerString[206:224]:   14,
-		_TimezoneLowerString[224:240]:   15,
-		_TimezoneLowerString[240:256]:   16,
-		_TimezoneLowerString[256:273]:   17,
-		_TimezoneLowerString[273:288]:   18,
-		_TimezoneLowerString[288:318]:   19,
-		_TimezoneLowerString[318:345]:   20,
-		_TimezoneLowerString[345:370]:   21,
-		_TimezoneLowerString[370:393]:   22,
-		_TimezoneLowerString[393:419]:   23,
-		_TimezoneLowerString[419:444]:   24,
-		_TimezoneLowerString[444:474]:   25,
-		_TimezoneLowerString[474:497]:   26,
-		_TimezoneLowerString[497:523]:   27,
-		_TimezoneLowerString[523:549]:   28,
-		_TimezoneLowerString[549:574]:   29,
-		_TimezoneLowerString[574:599]:   30,
-		_TimezoneLowerString[599:611]:   31,
-		_TimezoneLowerString[611:624]:   32,
-		_TimezoneLowerString[624:644]:   33,
-		_TimezoneLowerString[644:662]:   34,
-		_TimezoneLowerString[662:680]:   35,
-		_TimezoneLowerString[680:701]:   36,
-		_TimezoneLowerString[701:717]:   37,
-		_TimezoneLowerString[717:732]:   38,
-		_TimezoneLowerString[732:748]:   39,
-		_TimezoneLowerString[748:766]:   40,
-		_TimezoneLowerString[766:785]:   41,
-		_TimezoneLowerString[785:804]:   42,
-		_TimezoneLowerString[804:819]:   43,
-		_TimezoneLowerString[819:835]:   44,
-		_TimezoneLowerString[835:848]:   45,
-		_TimezoneLowerString[848:857]:   46,
-		_TimezoneLowerString[857:871]:   47,
-		_TimezoneLowerString[871:883]:   48,
-		_TimezoneLowerString[883:893]:   49,
-		_TimezoneLowerString[893:909]:   50,
-		_TimezoneLowerString[909:921]:   51,
-		_TimezoneLowerString[921:936]:   52,
-		_TimezoneLowerString[936:950]:   53,
-		_TimezoneLowerString[950:967]:   54,
-		_TimezoneLowerString[967:983]:   55,
-		_TimezoneLowerString[983:995]:   56,
-		_TimezoneLowerString[995:1009]:  57,
-		_TimezoneLowerString[1009:1027]: 58,
-		_TimezoneLowerString[1027:1042]: 59,
-		_TimezoneLowerString[1042:1057]: 60,
-		_TimezoneLowerString[1057:1074]: 61,
-		_TimezoneLowerString[1074:1087]: 62,
-		_TimezoneLowerString[1087:1100]: 63,
-		_TimezoneLowerString[1100:1117]: 64,
-		_TimezoneLowerString[1117:1137]: 65,
-		_TimezoneLowerString[1137:1151]: 66,
-		_TimezoneLowerString[1151:1167]: 67,
-		_TimezoneLowerString[1167:1184]: 68,
-		_TimezoneLowerString[1184:1198]: 69,
-		_TimezoneLowerString[1198:1212]: 70,
-		_TimezoneLowerString[1212:1227]: 71,
-		_TimezoneLowerString[1227:1246]: 72,
-		_TimezoneLowerString[1246:1260]: 73,
-		_TimezoneLowerString[1260:1278]: 74,
-		_TimezoneLowerString[1278:1294]: 75,
-		_TimezoneLowerString[1294:1311]: 76,
-		_TimezoneLowerString[1311:1324]: 77,
-		_TimezoneLowerString[1324:1335]: 78,
-		_TimezoneLowerString[1335:1347]: 79,
-		_TimezoneLowerString[1347:1365]: 80,
-		_TimezoneLowerString[1365:1381]: 81,
-		_TimezoneLowerString[1381:1396]: 82,
-		_TimezoneLowerString[1396:1409]: 83,
-		_TimezoneLowerString[1409:1425]: 84,
-		_TimezoneLowerString[1425:1445]: 85,
-		_TimezoneLowerString[1445:1466]: 86,
-		_TimezoneLowerString[1466:1481]: 87,
-		_TimezoneLowerString[1481:1495]: 88,
-		_TimezoneLowerString[1495:1515]: 89,
-		_TimezoneLowerString[1515:1531]: 90,
-		_TimezoneLowerString[1531:1550]: 91,
-		_TimezoneLowerString[1550:1567]: 92,
-		_TimezoneLowerString[1567:1584]: 93,
-		_TimezoneLowerString[1584:1599]: 94,
-		_TimezoneLowerString[1599:1613]: 95,
-		_TimezoneLowerString[1613:1628]: 96,
-		_TimezoneLowerString[1628:1643]: 97,
-		_TimezoneLowerString[1643:1658]: 98,
-		_TimezoneLowerString[1658:1677]: 99,
-		_TimezoneLowerString[1677:1696]: 100,
-		_TimezoneLowerString[1696:1716]: 101,
-		_TimezoneLowerString[1716:1730]: 102,
-		_TimezoneLowerString[1730:1746]: 103,
-		_TimezoneLowerString[1746:1762]: 104,
-		_TimezoneLowerString[1762:1783]: 105,
-		_TimezoneLowerString[1783:1802]: 106,
-		_TimezoneLowerString[1802:1817]: 107,
-		_TimezoneLowerString[1817:1834]: 108,
-		_TimezoneLowerString[1834:1852]: 109,
-		_TimezoneLowerString[1852:1868]: 110,
-		_TimezoneLowerString[1868:1887]: 111,
-		_TimezoneLowerString[1887:1906]: 112,
-		_TimezoneLowerString[1906:1920]: 113,
-		_TimezoneLowerString[1920:1933]: 114,
-		_TimezoneLowerString[1933:1948]: 115,
-		_TimezoneLowerString[1948:1968]: 116,
-		_TimezoneLowerString[1968:1984]: 117,
-		_TimezoneLowerString[1984:1998]: 118,
-		_TimezoneLowerString[1998:2011]: 119,
-		_TimezoneLowerString[2011:2022]: 120,
-		_TimezoneLowerString[2022:2038]: 121,
-		_TimezoneLowerString[2038:2050]: 122,
-		_TimezoneLowerString[2050:2064]: 123,
-		_TimezoneLowerString[2064:2077]: 124,
-		_TimezoneLowerString[2077:2095]: 125,
-		_TimezoneLowerString[2095:2110]: 126,
-		_TimezoneLowerString[2110:2127]: 127,
-		_TimezoneLowerString[2127:2144]: 128,
-		_TimezoneLowerString[2144:2162]: 129,
-		_TimezoneLowerString[2162:2175]: 130,
-		_TimezoneLowerString[2175:2189]: 131,
-		_TimezoneLowerString[2189:2204]: 132,
-		_TimezoneLowerString[2204:2218]: 133,
-		_TimezoneLowerString[2218:2230]: 134,
-		_TimezoneLowerString[2230:2243]: 135,
-		_TimezoneLowerString[2243:2257]: 136,
-		_TimezoneLowerString[2257:2274]: 137,
-		_TimezoneLowerString[2274:2289]: 138,
-		_TimezoneLowerString[2289:2305]: 139,
-		_TimezoneLowerString[2305:2326]: 140,
-		_TimezoneLowerString[2326:2343]: 141,
-		_TimezoneLowerString[2343:2360]: 142,
-		_TimezoneLowerString[2360:2372]: 143,
-		_TimezoneLowerString[2372:2391]: 144,
-		_TimezoneLowerString[2391:2404]: 145,
-		_TimezoneLowerString[2404:2417]: 146,
-		_TimezoneLowerString[2417:2431]: 147,
-		_TimezoneLowerString[2431:2449]: 148,
-		_TimezoneLowerString[2449:2465]: 149,
-		_TimezoneLowerString[2465:2479]: 150,
-		_TimezoneLowerString[2479:2491]: 151,
-		_TimezoneLowerString[2491:2506]: 152,
-		_TimezoneLowerString[2506:2518]: 153,
-		_TimezoneLowerString[2518:2533]: 154,
-		_TimezoneLowerString[2533:2548]: 155,
-		_TimezoneLowerString[2548:2565]: 156,
-		_TimezoneLowerString[2565:2579]: 157,
-		_TimezoneLowerString[2579:2595]: 158,
-		_TimezoneLowerString[2595:2612]: 159,
-		_TimezoneLowerString[2612:2625]: 160,
-		_TimezoneLowerString[2625:2637]: 161,
-		_TimezoneLowerString[2637:2650]: 162,
-		_TimezoneLowerString[2650:2665]: 163,
-		_TimezoneLowerString[2665:2677]: 164,
-		_TimezoneLowerString[2677:2693]: 165,
-		_TimezoneLowerString[2693:2706]: 166,
-		_TimezoneLowerString[2706:2726]: 167,
-		_TimezoneLowerString[2726:2738]: 168,
-		_TimezoneLowerString[2738:2758]: 169,
-		_TimezoneLowerString[2758:2771]: 170,
-		_TimezoneLowerString[2771:2786]: 171,
-		_TimezoneLowerString[2786:2804]: 172,
-		_TimezoneLowerString[2804:2816]: 173,
-		_TimezoneLowerString[2816:2833]: 174,
-		_TimezoneLowerString[2833:2848]: 175,
-		_TimezoneLowerString[2848:2862]: 176,
-		_TimezoneLowerString[2862:2875]: 177,
-		_TimezoneLowerString[2875:2889]: 178,
-		_TimezoneLowerString[2889:2911]: 179,
-		_TimezoneLowerString[2911:2925]: 180,
-		_TimezoneLowerString[2925:2944]: 181,
-		_TimezoneLowerString[2944:2958]: 182,
-		_TimezoneLowerString[2958:2973]: 183,
-		_TimezoneLowerString[2973:2991]: 184,
-		_TimezoneLowerString[2991:3003]: 185,
-		_TimezoneLowerString[3003:3015]: 186,
-		_TimezoneLowerString[3015:3028]: 187,
-		_TimezoneLowerString[3028:3041]: 188,
-		_TimezoneLowerString[3041:3055]: 189,
-		_TimezoneLowerString[3055:3066]: 190,
-		_TimezoneLowerString[3066:3078]: 191,
-		_TimezoneLowerString[3078:3091]: 192,
-		_TimezoneLowerString[3091:3109]: 193,
-		_TimezoneLowerString[3109:3123]: 194,
-		_TimezoneLowerString[3123:3134]: 195,
-		_TimezoneLowerString[3134:3149]: 196,
-		_TimezoneLowerString[3149:3159]: 197,
-		_TimezoneLowerString[3159:3172]: 198,
-		_TimezoneLowerString[3172:3182]: 199,
-		_TimezoneLowerString[3182:3193]: 200,
-		_TimezoneLowerString[3193:3203]: 201,
-		_TimezoneLowerString[3203:3214]: 202,
-		_TimezoneLowerString[3214:3225]: 203,
-		_TimezoneLowerString[3225:3234]: 204,
-		_TimezoneLowerString[3234:3247]: 205,
-		_TimezoneLowerString[3247:3261]: 206,
-		_TimezoneLowerString[3261:3275]: 207,
-		_TimezoneLowerString[3275:3289]: 208,
-		_TimezoneLowerString[3289:3307]: 209,
-		_TimezoneLowerString[3307:3321]: 210,
-		_TimezoneLowerString[3321:3335]: 211,
-		_TimezoneLowerString[3335:3345]: 212,
-		_TimezoneLowerString[3345:3356]: 213,
-		_TimezoneLowerString[3356:3368]: 214,
-		_TimezoneLowerString[3368:3382]: 215,
-		_TimezoneLowerString[3382:3393]: 216,
-		_TimezoneLowerString[3393:3404]: 217,
-		_TimezoneLowerString[3404:3417]: 218,
-		_TimezoneLowerString[3417:3432]: 219,
-		_TimezoneLowerString[3432:3446]: 220,
-		_TimezoneLowerString[3446:3458]: 221,
-		_TimezoneLowerString[3458:3472]: 222,
-		_TimezoneLowerString[3472:3489]: 223,
-		_TimezoneLowerString[3489:3499]: 224,
-		_TimezoneLowerString[3499:3512]: 225,
-		_TimezoneLowerString[3512:3531]: 226,
-		_TimezoneLowerString[3531:3546]: 227,
-		_TimezoneLowerString[3546:3563]: 228,
-		_TimezoneLowerString[3563:3575]: 229,
-		_TimezoneLowerString[3575:3590]: 230,
-		_TimezoneLowerString[3590:3603]: 231,
-		_TimezoneLowerString[3603:3615]: 232,
-		_TimezoneLowerString[3615:3632]: 233,
-		_TimezoneLowerString[3632:3646]: 234,
-		_TimezoneLowerString[3646:3664]: 235,
-		_TimezoneLowerString[3664:3681]: 236,
-		_TimezoneLowerString[3681:3697]: 237,
-		_TimezoneLowerString[3697:3711]: 238,
-		_TimezoneLowerString[3711:3733]: 239,
-		_TimezoneLowerString[3733:3747]: 240,
-		_TimezoneLowerString[3747:3764]: 241,
-		_TimezoneLowerString[3764:3782]: 242,
-		_TimezoneLowerString[3782:3799]: 243,
-		_TimezoneLowerString[3799:3815]: 244,
-		_TimezoneLowerString[3815:3829]: 245,
-		_TimezoneLowerString[3829:3848]: 246,
-		_TimezoneLowerString[3848:3865]: 247,
-		_TimezoneLowerString[3865:3880]: 248,
-		_TimezoneLowerString[3880:3895]: 249,
-		_TimezoneLowerString[3895:3908]: 250,
-		_TimezoneLowerString[3908:3922]: 251,
-		_TimezoneLowerString[3922:3937]: 252,
-		_TimezoneLowerString[3937:3952]: 253,
-		_TimezoneLowerString[3952:3965]: 254,
-		_TimezoneLowerString[3965:3980]: 255,
-		_TimezoneLowerString[3980:3989]: 256,
-		_TimezoneLowerString[3989:4005]: 257,
-		_TimezoneLowerString[4005:4021]: 258,
-		_TimezoneLowerString[4021:4039]: 259,
-		_TimezoneLowerString[4039:4056]: 260,
-		_TimezoneLowerString[4056:4069]: 261,
-		_TimezoneLowerString[4069:4080]: 262,
-		_TimezoneLowerString[4080:4095]: 263,
-		_TimezoneLowerString[4095:4108]: 264,
-		_TimezoneLowerString[4108:4122]: 265,
-		_TimezoneLowerString[4122:4138]: 266,
-		_TimezoneLowerString[4138:4152]: 267,
-		_TimezoneLowerString[4152:4168]: 268,
-		_TimezoneLowerString[4168:4183]: 269,
-		_TimezoneLowerString[4183:4198]: 270,
-		_TimezoneLowerString[4198:4211]: 271,
-		_TimezoneLowerString[4211:4223]: 272,
-		_TimezoneLowerString[4223:4235]: 273,
-		_TimezoneLowerString[4235:4250]: 274,
-		_TimezoneLowerString[4250:4264]: 275,
-		_TimezoneLowerString[4264:4275]: 276,
-		_TimezoneLowerString[4275:4286]: 277,
-		_TimezoneLowerString[4286:4298]: 278,
-		_TimezoneLowerString[4298:4311]: 279,
-		_TimezoneLowerString[4311:4320]: 280,
-		_TimezoneLowerString[4320:4331]: 281,
-		_TimezoneLowerString[4331:4345]: 282,
-		_TimezoneLowerString[4345:4365]: 283,
-		_TimezoneLowerString[4365:4385]: 284,
-		_TimezoneLowerString[4385:4401]: 285,
-		_TimezoneLowerString[4401:4413]: 286,
-		_TimezoneLowerString[4413:4424]: 287,
-		_TimezoneLowerString[4424:4440]: 288,
-		_TimezoneLowerString[4440:4453]: 289,
-		_TimezoneLowerString[4453:4468]: 290,
-		_TimezoneLowerString[4468:4484]: 291,
-		_TimezoneLowerString[4484:4497]: 292,
-		_TimezoneLowerString[4497:4516]: 293,
-		_TimezoneLowerString[4516:4526]: 294,
-		_TimezoneLowerString[4526:4542]: 295,
-		_TimezoneLowerString[4542:4553]: 296,
-		_TimezoneLowerString[4553:4565]: 297,
-		_TimezoneLowerString[4565:4575]: 298,
-		_TimezoneLowerString[4575:4587]: 299,
-		_TimezoneLowerString[4587:4601]: 300,
-		_TimezoneLowerString[4601:4614]: 301,
-		_TimezoneLowerString[4614:4630]: 302,
-		_TimezoneLowerString[4630:4642]: 303,
-		_TimezoneLowerString[4642:4659]: 304,
-		_TimezoneLowerString[4659:4675]: 305,
-		_TimezoneLowerString[4675:4684]: 306,
-		_TimezoneLowerString[4684:4697]: 307,
-		_TimezoneLowerString[4697:4715]: 308,
-		_TimezoneLowerString[4715:4725]: 309,
-		_TimezoneLowerString[4725:4738]: 310,
-		_TimezoneLowerString[4738:4754]: 311,
-		_TimezoneLowerString[4754:4766]: 312,
-		_TimezoneLowerString[4766:4784]: 313,
-		_TimezoneLowerString[4784:4800]: 314,
-		_TimezoneLowerString[4800:4818]: 315,
-		_TimezoneLowerString[4818:4830]: 316,
-		_TimezoneLowerString[4830:4843]: 317,
-		_TimezoneLowerString[4843:4856]: 318,
-		_TimezoneLowerString[4856:4870]: 319,
-		_TimezoneLowerString[4870:4886]: 320,
-		_TimezoneLowerString[4886:4902]: 321,
-		_TimezoneLowerString[4902:4915]: 322,
-		_TimezoneLowerString[4915:4929]: 323,
-		_TimezoneLowerString[4929:4950]: 324,
-		_TimezoneLowerString[4950:4968]: 325,
-		_TimezoneLowerString[4968:4984]: 326,
-		_TimezoneLowerString[4984:5000]: 327,
-		_TimezoneLowerString[5000:5015]: 328,
-		_TimezoneLowerString[5015:5031]: 329,
-		_TimezoneLowerString[5031:5049]: 330,
-		_TimezoneLowerString[5049:5061]: 331,
-		_TimezoneLowerString[5061:5078]: 332,
-		_TimezoneLowerString[5078:5093]: 333,
-		_TimezoneLowerString[5093:5104]: 334,
-		_TimezoneLowerString[5104:5116]: 335,
-		_TimezoneLowerString[5116:5131]: 336,
-		_TimezoneLowerString[5131:5142]: 337,
-		_TimezoneLowerString[5142:5157]: 338,
-		_TimezoneLowerString[5157:5171]: 339,
-		_TimezoneLowerString[5171:5192]: 340,
-		_TimezoneLowerString[5192:5209]: 341,
-		_TimezoneLowerString[5209:5225]: 342,
-		_TimezoneLowerString[5225:5244]: 343,
-		_TimezoneLowerString[5244:5260]: 344,
-		_TimezoneLowerString[5260:5279]: 345,
-		_TimezoneLowerString[5279:5301]: 346,
-		_TimezoneLowerString[5301:5312]: 347,
-		_TimezoneLowerString[5312:5324]: 348,
-		_TimezoneLowerString[5324:5339]: 349,
-		_TimezoneLowerString[5339:5352]: 350,
-		_TimezoneLowerString[5352:5364]: 351,
-		_TimezoneLowerString[5364:5379]: 352,
-		_TimezoneLowerString[5379:5397]: 353,
-		_TimezoneLowerString[5397:5416]: 354,
-		_TimezoneLowerString[5416:5430]: 355,
-		_TimezoneLowerString[5430:5446]: 356,
-		_TimezoneLowerString[5446:5459]: 357,
-		_TimezoneLowerString[5459:5472]: 358,
-		_TimezoneLowerString[5472:5483]: 359,
-		_TimezoneLowerString[5483:5496]: 360,
-		_TimezoneLowerString[5496:5516]: 361,
-		_TimezoneLowerString[5516:5528]: 362,
-		_TimezoneLowerString[5528:5537]: 363,
-		_TimezoneLowerString[5537:5548]: 364,
-		_TimezoneLowerString[5548:5563]: 365,
-		_TimezoneLowerString[5563:5580]: 366,
-		_TimezoneLowerString[5580:5601]: 367,
-		_TimezoneLowerString[5601:5613]: 368,
-		_TimezoneLowerString[5613:5628]: 369,
-		_TimezoneLowerString[5628:5641]: 370,
-		_TimezoneLowerString[5641:5659]: 371,
-		_TimezoneLowerString[5659:5675]: 372,
-		_TimezoneLowerString[5675:5689]: 373,
-		_TimezoneLowerString[5689:5700]: 374,
-		_TimezoneLowerString[5700:5717]: 375,
-		_TimezoneLowerString[5717:5732]: 376,
-		_TimezoneLowerString[5732:5749]: 377,
-		_TimezoneLowerString[5749:5759]: 378,
-		_TimezoneLowerString[5759:5772]: 379,
-		_TimezoneLowerString[5772:5784]: 380,
-		_TimezoneLowerString[5784:5801]: 381,
-		_TimezoneLowerString[5801:5814]: 382,
-		_TimezoneLowerString[5814:5829]: 383,
-		_TimezoneLowerString[5829:5843]: 384,
-		_TimezoneLowerString[5843:5858]: 385,
-		_TimezoneLowerString[5858:5886]: 386,
-		_TimezoneLowerString[5886:5906]: 387,
-		_TimezoneLowerString[5906:5929]: 388,
-		_TimezoneLowerString[5929:5955]: 389,
-		_TimezoneLowerString[5955:5980]: 390,
-		_TimezoneLowerString[5980:6001]: 391,
-		_TimezoneLowerString[6001:6026]: 392,
-		_TimezoneLowerString[6026:6049]: 393,
-		_TimezoneLowerString[6049:6063]: 394,
-		_TimezoneLowerString[6063:6090]: 395,
-		_TimezoneLowerString[6090:6117]: 396,
-		_TimezoneLowerString[6117:6136]: 397,
-		_TimezoneLowerString[6136:6153]: 398,
-		_TimezoneLowerString[6153:6171]: 399,
-		_TimezoneLowerString[6171:6187]: 400,
-		_TimezoneLowerString[6187:6199]: 401,
-		_TimezoneLowerString[6199:6226]: 402,
-		_TimezoneLowerString[6226:6253]: 403,
-		_TimezoneLowerString[6253:6283]: 404,
-		_TimezoneLowerString[6283:6298]: 405,
-		_TimezoneLowerString[6298:6311]: 406,
-		_TimezoneLowerString[6311:6326]: 407,
-		_TimezoneLowerString[6326:6342]: 408,
-		_TimezoneLowerString[6342:6356]: 409,
-		_TimezoneLowerString[6356:6368]: 410,
-		_TimezoneLowerString[6368:6386]: 411,
-		_TimezoneLowerString[6386:6400]: 412,
-		_TimezoneLowerString[6400:6413]: 413,
-		_TimezoneLowerString[6413:6426]: 414,
-		_TimezoneLowerString[6426:6441]: 415,
-		_TimezoneLowerString[6441:6457]: 416,
-		_TimezoneLowerString[6457:6472]: 417,
-		_TimezoneLowerString[6472:6489]: 418,
-		_TimezoneLowerString[6489:6503]: 419,
-		_TimezoneLowerString[6503:6518]: 420,
-		_TimezoneLowerString[6518:6527]: 421,
-		_TimezoneLowerString[6527:6540]: 422,
-		_TimezoneLowerString[6540:6553]: 423,
-		_TimezoneLowerString[6553:6569]: 424,
-	}
 )
 
+// _TimezoneLookupFold determines the enum value of the string regardless of its case
+// by Unicode simple folding. It scans the String values, as a fold table is only generated
+// for enums with the "ignore-case" feature.
+func _TimezoneLookupFold(raw string) (Timezone, bool) {
+	for idx := range _TimezoneStrings {
+		if strings.EqualFold(_TimezoneStrings[idx], raw) {
+			return _TimezoneValues[idx], true
+		}
+	}
+	return Timezone(0), false
+}
+
 // TimezoneFromString determines the enum value with an exact case match.
 func TimezoneFromString(raw string) (Timezone, bool) {
 	v, ok := _TimezoneStringToValueMap[raw]
@@ -2393,13 +3340,14 @@ func TimezoneFromString(raw string) (Timezone, bool) {
 	return v, true
 }
 
-// TimezoneFromStringIgnoreCase determines the enum value with a case-insensitive match.
+// TimezoneFromStringIgnoreCase determines the enum value with a case-insensitive match
+// by Unicode simple folding, regardless of the casing of raw.
 func TimezoneFromStringIgnoreCase(raw string) (Timezone, bool) {
 	v, ok := TimezoneFromString(raw)
 	if ok {
 		return v, ok
 	}
-	v, ok = _TimezoneLowerStringToValueMap[raw]
+	v, ok = _TimezoneLookupFold(raw)
 	if !ok {
 		return Timezone(0), false
 	}
@@ -2408,10 +3356,15 @@ func TimezoneFromStringIgnoreCase(raw string) (Timezone, bool) {
 
 // MarshalBinary implements the encoding.BinaryMarshaler interface for Timezone.
 func (_t Timezone) MarshalBinary() ([]byte, error) {
+	return _t.AppendBinary(nil)
+}
+
+// AppendBinary implements the encoding.BinaryAppender interface for Timezone.
+func (_t Timezone) AppendBinary(b []byte) ([]byte, error) {
 	if err := _t.Validate(); err != nil {
-		return nil, fmt.Errorf("Cannot marshal value %q as Timezone. %w", _t, err)
+		return b, fmt.Errorf("Cannot marshal value %q as Timezone. %w", _t, err)
 	}
-	return []byte(_t.String()), nil
+	return append(b, _t.String()...), nil
 }
 
 // UnmarshalBinary implements the encoding.BinaryUnmarshaler interface for Timezone.
@@ -2424,37 +3377,7 @@ func (_t *Timezone) UnmarshalBinary(text []byte) error {
 	var ok bool
 	*_t, ok = TimezoneFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a Timezone", str)
-	}
-	return nil
-}
-
-// MarshalGQL implements the graphql.Marshaler interface for Timezone.
-func (_t Timezone) MarshalGQL(w io.Writer) {
-	fmt.Fprint(w, strconv.Quote(_t.String()))
-}
-
-// UnmarshalGQL implements the graphql.Unmarshaler interface for Timezone.
-func (_t *Timezone) UnmarshalGQL(value interface{}) error {
-	var str string
-	switch v := value.(type) {
-	case []byte:
-		str = string(v)
-	case string:
-		str = v
-	case fmt.Stringer:
-		str = v.String()
-	default:
-		return fmt.Errorf("invalid value of Timezone: %[1]T(%[1]v)", value)
-	}
-	if len(str) == 0 {
-		return fmt.Errorf("Timezone cannot be derived from empty string")
-	}
-
-	var ok bool
-	*_t, ok = TimezoneFromString(str)
-	if !ok {
-		return fmt.Errorf("Value %q does not represent a Timezone", str)
+		return _enumer.NewParseError("Timezone", str, TimezoneStrings())
 	}
 	return nil
 }
@@ -2480,7 +3403,7 @@ func (_t *Timezone) UnmarshalJSON(data []byte) error {
 	var ok bool
 	*_t, ok = TimezoneFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a Timezone", str)
+		return _enumer.NewParseError("Timezone", str, TimezoneStrings())
 	}
 	return nil
 }
@@ -2513,17 +3436,22 @@ func (_t *Timezone) Scan(value interface{}) error {
 	var ok bool
 	*_t, ok = TimezoneFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a Timezone", str)
+		return _enumer.NewParseError("Timezone", str, TimezoneStrings())
 	}
 	return nil
 }
 
 // MarshalText implements the encoding.TextMarshaler interface for Timezone.
 func (_t Timezone) MarshalText() ([]byte, error) {
+	return _t.AppendText(nil)
+}
+
+// AppendText implements the encoding.TextAppender interface for Timezone.
+func (_t Timezone) AppendText(b []byte) ([]byte, error) {
 	if err := _t.Validate(); err != nil {
-		return nil, fmt.Errorf("Cannot marshal value %q as Timezone. %w", _t, err)
+		return b, fmt.Errorf("Cannot marshal value %q as Timezone. %w", _t, err)
 	}
-	return []byte(_t.String()), nil
+	return append(b, _t.String()...), nil
 }
 
 // UnmarshalText implements the encoding.TextUnmarshaler interface for Timezone.
@@ -2536,7 +3464,7 @@ func (_t *Timezone) UnmarshalText(text []byte) error {
 	var ok bool
 	*_t, ok = TimezoneFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a Timezone", str)
+		return _enumer.NewParseError("Timezone", str, TimezoneStrings())
 	}
 	return nil
 }
@@ -2562,14 +3490,459 @@ func (_t *Timezone) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	var ok bool
 	*_t, ok = TimezoneFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a Timezone", str)
+		return _enumer.NewParseError("Timezone", str, TimezoneStrings())
 	}
 	return nil
 }
 
+var _TimezoneCompletions = [424]string{
+	"Asia/Kabul",
+	"Europe/Tirane",
+	"Africa/Algiers",
+	"Pacific/Pago_Pago",
+	"Europe/Andorra",
+	"Africa/Luanda",
+	"America/Anguilla",
+	"Antarctica/Casey",
+	"Antarctica/Davis",
+	"Antarctica/DumontDUrville",
+	"Antarctica/Mawson",
+	"Antarctica/McMurdo",
+	"Antarctica/Palmer",
+	"Antarctica/Rothera",
+	"Antarctica/Syowa",
+	"Antarctica/Troll",
+	"Antarctica/Vostok",
+	"America/Antigua",
+	"America/Argentina/Buenos_Aires",
+	"America/Argentina/Catamarca",
+	"America/Argentina/Cordoba",
+	"America/Argentina/Jujuy",
+	"America/Argentina/La_Rioja",
+	"America/Argentina/Mendoza",
+	"America/Argentina/Rio_Gallegos",
+	"America/Argentina/Salta",
+	"America/Argentina/San_Juan",
+	"America/Argentina/San_Luis",
+	"America/Argentina/Tucuman",
+	"America/Argentina/Ushuaia",
+	"Asia/Yerevan",
+	"America/Aruba",
+	"Antarctica/Macquarie",
+	"Australia/Adelaide",
+	"Australia/Brisbane",
+	"Australia/Broken_Hill",
+	"Australia/Darwin",
+	"Australia/Eucla",
+	"Australia/Hobart",
+	"Australia/Lindeman",
+	"Australia/Lord_Howe",
+	"Australia/Melbourne",
+	"Australia/Perth",
+	"Australia/Sydney",
+	"Europe/Vienna",
+	"Asia/Baku",
+	"America/Nassau",
+	"Asia/Bahrain",
+	"Asia/Dhaka",
+	"America/Barbados",
+	"Europe/Minsk",
+	"Europe/Brussels",
+	"America/Belize",
+	"Africa/Porto-Novo",
+	"Atlantic/Bermuda",
+	"Asia/Thimphu",
+	"America/La_Paz",
+	"America/Kralendijk",
+	"Europe/Sarajevo",
+	"Africa/Gaborone",
+	"America/Araguaina",
+	"America/Bahia",
+	"America/Belem",
+	"America/Boa_Vista",
+	"America/Campo_Grande",
+	"America/Cuiaba",
+	"America/Eirunepe",
+	"America/Fortaleza",
+	"America/Maceio",
+	"America/Manaus",
+	"America/Noronha",
+	"America/Porto_Velho",
+	"America/Recife",
+	"America/Rio_Branco",
+	"America/Santarem",
+	"America/Sao_Paulo",
+	"Indian/Chagos",
+	"Asia/Brunei",
+	"Europe/Sofia",
+	"Africa/Ouagadougou",
+	"Africa/Bujumbura",
+	"Asia/Phnom_Penh",
+	"Africa/Douala",
+	"America/Atikokan",
+	"America/Blanc-Sablon",
+	"America/Cambridge_Bay",
+	"America/Creston",
+	"America/Dawson",
+	"America/Dawson_Creek",
+	"America/Edmonton",
+	"America/Fort_Nelson",
+	"America/Glace_Bay",
+	"America/Goose_Bay",
+	"America/Halifax",
+	"America/Inuvik",
+	"America/Iqaluit",
+	"America/Moncton",
+	"America/Nipigon",
+	"America/Pangnirtung",
+	"America/Rainy_River",
+	"America/Rankin_Inlet",
+	"America/Regina",
+	"America/Resolute",
+	"America/St_Johns",
+	"America/Swift_Current",
+	"America/Thunder_Bay",
+	"America/Toronto",
+	"America/Vancouver",
+	"America/Whitehorse",
+	"America/Winnipeg",
+	"America/Yellowknife",
+	"Atlantic/Cape_Verde",
+	"America/Cayman",
+	"Africa/Bangui",
+	"Africa/Ndjamena",
+	"America/Punta_Arenas",
+	"America/Santiago",
+	"Pacific/Easter",
+	"Asia/Shanghai",
+	"Asia/Urumqi",
+	"Indian/Christmas",
+	"Indian/Cocos",
+	"America/Bogota",
+	"Indian/Comoro",
+	"Africa/Brazzaville",
+	"Africa/Kinshasa",
+	"Africa/Lubumbashi",
+	"Pacific/Rarotonga",
+	"America/Costa_Rica",
+	"Europe/Zagreb",
+	"America/Havana",
+	"America/Curacao",
+	"Asia/Famagusta",
+	"Asia/Nicosia",
+	"Europe/Prague",
+	"Africa/Abidjan",
+	"Europe/Copenhagen",
+	"Africa/Djibouti",
+	"America/Dominica",
+	"America/Santo_Domingo",
+	"America/Guayaquil",
+	"Pacific/Galapagos",
+	"Africa/Cairo",
+	"America/El_Salvador",
+	"Africa/Malabo",
+	"Africa/Asmara",
+	"Europe/Tallinn",
+	"Africa/Addis_Ababa",
+	"Atlantic/Stanley",
+	"Atlantic/Faroe",
+	"Pacific/Fiji",
+	"Europe/Helsinki",
+	"Europe/Paris",
+	"America/Cayenne",
+	"Pacific/Gambier",
+	"Pacific/Marquesas",
+	"Pacific/Tahiti",
+	"Indian/Kerguelen",
+	"Africa/Libreville",
+	"Africa/Banjul",
+	"Asia/Tbilisi",
+	"Europe/Berlin",
+	"Europe/Busingen",
+	"Africa/Accra",
+	"Europe/Gibraltar",
+	"Europe/Athens",
+	"America/Danmarkshavn",
+	"America/Nuuk",
+	"America/Scoresbysund",
+	"America/Thule",
+	"America/Grenada",
+	"America/Guadeloupe",
+	"Pacific/Guam",
+	"America/Guatemala",
+	"Europe/Guernsey",
+	"Africa/Conakry",
+	"Africa/Bissau",
+	"America/Guyana",
+	"America/Port-au-Prince",
+	"Europe/Vatican",
+	"America/Tegucigalpa",
+	"Asia/Hong_Kong",
+	"Europe/Budapest",
+	"Atlantic/Reykjavik",
+	"Asia/Kolkata",
+	"Asia/Jakarta",
+	"Asia/Jayapura",
+	"Asia/Makassar",
+	"Asia/Pontianak",
+	"Asia/Tehran",
+	"Asia/Baghdad",
+	"Europe/Dublin",
+	"Europe/Isle_of_Man",
+	"Asia/Jerusalem",
+	"Europe/Rome",
+	"America/Jamaica",
+	"Asia/Tokyo",
+	"Europe/Jersey",
+	"Asia/Amman",
+	"Asia/Almaty",
+	"Asia/Aqtau",
+	"Asia/Aqtobe",
+	"Asia/Atyrau",
+	"Asia/Oral",
+	"Asia/Qostanay",
+	"Asia/Qyzylorda",
+	"Africa/Nairobi",
+	"Pacific/Kanton",
+	"Pacific/Kiritimati",
+	"Pacific/Tarawa",
+	"Asia/Pyongyang",
+	"Asia/Seoul",
+	"Asia/Kuwait",
+	"Asia/Bishkek",
+	"Asia/Vientiane",
+	"Europe/Riga",
+	"Asia/Beirut",
+	"Africa/Maseru",
+	"Africa/Monrovia",
+	"Africa/Tripoli",
+	"Europe/Vaduz",
+	"Europe/Vilnius",
+	"Europe/Luxembourg",
+	"Asia/Macau",
+	"Europe/Skopje",
+	"Indian/Antananarivo",
+	"Africa/Blantyre",
+	"Asia/Kuala_Lumpur",
+	"Asia/Kuching",
+	"Indian/Maldives",
+	"Africa/Bamako",
+	"Europe/Malta",
+	"Pacific/Kwajalein",
+	"Pacific/Majuro",
+	"America/Martinique",
+	"Africa/Nouakchott",
+	"Indian/Mauritius",
+	"Indian/Mayotte",
+	"America/Bahia_Banderas",
+	"America/Cancun",
+	"America/Chihuahua",
+	"America/Hermosillo",
+	"America/Matamoros",
+	"America/Mazatlan",
+	"America/Merida",
+	"America/Mexico_City",
+	"America/Monterrey",
+	"America/Ojinaga",
+	"America/Tijuana",
+	"Pacific/Chuuk",
+	"Pacific/Kosrae",
+	"Pacific/Pohnpei",
+	"Europe/Chisinau",
+	"Europe/Monaco",
+	"Asia/Choibalsan",
+	"Asia/Hovd",
+	"Asia/Ulaanbaatar",
+	"Europe/Podgorica",
+	"America/Montserrat",
+	"Africa/Casablanca",
+	"Africa/Maputo",
+	"Asia/Yangon",
+	"Africa/Windhoek",
+	"Pacific/Nauru",
+	"Asia/Kathmandu",
+	"Europe/Amsterdam",
+	"Pacific/Noumea",
+	"Pacific/Auckland",
+	"Pacific/Chatham",
+	"America/Managua",
+	"Africa/Niamey",
+	"Africa/Lagos",
+	"Pacific/Niue",
+	"Pacific/Norfolk",
+	"Pacific/Saipan",
+	"Europe/Oslo",
+	"Asia/Muscat",
+	"Asia/Karachi",
+	"Pacific/Palau",
+	"Asia/Gaza",
+	"Asia/Hebron",
+	"America/Panama",
+	"Pacific/Bougainville",
+	"Pacific/Port_Moresby",
+	"America/Asuncion",
+	"America/Lima",
+	"Asia/Manila",
+	"Pacific/Pitcairn",
+	"Europe/Warsaw",
+	"Atlantic/Azores",
+	"Atlantic/Madeira",
+	"Europe/Lisbon",
+	"America/Puerto_Rico",
+	"Asia/Qatar",
+	"Europe/Bucharest",
+	"Asia/Anadyr",
+	"Asia/Barnaul",
+	"Asia/Chita",
+	"Asia/Irkutsk",
+	"Asia/Kamchatka",
+	"Asia/Khandyga",
+	"Asia/Krasnoyarsk",
+	"Asia/Magadan",
+	"Asia/Novokuznetsk",
+	"Asia/Novosibirsk",
+	"Asia/Omsk",
+	"Asia/Sakhalin",
+	"Asia/Srednekolymsk",
+	"Asia/Tomsk",
+	"Asia/Ust-Nera",
+	"Asia/Vladivostok",
+	"Asia/Yakutsk",
+	"Asia/Yekaterinburg",
+	"Europe/Astrakhan",
+	"Europe/Kaliningrad",
+	"Europe/Kirov",
+	"Europe/Moscow",
+	"Europe/Samara",
+	"Europe/Saratov",
+	"Europe/Ulyanovsk",
+	"Europe/Volgograd",
+	"Africa/Kigali",
+	"Indian/Reunion",
+	"America/St_Barthelemy",
+	"Atlantic/St_Helena",
+	"America/St_Kitts",
+	"America/St_Lucia",
+	"America/Marigot",
+	"America/Miquelon",
+	"America/St_Vincent",
+	"Pacific/Apia",
+	"Europe/San_Marino",
+	"Africa/Sao_Tome",
+	"Asia/Riyadh",
+	"Africa/Dakar",
+	"Europe/Belgrade",
+	"Indian/Mahe",
+	"Africa/Freetown",
+	"Asia/Singapore",
+	"America/Lower_Princes",
+	"Europe/Bratislava",
+	"Europe/Ljubljana",
+	"Pacific/Guadalcanal",
+	"Africa/Mogadishu",
+	"Africa/Johannesburg",
+	"Atlantic/South_Georgia",
+	"Africa/Juba",
+	"Africa/Ceuta",
+	"Atlantic/Canary",
+	"Europe/Madrid",
+	"Asia/Colombo",
+	"Africa/Khartoum",
+	"America/Paramaribo",
+	"Arctic/Longyearbyen",
+	"Africa/Mbabane",
+	"Europe/Stockholm",
+	"Europe/Zurich",
+	"Asia/Damascus",
+	"Asia/Taipei",
+	"Asia/Dushanbe",
+	"Africa/Dar_es_Salaam",
+	"Asia/Bangkok",
+	"Asia/Dili",
+	"Africa/Lome",
+	"Pacific/Fakaofo",
+	"Pacific/Tongatapu",
+	"America/Port_of_Spain",
+	"Africa/Tunis",
+	"Europe/Istanbul",
+	"Asia/Ashgabat",
+	"America/Grand_Turk",
+	"Pacific/Funafuti",
+	"Africa/Kampala",
+	"Europe/Kiev",
+	"Europe/Simferopol",
+	"Europe/Uzhgorod",
+	"Europe/Zaporozhye",
+	"Asia/Dubai",
+	"Europe/London",
+	"America/Adak",
+	"America/Anchorage",
+	"America/Boise",
+	"America/Chicago",
+	"America/Denver",
+	"America/Detroit",
+	"America/Indiana/Indianapolis",
+	"America/Indiana/Knox",
+	"America/Indiana/Marengo",
+	"America/Indiana/Petersburg",
+	"America/Indiana/Tell_City",
+	"America/Indiana/Vevay",
+	"America/Indiana/Vincennes",
+	"America/Indiana/Winamac",
+	"America/Juneau",
+	"America/Kentucky/Louisville",
+	"America/Kentucky/Monticello",
+	"America/Los_Angeles",
+	"America/Menominee",
+	"America/Metlakatla",
+	"America/New_York",
+	"America/Nome",
+	"America/North_Dakota/Beulah",
+	"America/North_Dakota/Center",
+	"America/North_Dakota/New_Salem",
+	"America/Phoenix",
+	"America/Sitka",
+	"America/Yakutat",
+	"Pacific/Honolulu",
+	"Pacific/Midway",
+	"Pacific/Wake",
+	"America/Montevideo",
+	"Asia/Samarkand",
+	"Asia/Tashkent",
+	"Pacific/Efate",
+	"America/Caracas",
+	"Asia/Ho_Chi_Minh",
+	"America/Tortola",
+	"America/St_Thomas",
+	"Pacific/Wallis",
+	"Africa/El_Aaiun",
+	"Asia/Aden",
+	"Africa/Lusaka",
+	"Africa/Harare",
+	"Europe/Mariehamn",
+}
+
+// TimezoneUsage returns a usage hint of all String values of the enum, less the deprecated values,
+// e.g. for flag descriptions.
+func TimezoneUsage() string {
+	return "one of: " + strings.Join(_TimezoneStrings[:], "|")
+}
+
+// TimezoneCompletions returns the completion candidates of the enum matching the given prefix,
+// less the deprecated values. Each candidate is formatted as "<value>" or "<value>\t<description>",
+// as expected by shell completions, e.g. of cobra's RegisterFlagCompletionFunc.
+func TimezoneCompletions(toComplete string) []string {
+	out := make([]string, 0, len(_TimezoneCompletions))
+	for _, c := range _TimezoneCompletions {
+		if strings.HasPrefix(c, toComplete) {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
 const (
-	_UserRoleString      = "standardeditorrevieweradmin"
-	_UserRoleLowerString = "standardeditorrevieweradmin"
+	_UserRoleString = "standardeditorrevieweradmin"
 )
 
 var (
@@ -2600,6 +3973,11 @@ func UserRoleStrings() []string {
 	return cp[:]
 }
 
+// Values returns all values of the enum.
+func (UserRole) Values() []UserRole {
+	return UserRoleValues()
+}
+
 // IsValid tests whether the value is a valid enum value.
 func (_u UserRole) IsValid() bool {
 	return _u >= 0 && _u <= 3
@@ -2624,6 +4002,54 @@ func (_u UserRole) String() string {
 	return _UserRoleStrings[idx]
 }
 
+// Index returns the position of the value within the ordered values of the enum
+// (ignoring alternative values) or -1 if the value is invalid.
+func (_u UserRole) Index() int {
+	if !_u.IsValid() {
+		return -1
+	}
+	idx := int(_u)
+	return idx
+}
+
+// Compare returns -1, 0 or +1 depending on whether the value orders before,
+// equal to or after the other value. Invalid values order before all valid values.
+func (_u UserRole) Compare(other UserRole) int {
+	a, b := _u.Index(), other.Index()
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return +1
+	}
+	return 0
+}
+
+// Less tests whether the value orders before the other value.
+func (_u UserRole) Less(other UserRole) bool {
+	return _u.Compare(other) < 0
+}
+
+// Next returns the succeeding value in order of the enum.
+// It returns false if the value is invalid or the last value.
+func (_u UserRole) Next() (UserRole, bool) {
+	idx := _u.Index()
+	if idx == -1 || idx+1 == len(_UserRoleValues) {
+		return UserRole(0), false
+	}
+	return _UserRoleValues[idx+1], true
+}
+
+// Prev returns the preceding value in order of the enum.
+// It returns false if the value is invalid or the first value.
+func (_u UserRole) Prev() (UserRole, bool) {
+	idx := _u.Index()
+	if idx < 1 {
+		return UserRole(0), false
+	}
+	return _UserRoleValues[idx-1], true
+}
+
 var (
 	_UserRoleStringToValueMap = map[string]UserRole{
 		_UserRoleString[0:8]:   UserRoleStandard,
@@ -2631,14 +4057,20 @@ var (
 		_UserRoleString[14:22]: UserRoleReviewer,
 		_UserRoleString[22:27]: UserRoleAdmin,
 	}
-	_UserRoleLowerStringToValueMap = map[string]UserRole{
-		_UserRoleLowerString[0:8]:   UserRoleStandard,
-		_UserRoleLowerString[8:14]:  UserRoleEditor,
-		_UserRoleLowerString[14:22]: UserRoleReviewer,
-		_UserRoleLowerString[22:27]: UserRoleAdmin,
-	}
 )
 
+// _UserRoleLookupFold determines the enum value of the string regardless of its case
+// by Unicode simple folding. It scans the String values, as a fold table is only generated
+// for enums with the "ignore-case" feature.
+func _UserRoleLookupFold(raw string) (UserRole, bool) {
+	for idx := range _UserRoleStrings {
+		if strings.EqualFold(_UserRoleStrings[idx], raw) {
+			return _UserRoleValues[idx], true
+		}
+	}
+	return UserRole(0), false
+}
+
 // UserRoleFromString determines the enum value with an exact case match.
 func UserRoleFromString(raw string) (UserRole, bool) {
 	if len(raw) == 0 {
@@ -2651,7 +4083,8 @@ func UserRoleFromString(raw string) (UserRole, bool) {
 	return v, true
 }
 
-// UserRoleFromStringIgnoreCase determines the enum value with a case-insensitive match.
+// UserRoleFromStringIgnoreCase determines the enum value with a case-insensitive match
+// by Unicode simple folding, regardless of the casing of raw.
 func UserRoleFromStringIgnoreCase(raw string) (UserRole, bool) {
 	if len(raw) == 0 {
 		return UserRole(0), true
@@ -2660,7 +4093,7 @@ func UserRoleFromStringIgnoreCase(raw string) (UserRole, bool) {
 	if ok {
 		return v, ok
 	}
-	v, ok = _UserRoleLowerStringToValueMap[raw]
+	v, ok = _UserRoleLookupFold(raw)
 	if !ok {
 		return UserRole(0), false
 	}
@@ -2669,10 +4102,15 @@ func UserRoleFromStringIgnoreCase(raw string) (UserRole, bool) {
 
 // MarshalBinary implements the encoding.BinaryMarshaler interface for UserRole.
 func (_u UserRole) MarshalBinary() ([]byte, error) {
+	return _u.AppendBinary(nil)
+}
+
+// AppendBinary implements the encoding.BinaryAppender interface for UserRole.
+func (_u UserRole) AppendBinary(b []byte) ([]byte, error) {
 	if err := _u.Validate(); err != nil {
-		return nil, fmt.Errorf("Cannot marshal value %q as UserRole. %w", _u, err)
+		return b, fmt.Errorf("Cannot marshal value %q as UserRole. %w", _u, err)
 	}
-	return []byte(_u.String()), nil
+	return append(b, _u.String()...), nil
 }
 
 // UnmarshalBinary implements the encoding.BinaryUnmarshaler interface for UserRole.
@@ -2682,7 +4120,7 @@ func (_u *UserRole) UnmarshalBinary(text []byte) error {
 	var ok bool
 	*_u, ok = UserRoleFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a UserRole", str)
+		return _enumer.NewParseError("UserRole", str, UserRoleStrings())
 	}
 	return nil
 }
@@ -2710,7 +4148,7 @@ func (_u *UserRole) UnmarshalGQL(value interface{}) error {
 	var ok bool
 	*_u, ok = UserRoleFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a UserRole", str)
+		return _enumer.NewParseError("UserRole", str, UserRoleStrings())
 	}
 	return nil
 }
@@ -2733,7 +4171,7 @@ func (_u *UserRole) UnmarshalJSON(data []byte) error {
 	var ok bool
 	*_u, ok = UserRoleFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a UserRole", str)
+		return _enumer.NewParseError("UserRole", str, UserRoleStrings())
 	}
 	return nil
 }
@@ -2764,17 +4202,22 @@ func (_u *UserRole) Scan(value interface{}) error {
 	var ok bool
 	*_u, ok = UserRoleFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a UserRole", str)
+		return _enumer.NewParseError("UserRole", str, UserRoleStrings())
 	}
 	return nil
 }
 
 // MarshalText implements the encoding.TextMarshaler interface for UserRole.
 func (_u UserRole) MarshalText() ([]byte, error) {
+	return _u.AppendText(nil)
+}
+
+// AppendText implements the encoding.TextAppender interface for UserRole.
+func (_u UserRole) AppendText(b []byte) ([]byte, error) {
 	if err := _u.Validate(); err != nil {
-		return nil, fmt.Errorf("Cannot marshal value %q as UserRole. %w", _u, err)
+		return b, fmt.Errorf("Cannot marshal value %q as UserRole. %w", _u, err)
 	}
-	return []byte(_u.String()), nil
+	return append(b, _u.String()...), nil
 }
 
 // UnmarshalText implements the encoding.TextUnmarshaler interface for UserRole.
@@ -2784,7 +4227,7 @@ func (_u *UserRole) UnmarshalText(text []byte) error {
 	var ok bool
 	*_u, ok = UserRoleFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a UserRole", str)
+		return _enumer.NewParseError("UserRole", str, UserRoleStrings())
 	}
 	return nil
 }
@@ -2807,7 +4250,33 @@ func (_u *UserRole) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	var ok bool
 	*_u, ok = UserRoleFromString(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a UserRole", str)
+		return _enumer.NewParseError("UserRole", str, UserRoleStrings())
 	}
 	return nil
 }
+
+var _UserRoleCompletions = [4]string{
+	"standard",
+	"editor",
+	"reviewer",
+	"admin",
+}
+
+// UserRoleUsage returns a usage hint of all String values of the enum, less the deprecated values,
+// e.g. for flag descriptions.
+func UserRoleUsage() string {
+	return "one of: " + strings.Join(_UserRoleStrings[:], "|")
+}
+
+// UserRoleCompletions returns the completion candidates of the enum matching the given prefix,
+// less the deprecated values. Each candidate is formatted as "<value>" or "<value>\t<description>",
+// as expected by shell completions, e.g. of cobra's RegisterFlagCompletionFunc.
+func UserRoleCompletions(toComplete string) []string {
+	out := make([]string, 0, len(_UserRoleCompletions))
+	for _, c := range _UserRoleCompletions {
+		if strings.HasPrefix(c, toComplete) {
+			out = append(out, c)
+		}
+	}
+	return out
+}
@@ -0,0 +1,93 @@
+// Package suggest proposes the closest candidates of misspelled values.
+// It backs the parse errors of the runtime package as well as the
+// validation messages of the generator.
+package suggest
+
+import (
+	"strings"
+)
+
+const (
+	maxSuggestions      = 3
+	maxSuggestionLength = 128 // hint: longer inputs are not considered a misspelling of any candidate
+)
+
+// ClosestMatches returns up to three candidates which are closest to
+// the given value by edit distance. Only candidates sharing the smallest
+// distance are returned and candidates too far off the value are not
+// considered a match at all. Values longer than 128 bytes have no matches,
+// which bounds the effort spent on arbitrary inputs.
+func ClosestMatches(value string, candidates []string) []string {
+	type match struct {
+		candidate string
+		distance  int
+	}
+	if len(value) > maxSuggestionLength {
+		return nil
+	}
+	threshold := len(value) / 3
+	if threshold < 2 {
+		threshold = 2
+	}
+	s := []rune(strings.ToLower(value))
+	var matches []match
+	for _, c := range candidates {
+		t := []rune(strings.ToLower(c))
+		// hint: the difference in length is a lower bound of the edit distance
+		if len(s)-len(t) > threshold || len(t)-len(s) > threshold {
+			continue
+		}
+		d := editDistance(s, t)
+		if d > threshold {
+			continue
+		}
+		if len(matches) > 0 && d < matches[0].distance {
+			matches = matches[:0]
+		}
+		if len(matches) == 0 || d == matches[0].distance {
+			matches = append(matches, match{c, d})
+		}
+	}
+	if len(matches) > maxSuggestions {
+		matches = matches[:maxSuggestions]
+	}
+	out := make([]string, len(matches))
+	for idx, m := range matches {
+		out[idx] = m.candidate
+	}
+	return out
+}
+
+// editDistance determines the optimal string alignment distance of s and t,
+// i.e. the Levenshtein distance which also counts adjacent transpositions
+// as a single edit. It only retains the last three rows of the distance matrix.
+func editDistance(s, t []rune) int {
+	prev2, prev, curr := make([]int, len(t)+1), make([]int, len(t)+1), make([]int, len(t)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(s); i++ {
+		curr[0] = i
+		for j := 1; j <= len(t); j++ {
+			cost := 1
+			if s[i-1] == t[j-1] {
+				cost = 0
+			}
+			curr[j] = minInt(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+			if i > 1 && j > 1 && s[i-1] == t[j-2] && s[i-2] == t[j-1] {
+				curr[j] = minInt(curr[j], prev2[j-2]+1)
+			}
+		}
+		prev2, prev, curr = prev, curr, prev2
+	}
+	return prev[len(t)]
+}
+
+func minInt(v int, vs ...int) int {
+	for _, w := range vs {
+		if w < v {
+			v = w
+		}
+	}
+	return v
+}
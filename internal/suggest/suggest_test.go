@@ -0,0 +1,36 @@
+package suggest
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestClosestMatches(t *testing.T) {
+	serializers := []string{"binary", "bson", "graphql", "json", "sql", "text", "yaml", "yaml.v3"}
+	require.Equal(t, []string{"json"}, ClosestMatches("jsno", serializers))
+	require.Equal(t, []string{"yaml"}, ClosestMatches("yml", serializers))
+	require.Equal(t, []string{"bson", "json"}, ClosestMatches("sson", serializers))
+	require.Empty(t, ClosestMatches("protobuf", serializers))
+	require.Empty(t, ClosestMatches(strings.Repeat("json", 33), serializers))
+}
+
+func TestEditDistance(t *testing.T) {
+	for _, tC := range []struct {
+		a, b     string
+		distance int
+	}{
+		{"", "", 0},
+		{"", "json", 4},
+		{"json", "", 4},
+		{"json", "json", 0},
+		{"json", "jsno", 1},
+		{"yml", "yaml", 1},
+		{"kitten", "sitting", 3},
+		{"ca", "abc", 3},
+		{"größe", "grösse", 2},
+	} {
+		require.Equal(t, tC.distance, editDistance([]rune(tC.a), []rune(tC.b)), "%q -> %q", tC.a, tC.b)
+	}
+}
@@ -43,6 +43,7 @@ func DetermineEnumType(node ast.Node, typesInfo *types.Info, genFile *ast.File)
 		}
 	}
 
+	var kind types.BasicKind
 	{ // assert enum type
 		if decl.Doc == nil || len(decl.Doc.List) == 0 {
 			return nil, -1, nil
@@ -65,6 +66,7 @@ func DetermineEnumType(node ast.Node, typesInfo *types.Info, genFile *ast.File)
 			// TODO: evaluate if this error return is correct at this point (we still don't know if it is an enum spec)
 			return nil, node.Pos(), errors.New("enum types must be of any unsigned integer type") // not a type spec
 		}
+		kind = typ.Kind()
 
 		// find magic comment
 		magic := slices.Filter(decl.Doc.List, func(v *ast.Comment, idx int) bool {
@@ -82,7 +84,7 @@ func DetermineEnumType(node ast.Node, typesInfo *types.Info, genFile *ast.File)
 		}
 	}
 
-	return &EnumType{Node: decl}, -1, nil
+	return &EnumType{Node: decl, Kind: kind}, -1, nil
 }
 
 // AssignEnumConstBlockToType evaluates the current node for a possible const block spec/enum values.
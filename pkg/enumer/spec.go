@@ -3,6 +3,7 @@ package enumer
 import (
 	"go/types"
 	"regexp"
+	"strings"
 )
 
 var (
@@ -22,6 +23,10 @@ const (
 type EnumTypeSpec struct {
 	Type           SpecType
 	Values         []*EnumTypeSpecValue
+	Default        *EnumTypeSpecValue // hint: the explicitly configured default value
+	LabelLanguages []string           // hint: the language tags of the label columns, e.g. "en"
+	HasOrder       bool               // hint: values are ordered explicitly instead of by their IDs
+	Transitions    []*EnumTypeSpecTransition
 	AdditionalData *AdditionalData
 }
 
@@ -29,9 +34,44 @@ type EnumTypeSpecValue struct {
 	ID            uint64
 	EnumValue     string
 	IsAlternative bool           // hint: an alternative value
+	IsCustom      bool           // hint: value was set explicitly and bypasses the transformation
+	IsDeprecated  bool           // hint: value is still accepted, but should no longer be used
+	Labels        []string       // hint: human-readable labels in order of the spec's label languages
+	Order         int64          // hint: explicit sort key of the value, see EnumTypeSpec.HasOrder
+	Description   string         // hint: human-readable description from the doc comment resp. "description" column
 	ConstSpec     *EnumValueSpec // hint: if derived from const value
 }
 
+const (
+	ReservedColumnDeprecated = "deprecated"
+	ReservedColumnLabel      = "label" // hint: e.g. "label(en)"
+	ReservedColumnOrder      = "order"
+)
+
+// DescriptionColumn names the additional data column, which
+// also serves as the description of the values, e.g. for completions.
+const DescriptionColumn = "description"
+
+func (v *EnumTypeSpecValue) parseReservedColumn(name, raw string) error {
+	switch {
+	case name == ReservedColumnDeprecated:
+		val, err := typedParserFuncs[types.Bool](raw)
+		if err != nil {
+			return err
+		}
+		v.IsDeprecated = val.(bool)
+	case name == ReservedColumnOrder:
+		val, err := typedParserFuncs[types.Int64](raw)
+		if err != nil {
+			return err
+		}
+		v.Order = val.(int64)
+	case strings.HasPrefix(name, ReservedColumnLabel+"("):
+		v.Labels = append(v.Labels, raw)
+	}
+	return nil
+}
+
 type AdditionalData struct {
 	Headers []*AdditionalDataHeader
 	Rows    [][]*AdditionalDataCell
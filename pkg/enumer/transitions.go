@@ -0,0 +1,196 @@
+package enumer
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"go/token"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/mvrahden/go-enumer/internal/suggest"
+	"github.com/mvrahden/go-enumer/pkg/utils/slices"
+)
+
+const (
+	transitionsBlockMarker = "Transitions:"
+	transitionArrow        = "->"
+)
+
+type EnumTypeSpecTransition struct {
+	From *EnumTypeSpecValue // hint: always refers to a dominant value
+	To   *EnumTypeSpecValue // hint: always refers to a dominant value
+}
+
+// rawTransition is a transition as declared by the user.
+type rawTransition struct {
+	From, To string
+}
+
+// LoadTransitions loads the state transitions of the enum either from the
+// CSV file referenced by the `-transitions` option or from a "Transitions:"
+// block within the doc comment of the enum type and validates them against the spec.
+func (e *EnumType) LoadTransitions(fset *token.FileSet) error {
+	fromComment := e.transitionsFromComment()
+	if len(e.Config.TransitionsSource) == 0 && fromComment == nil {
+		return nil
+	}
+	if len(e.Config.TransitionsSource) > 0 && fromComment != nil {
+		return errors.New("transitions cannot be declared both in a file and in a comment block")
+	}
+	raw := fromComment
+	if len(e.Config.TransitionsSource) > 0 {
+		dirPath := filepath.Dir(fset.Position(e.Node.Pos()).Filename)
+		var err error
+		raw, err = loadTransitionsFromFS(os.DirFS(dirPath), e.Config.TransitionsSource)
+		if err != nil {
+			return err
+		}
+	}
+	return e.resolveTransitions(raw)
+}
+
+// transitionsFromComment parses a transitions block within the doc comment, e.g.
+//
+//	// Transitions:
+//	//
+//	//	Created -> Canceled, Confirmed
+//	//	Confirmed -> Canceled
+func (e *EnumType) transitionsFromComment() []rawTransition {
+	if e.Node.Doc == nil {
+		return nil
+	}
+	var out []rawTransition
+	inBlock := false
+	for _, c := range e.Node.Doc.List {
+		line := strings.TrimSpace(strings.TrimPrefix(c.Text, "//"))
+		if !inBlock {
+			inBlock = line == transitionsBlockMarker
+			continue
+		}
+		if len(line) == 0 {
+			continue
+		}
+		from, targets, ok := strings.Cut(line, transitionArrow)
+		if !ok {
+			break
+		}
+		for _, to := range strings.Split(targets, ",") {
+			out = append(out, rawTransition{From: strings.TrimSpace(from), To: strings.TrimSpace(to)})
+		}
+	}
+	if !inBlock {
+		return nil
+	}
+	return out
+}
+
+func loadTransitionsFromFS(pkgFS fs.FS, path string) ([]rawTransition, error) {
+	f, err := pkgFS.Open(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, errors.New("no such transitions file")
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	cr := csv.NewReader(f)
+	hdr, err := cr.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, errors.New("found empty transitions file")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed reading transitions header. err: %w", err)
+	}
+	if len(hdr) != 2 || hdr[0] != "from" || hdr[1] != "to" {
+		return nil, errors.New("transitions header must consist of the columns \"from\" and \"to\"")
+	}
+	var out []rawTransition
+	for rowIdx := 0; true; rowIdx++ {
+		row, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed reading transitions row %d. err: %w", rowIdx+2, err)
+		}
+		out = append(out, rawTransition{From: row[0], To: row[1]})
+	}
+	return out, nil
+}
+
+// resolveTransitions resolves the declared transitions to the values of the spec
+// and asserts that all states are reachable from the initial state.
+func (e *EnumType) resolveTransitions(raw []rawTransition) error {
+	if len(raw) == 0 {
+		return errors.New("transitions must contain at least one transition")
+	}
+	specValues := slices.Map(e.Spec.Values, func(v *EnumTypeSpecValue, _ int) string { return v.EnumValue })
+	resolve := func(t rawTransition, state string) (*EnumTypeSpecValue, error) {
+		idx := slices.FindIndex(e.Spec.Values, func(v *EnumTypeSpecValue, _ int) bool { return v.EnumValue == state })
+		if idx == -1 {
+			msg := fmt.Sprintf("transition %q -> %q refers to unknown state %q", t.From, t.To, state)
+			if suggestions := suggest.ClosestMatches(state, specValues); len(suggestions) > 0 {
+				msg += fmt.Sprintf(" (did you mean %q?)", suggestions[0])
+			}
+			return nil, errors.New(msg)
+		}
+		return e.dominantValue(e.Spec.Values[idx]), nil
+	}
+
+	for _, t := range raw {
+		from, err := resolve(t, t.From)
+		if err != nil {
+			return err
+		}
+		to, err := resolve(t, t.To)
+		if err != nil {
+			return err
+		}
+		isDuplicate := slices.Any(e.Spec.Transitions, func(v *EnumTypeSpecTransition, _ int) bool {
+			return v.From == from && v.To == to
+		})
+		if isDuplicate {
+			return fmt.Errorf("duplicate transition %q -> %q", t.From, t.To)
+		}
+		e.Spec.Transitions = append(e.Spec.Transitions, &EnumTypeSpecTransition{From: from, To: to})
+	}
+
+	// assert all states are reachable from the initial state
+	initial := e.InitialState()
+	reachable := map[*EnumTypeSpecValue]bool{initial: true}
+	for queue := []*EnumTypeSpecValue{initial}; len(queue) > 0; queue = queue[1:] {
+		for _, t := range e.Spec.Transitions {
+			if t.From == queue[0] && !reachable[t.To] {
+				reachable[t.To] = true
+				queue = append(queue, t.To)
+			}
+		}
+	}
+	badIdx := slices.FindIndex(e.Spec.Values, func(v *EnumTypeSpecValue, _ int) bool {
+		return !v.IsAlternative && !reachable[v]
+	})
+	if badIdx > -1 {
+		return fmt.Errorf("state %q is unreachable from initial state %q", e.Spec.Values[badIdx].EnumValue, initial.EnumValue)
+	}
+	return nil
+}
+
+// InitialState returns the state from which all states of the
+// state machine must be reachable, i.e. the default value or the first value of the spec.
+func (e *EnumType) InitialState() *EnumTypeSpecValue {
+	if e.Spec.Default != nil {
+		return e.dominantValue(e.Spec.Default)
+	}
+	return e.Spec.Values[0]
+}
+
+// dominantValue returns the first value of the spec sharing the ID of the given value.
+func (e *EnumType) dominantValue(v *EnumTypeSpecValue) *EnumTypeSpecValue {
+	idx := slices.FindIndex(e.Spec.Values, func(d *EnumTypeSpecValue, _ int) bool { return d.ID == v.ID })
+	return e.Spec.Values[idx]
+}
@@ -17,14 +17,18 @@ import (
 	"strings"
 
 	"github.com/mvrahden/go-enumer/config"
+	"github.com/mvrahden/go-enumer/internal/suggest"
 	"github.com/mvrahden/go-enumer/pkg/utils/slices"
+	"golang.org/x/text/language"
 )
 
 type EnumTypeConfig struct {
 	Node *ast.Comment
 
-	Options    *config.Options
-	FromSource string
+	Options           *config.Options
+	FromSource        string
+	Default           string // hint: refers to the default value of the spec
+	TransitionsSource string // hint: refers to a CSV file of state transitions
 }
 
 func DefaultConfig(cfg *config.Options) *EnumTypeConfig {
@@ -33,6 +37,7 @@ func DefaultConfig(cfg *config.Options) *EnumTypeConfig {
 
 type EnumType struct {
 	Node       *ast.GenDecl
+	Kind       types.BasicKind // hint: the underlying unsigned integer kind of the type
 	Config     *EnumTypeConfig
 	Spec       *EnumTypeSpec // hint: the specification derived either from const block notation or from a file
 	ConstBlock *EnumConstBlock
@@ -75,6 +80,59 @@ func (e *EnumValueSpec) GetObjectVia(ti *types.Info) types.Object {
 	return ti.ObjectOf(e.Node.Names[0])
 }
 
+// IsDeprecated reports whether the constant is marked as deprecated
+// by a "Deprecated:" paragraph in its doc or line comment.
+func (e *EnumValueSpec) IsDeprecated() bool {
+	for _, cg := range []*ast.CommentGroup{e.Node.Doc, e.Node.Comment} {
+		if cg == nil {
+			continue
+		}
+		ok := slices.Any(strings.Split(cg.Text(), "\n"), func(line string, _ int) bool {
+			return strings.HasPrefix(line, "Deprecated:")
+		})
+		if ok {
+			return true
+		}
+	}
+	return false
+}
+
+// Description returns the doc comment of the constant as a single line,
+// less any "Deprecated:" paragraph.
+func (e *EnumValueSpec) Description() string {
+	if e.Node.Doc == nil {
+		return ""
+	}
+	paragraphs := slices.Filter(strings.Split(e.Node.Doc.Text(), "\n\n"), func(p string, _ int) bool {
+		return !strings.HasPrefix(p, "Deprecated:")
+	})
+	return strings.Join(strings.Fields(strings.Join(paragraphs, " ")), " ")
+}
+
+const customValueToken = "// enum:"
+
+// CustomValue extracts an explicitly set enum value from the line comment
+// of the constant, e.g. `// enum:"legacy-value"`.
+// It reports whether the constant carries such a custom value.
+func (e *EnumValueSpec) CustomValue() (string, bool, error) {
+	if e.Node.Comment == nil {
+		return "", false, nil
+	}
+	lineComment := e.Node.Comment.List[0].Text
+	if !strings.HasPrefix(lineComment, customValueToken) {
+		return "", false, nil
+	}
+	quoted, err := strconv.QuotedPrefix(lineComment[len(customValueToken):])
+	if err != nil {
+		return "", true, errors.New("value must be a quoted string")
+	}
+	value, _ := strconv.Unquote(quoted)
+	if len(value) == 0 {
+		return "", true, errors.New("value cannot be empty")
+	}
+	return value, true, nil
+}
+
 // DetectMagicComment retrieves the magic comment from the list of comments.
 // It assumes that a magic comment exists.
 func (e *EnumType) DetectMagicComment() (c *ast.Comment) {
@@ -103,7 +161,10 @@ func (e *EnumType) ParseMagicComment(mc *ast.Comment, opts *config.Options) erro
 		f.StringVar(&cfg.Options.TransformStrategy, "transform", cfg.Options.TransformStrategy, "")
 		f.Var(&cfg.Options.Serializers, "serializers", "")
 		f.Var(&cfg.Options.SupportedFeatures, "support", "")
+		f.StringVar(&cfg.Options.LookupStrategy, "lookup", cfg.Options.LookupStrategy, "")
 		f.StringVar(&cfg.FromSource, "from", "", "")
+		f.StringVar(&cfg.Default, "default", "", "")
+		f.StringVar(&cfg.TransitionsSource, "transitions", "", "")
 		err := f.Parse(args)
 		if err != nil {
 			if els := strings.SplitAfter(err.Error(), "not defined: -"); len(els) == 2 { // flag provided but not defined: -<unknown opt>
@@ -118,17 +179,17 @@ func (e *EnumType) ParseMagicComment(mc *ast.Comment, opts *config.Options) erro
 	}
 
 	if len(cfg.FromSource) > 0 {
-		if !strings.HasSuffix(cfg.FromSource, ".csv") {
-			return errors.New("unsupported file extension")
-		}
-		if strings.Contains(cfg.FromSource, "../") {
-			return errors.New("source path cannot contain path traversals")
-		}
-		if strings.HasPrefix(cfg.FromSource, "./") || strings.HasPrefix(cfg.FromSource, "/") {
-			return errors.New("source path cannot start with \"./\" or \"/\"")
+		if err := validateSourcePath(cfg.FromSource); err != nil {
+			return err
 		}
 		cfg.FromSource = filepath.Clean(cfg.FromSource)
 	}
+	if len(cfg.TransitionsSource) > 0 {
+		if err := validateSourcePath(cfg.TransitionsSource); err != nil {
+			return fmt.Errorf("invalid transitions file. err: %w", err)
+		}
+		cfg.TransitionsSource = filepath.Clean(cfg.TransitionsSource)
+	}
 
 	e.Config = cfg
 	e.Config.Node = mc
@@ -136,6 +197,19 @@ func (e *EnumType) ParseMagicComment(mc *ast.Comment, opts *config.Options) erro
 	return nil
 }
 
+func validateSourcePath(path string) error {
+	if !strings.HasSuffix(path, ".csv") {
+		return errors.New("unsupported file extension")
+	}
+	if strings.Contains(path, "../") {
+		return errors.New("source path cannot contain path traversals")
+	}
+	if strings.HasPrefix(path, "./") || strings.HasPrefix(path, "/") {
+		return errors.New("source path cannot start with \"./\" or \"/\"")
+	}
+	return nil
+}
+
 func (e *EnumType) GetPkgFS(fset *token.FileSet) (fs.FS, bool) {
 	if e.HasSimpleBlockSpec() {
 		return nil, false
@@ -147,7 +221,12 @@ func (e *EnumType) GetPkgFS(fset *token.FileSet) (fs.FS, bool) {
 
 func (e *EnumType) ValidateEnumTypeConfig(fset *token.FileSet) error {
 	// valdidate simple enum options
-	// TODO
+	if err := e.Config.Options.Validate(); err != nil {
+		return err
+	}
+	if len(e.Config.Default) > 0 && e.Config.Options.SupportedFeatures.Contains(config.SupportUndefined) {
+		return fmt.Errorf("a default value cannot be combined with the %q feature", config.SupportUndefined)
+	}
 
 	// validate filebased enum options
 	pkgFS, ok := e.GetPkgFS(fset)
@@ -174,11 +253,25 @@ func (e *EnumType) LoadSpec(fset *token.FileSet) error {
 func (e *EnumType) LoadSimpleBlockSpec() error {
 	spec := &EnumTypeSpec{Type: SimpleBlockSpec, Values: make([]*EnumTypeSpecValue, len(e.ConstBlock.Specs))}
 
-	slices.Range(e.ConstBlock.Specs, func(v *EnumValueSpec, idx int) {
-		enumValue := v.Node.Names[0].Name
-		enumValue = strings.TrimPrefix(enumValue, e.Name().Name)
-		spec.Values[idx] = &EnumTypeSpecValue{ID: v.Value, EnumValue: enumValue, ConstSpec: v}
+	badIdx, err := slices.RangeErr(e.ConstBlock.Specs, func(v *EnumValueSpec, idx int) error {
+		customValue, ok, err := v.CustomValue()
+		if err != nil {
+			return err
+		}
+		if ok {
+			spec.Values[idx] = &EnumTypeSpecValue{ID: v.Value, EnumValue: customValue, IsCustom: true, ConstSpec: v}
+		} else {
+			enumValue := v.Node.Names[0].Name
+			enumValue = strings.TrimPrefix(enumValue, e.Name().Name)
+			spec.Values[idx] = &EnumTypeSpecValue{ID: v.Value, EnumValue: enumValue, ConstSpec: v}
+		}
+		spec.Values[idx].IsDeprecated = v.IsDeprecated()
+		spec.Values[idx].Description = v.Description()
+		return nil
 	})
+	if err != nil {
+		return fmt.Errorf("%q has an invalid custom value (reason: %s)", e.ConstBlock.Specs[badIdx].Node.Names[0].Name, err)
+	}
 
 	e.Spec = e.detectAlternativeValues(spec)
 	return nil
@@ -197,6 +290,35 @@ func (e *EnumType) LoadFileSpec(fset *token.FileSet) error {
 	return nil
 }
 
+type reservedColumn struct {
+	Index int
+	Name  string
+}
+
+// getReservedColumnName determines whether the given CSV header cell
+// refers to a reserved column, which is not treated as additional data.
+func getReservedColumnName(cell string) (string, bool) {
+	switch {
+	case cell == ReservedColumnDeprecated, cell == "bool("+ReservedColumnDeprecated+")":
+		return ReservedColumnDeprecated, true
+	case cell == ReservedColumnOrder, cell == "int("+ReservedColumnOrder+")":
+		return ReservedColumnOrder, true
+	case strings.HasPrefix(cell, ReservedColumnLabel+"(") && strings.HasSuffix(cell, ")"):
+		return cell, true
+	}
+	return "", false
+}
+
+// parseLabelLanguage determines the canonical language tag of a label column, e.g. "label(en)".
+func parseLabelLanguage(name string) (string, error) {
+	raw := strings.TrimSuffix(strings.TrimPrefix(name, ReservedColumnLabel+"("), ")")
+	tag, err := language.Parse(raw)
+	if err != nil {
+		return "", fmt.Errorf("invalid language tag %q", raw)
+	}
+	return tag.String(), nil
+}
+
 func (e *EnumType) detectAlternativeValues(spec *EnumTypeSpec) *EnumTypeSpec {
 	slices.Range(spec.Values, func(v *EnumTypeSpecValue, idx int) {
 		if idx == 0 {
@@ -227,6 +349,9 @@ func (e *EnumType) loadSpecFromFS(pkgFS fs.FS) (*EnumTypeSpec, error) {
 	}
 	// parse file
 	spec := EnumTypeSpec{Type: FilebasedSpec}
+	var reservedColumns []reservedColumn // hint: columns with meta data of enum values
+	var dataColumns []int                // hint: indices of additional data columns
+	descriptionColumn := -1              // hint: index of the description column
 	{
 		cr := csv.NewReader(f)
 		{ // evaluate header
@@ -253,12 +378,33 @@ func (e *EnumType) loadSpecFromFS(pkgFS fs.FS) (*EnumTypeSpec, error) {
 			if !ok {
 				return nil, errors.New("header cannot contain numeric values")
 			}
-			additionalDataColumns := len(hdr) - 2
+			for colIdx, cell := range hdr[2:] {
+				if name, ok := getReservedColumnName(cell); ok {
+					if name == ReservedColumnOrder {
+						spec.HasOrder = true
+					}
+					if strings.HasPrefix(name, ReservedColumnLabel+"(") {
+						lang, err := parseLabelLanguage(name)
+						if err != nil {
+							return nil, fmt.Errorf("failed parsing header column %d. err: %w", colIdx+2, err)
+						}
+						if slices.Any(spec.LabelLanguages, func(v string, _ int) bool { return v == lang }) {
+							return nil, fmt.Errorf("header contains duplicate labels for language %q", lang)
+						}
+						spec.LabelLanguages = append(spec.LabelLanguages, lang)
+					}
+					reservedColumns = append(reservedColumns, reservedColumn{Index: colIdx + 2, Name: name})
+					continue
+				}
+				dataColumns = append(dataColumns, colIdx+2)
+			}
+			additionalDataColumns := len(dataColumns)
 			if additionalDataColumns > 0 {
 				spec.AdditionalData = &AdditionalData{
 					Headers: make([]*AdditionalDataHeader, additionalDataColumns),
 				}
-				_, err = slices.RangeErr(hdr[2:], func(cell string, idx int) error {
+				dataHdr := slices.Map(dataColumns, func(colIdx int, _ int) string { return hdr[colIdx] })
+				_, err = slices.RangeErr(dataHdr, func(cell string, idx int) error {
 					isTyped := IS_TYPED_HEADER.MatchString(cell)
 					if !isTyped { // ok, treat is as string type
 						spec.AdditionalData.Headers[idx] = &AdditionalDataHeader{Name: cell, Type: types.String}
@@ -278,6 +424,12 @@ func (e *EnumType) loadSpecFromFS(pkgFS fs.FS) (*EnumTypeSpec, error) {
 				if err != nil {
 					return nil, err
 				}
+				descIdx := slices.FindIndex(spec.AdditionalData.Headers, func(h *AdditionalDataHeader, _ int) bool {
+					return h.Name == DescriptionColumn && h.Type == types.String
+				})
+				if descIdx > -1 {
+					descriptionColumn = dataColumns[descIdx]
+				}
 			}
 		}
 		var fieldValueFormatter = func(t types.BasicKind, val string) string {
@@ -312,12 +464,21 @@ func (e *EnumType) loadSpecFromFS(pkgFS fs.FS) (*EnumTypeSpec, error) {
 					return nil, errors.New("enum sequences must start with either 0 or 1")
 				}
 				val := row[1]
-				spec.Values = append(spec.Values, &EnumTypeSpecValue{ID: id, EnumValue: val})
+				value := &EnumTypeSpecValue{ID: id, EnumValue: val}
+				if descriptionColumn > -1 {
+					value.Description = row[descriptionColumn]
+				}
+				for _, col := range reservedColumns {
+					if err := value.parseReservedColumn(col.Name, row[col.Index]); err != nil {
+						return nil, fmt.Errorf("failed parsing %q in row %d column %d. err: %w", col.Name, rowIdx+2, col.Index, err)
+					}
+				}
+				spec.Values = append(spec.Values, value)
 
 				if spec.AdditionalData == nil {
 					continue // no additional data, let's move to next row
 				}
-				dataCells := row[2:]
+				dataCells := slices.Map(dataColumns, func(colIdx int, _ int) string { return row[colIdx] })
 				// add a row of additional data
 				spec.AdditionalData.Rows = append(spec.AdditionalData.Rows, make([]*AdditionalDataCell, len(dataCells)))
 				dataRowIdx := len(spec.AdditionalData.Rows) - 1
@@ -341,7 +502,7 @@ func (e *EnumType) loadSpecFromFS(pkgFS fs.FS) (*EnumTypeSpec, error) {
 					return nil
 				})
 				if err != nil {
-					return nil, fmt.Errorf("failed parsing additional data in row %d column %d. err: %w", rowIdx+2, badColIdx+2, err)
+					return nil, fmt.Errorf("failed parsing additional data in row %d column %d. err: %w", rowIdx+2, dataColumns[badColIdx], err)
 				}
 			}
 		}
@@ -456,6 +617,53 @@ func (e *EnumType) ValidateSpec(fset *token.FileSet, typesInfo *types.Info) erro
 	if !ok {
 		return errors.New("enum spec sequences must increment at most by one")
 	}
+
+	// assert default value is part of the spec
+	if len(e.Config.Default) > 0 {
+		defaultIdx := slices.FindIndex(e.Spec.Values, func(v *EnumTypeSpecValue, _ int) bool {
+			return v.EnumValue == e.Config.Default
+		})
+		if defaultIdx == -1 {
+			specValues := slices.Map(e.Spec.Values, func(v *EnumTypeSpecValue, _ int) string { return v.EnumValue })
+			if suggestions := suggest.ClosestMatches(e.Config.Default, specValues); len(suggestions) > 0 {
+				return fmt.Errorf("default value %q is not part of the enum spec (did you mean %q?)", e.Config.Default, suggestions[0])
+			}
+			return fmt.Errorf("default value %q is not part of the enum spec", e.Config.Default)
+		}
+		e.Spec.Default = e.Spec.Values[defaultIdx]
+	}
+
+	// assert deprecations refer to the dominant values
+	badIdx := slices.FindIndex(e.Spec.Values, func(v *EnumTypeSpecValue, idx int) bool {
+		if !v.IsAlternative || !v.IsDeprecated {
+			return false
+		}
+		dominantIdx := slices.FindIndex(e.Spec.Values, func(d *EnumTypeSpecValue, _ int) bool {
+			return d.ID == v.ID
+		})
+		return !e.Spec.Values[dominantIdx].IsDeprecated
+	})
+	if badIdx > -1 {
+		return fmt.Errorf("alternative value %q cannot be deprecated without its dominant value", e.Spec.Values[badIdx].EnumValue)
+	}
+
+	// assert explicit orders are unique and shared by alternative values
+	if e.Spec.HasOrder {
+		badIdx = slices.FindIndex(e.Spec.Values, func(v *EnumTypeSpecValue, idx int) bool {
+			return v.IsAlternative && v.Order != e.Spec.Values[idx-1].Order
+		})
+		if badIdx > -1 {
+			return fmt.Errorf("alternative value %q must have the same order as its dominant value", e.Spec.Values[badIdx].EnumValue)
+		}
+		badIdx = slices.FindIndex(e.Spec.Values, func(v *EnumTypeSpecValue, idx int) bool {
+			return !v.IsAlternative && slices.Any(e.Spec.Values[:idx], func(p *EnumTypeSpecValue, _ int) bool {
+				return !p.IsAlternative && p.Order == v.Order
+			})
+		})
+		if badIdx > -1 {
+			return fmt.Errorf("value %q has the same order %d as a preceding value", e.Spec.Values[badIdx].EnumValue, e.Spec.Values[badIdx].Order)
+		}
+	}
 	return nil
 }
 
@@ -501,6 +709,9 @@ func (e *EnumType) CrossValidateConstBlockWithSpec(fset *token.FileSet, typesInf
 
 	// assert const block assertions
 	badIdx, err := slices.RangeErr(e.ConstBlock.Specs, func(vs *EnumValueSpec, _ int) error {
+		if _, ok, _ := vs.CustomValue(); ok {
+			return errors.New("custom values are not supported for filebased specs")
+		}
 		if vs.Node.Comment != nil {
 			assertToken := "// assert \""
 			lineComment := vs.Node.Comment.List[0].Text
@@ -527,3 +738,93 @@ func (e *EnumType) CrossValidateConstBlockWithSpec(fset *token.FileSet, typesInf
 	}
 	return nil
 }
+
+// ValidateUniqueValues asserts that the (transformed) string values of the spec
+// are unique. If the enum type supports case-insensitive lookups, the values are
+// also required to be unique by Unicode case folding.
+func (e *EnumType) ValidateUniqueValues(fset *token.FileSet) error {
+	ignoreCase := e.Config.Options.SupportedFeatures.Contains(config.SupportIgnoreCase)
+	for idx, v := range e.Spec.Values {
+		for prevIdx, prev := range e.Spec.Values[:idx] {
+			if prev.EnumValue == v.EnumValue {
+				return fmt.Errorf("%s and %s both map to %q", e.describeSpecValue(fset, prevIdx), e.describeSpecValue(fset, idx), v.EnumValue)
+			}
+			if ignoreCase && strings.EqualFold(prev.EnumValue, v.EnumValue) {
+				return fmt.Errorf("%s and %s both map to %q when ignoring case", e.describeSpecValue(fset, prevIdx), e.describeSpecValue(fset, idx), strings.ToLower(v.EnumValue))
+			}
+		}
+	}
+	return nil
+}
+
+// ValidateSetValues ensures that the String values can be encoded as elements
+// of a comma-joined set, if the enum supports sets and is serialized by any of
+// the joined set encodings (binary, gob, text, xml). A value must not contain
+// the separator ",". The Postgres arrays of the sql encodings quote their elements.
+func (e *EnumType) ValidateSetValues(fset *token.FileSet) error {
+	if !e.Config.Options.SupportedFeatures.Contains(config.SupportSet) {
+		return nil
+	}
+	var joined string
+	for _, s := range []string{config.SerializerBinary, config.SerializerGob, config.SerializerText, config.SerializerXML} {
+		if e.Config.Options.Serializers.Contains(s) {
+			joined = s
+			break
+		}
+	}
+	if len(joined) == 0 {
+		return nil
+	}
+	for idx, v := range e.Spec.Values {
+		if strings.Contains(v.EnumValue, ",") {
+			return fmt.Errorf("%s maps to %q, which cannot be an element of a %q serialized set (must not contain \",\")", e.describeSpecValue(fset, idx), v.EnumValue, joined)
+		}
+	}
+	return nil
+}
+
+// ValidateGraphQLNames ensures that the String values are legal GraphQL enum
+// values, if the enum is serialized via GraphQL. The generated undefined
+// value is serialized as null and thus exempt.
+func (e *EnumType) ValidateGraphQLNames(fset *token.FileSet) error {
+	if !e.Config.Options.Serializers.Contains(config.SerializerGQL) {
+		return nil
+	}
+	for idx, v := range e.Spec.Values {
+		if len(v.EnumValue) == 0 && v.ID == 0 && e.Config.Options.SupportedFeatures.Contains(config.SupportUndefined) {
+			continue
+		}
+		if !IsGraphQLName(v.EnumValue) {
+			return fmt.Errorf("%s maps to %q, which is not a valid GraphQL enum value (must match /[_A-Za-z][_0-9A-Za-z]*/ and not be true, false or null)", e.describeSpecValue(fset, idx), v.EnumValue)
+		}
+	}
+	return nil
+}
+
+// IsGraphQLName reports whether the string is a legal GraphQL enum value.
+func IsGraphQLName(s string) bool {
+	if len(s) == 0 || s == "true" || s == "false" || s == "null" {
+		return false
+	}
+	for idx, r := range s {
+		switch {
+		case r == '_', 'A' <= r && r <= 'Z', 'a' <= r && r <= 'z':
+		case '0' <= r && r <= '9' && idx > 0:
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// describeSpecValue refers to a spec value by its origin, which is either
+// its constant or its row within the source file.
+func (e *EnumType) describeSpecValue(fset *token.FileSet, idx int) string {
+	v := e.Spec.Values[idx]
+	if v.ConstSpec != nil {
+		pos := fset.Position(v.ConstSpec.Node.Pos())
+		return fmt.Sprintf("%q (%s:%d:%d)", v.ConstSpec.Node.Names[0].Name, filepath.Base(pos.Filename), pos.Line, pos.Column)
+	}
+	// hint: rows are counted from 1 and the first row is the header
+	return fmt.Sprintf("%q (%s:%d)", v.EnumValue, e.Config.FromSource, idx+2)
+}
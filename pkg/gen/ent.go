@@ -0,0 +1,62 @@
+package gen
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"path"
+
+	"github.com/mvrahden/go-enumer/about"
+	"github.com/mvrahden/go-enumer/config"
+	"github.com/mvrahden/go-enumer/pkg/enumer"
+)
+
+// entField returns the ent field builder, which matches the SQL storage
+// of the enum type: By its String values via `field.Enum`, if it is
+// serialized via "sql", and by its numeric values via the field of its
+// underlying integer type, if it is serialized via "sql.int".
+// The ent feature requires either of both serializers.
+// The name is given as Go expression.
+func entField(ts *enumer.EnumType, name, goType string) string {
+	if ts.Config.Options.Serializers.Contains(config.SerializerSQLInt) {
+		return fmt.Sprintf("field.%s(%s).GoType(%s(0))", pascalCaseTransformer(enumer.TypeToString(ts.Kind)), name, goType)
+	}
+	return fmt.Sprintf("field.Enum(%s).GoType(%s(0))", name, goType)
+}
+
+// ExportEntFields renders a field helper per enum of the file with support
+// for ent, which is meant to be placed into the ent schema package of the
+// given name. It returns nil if there are no such enums.
+func ExportEntFields(f *File, schemaPkgName string) ([]byte, error) {
+	entTypes := make([]*enumer.EnumType, 0, len(f.TypeSpecs))
+	for _, ts := range f.TypeSpecs {
+		if ts.Config.Options.SupportedFeatures.Contains(config.SupportEntInterface) {
+			entTypes = append(entTypes, ts)
+		}
+	}
+	if len(entTypes) == 0 {
+		return nil, nil
+	}
+
+	pkgName := f.Header.Package.Name
+	buf := new(bytes.Buffer)
+	fmt.Fprintf(buf, "// Code generated by %q; DO NOT EDIT.\n\n", about.ShortInfo())
+	fmt.Fprintf(buf, "package %s\n\n", schemaPkgName)
+	fmt.Fprintf(buf, "import (\n")
+	fmt.Fprintf(buf, "\t\"entgo.io/ent\"\n")
+	fmt.Fprintf(buf, "\t\"entgo.io/ent/schema/field\"\n\n")
+	if path.Base(f.Header.Package.Path) == pkgName {
+		fmt.Fprintf(buf, "\t%q\n", f.Header.Package.Path)
+	} else {
+		fmt.Fprintf(buf, "\t%s %q\n", pkgName, f.Header.Package.Path)
+	}
+	fmt.Fprintf(buf, ")\n")
+	for _, ts := range entTypes {
+		name := ts.Name().Name
+		fmt.Fprintf(buf, "\n// %sField returns an ent field of the given name for the enum type %s.%s.\n", name, pkgName, name)
+		fmt.Fprintf(buf, "func %sField(name string) ent.Field {\n", name)
+		fmt.Fprintf(buf, "\treturn %s\n", entField(ts, "name", pkgName+"."+name))
+		fmt.Fprintf(buf, "}\n")
+	}
+	return format.Source(buf.Bytes())
+}
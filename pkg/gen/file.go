@@ -1,9 +1,20 @@
 package gen
 
 import (
+	"strconv"
+	"strings"
+
+	"github.com/mvrahden/go-enumer/about"
 	"github.com/mvrahden/go-enumer/pkg/enumer"
 )
 
+const (
+	runtimePkgPath  = about.Repo + "/enum" // hint: the runtime package shared by all generated enums
+	runtimePkgAlias = "_enumer"            // hint: avoids conflicts with identifiers "enum" of the target package
+
+	goVersionIterators = "1.23" // hint: range-over-func iterators (package "iter")
+)
+
 type File struct {
 	Header    Header
 	Imports   []*Import
@@ -28,6 +39,40 @@ type Module struct {
 	GoVersion    string
 }
 
+// HasGoVersion reports whether the go directive of the module
+// is at least the given go version, e.g. "1.23".
+// An unknown go version is considered as the lowest common denominator.
+func (m Module) HasGoVersion(version string) bool {
+	if len(m.GoVersion) == 0 {
+		return false
+	}
+	have, want := parseGoVersion(m.GoVersion), parseGoVersion(version)
+	for idx := range want {
+		if have[idx] != want[idx] {
+			return have[idx] > want[idx]
+		}
+	}
+	return true
+}
+
+// SupportsIterators reports whether the module supports range-over-func iterators.
+func (m Module) SupportsIterators() bool {
+	return m.HasGoVersion(goVersionIterators)
+}
+
+// parseGoVersion parses the major, minor and patch version of a go version,
+// e.g. "1.21.0" or "1.21rc1". Any pre-release suffix is ignored.
+func parseGoVersion(v string) [3]int {
+	var out [3]int
+	for idx, el := range strings.SplitN(v, ".", 3) {
+		if end := strings.IndexFunc(el, func(r rune) bool { return r < '0' || r > '9' }); end >= 0 {
+			el = el[:end]
+		}
+		out[idx], _ = strconv.Atoi(el)
+	}
+	return out
+}
+
 type Import struct {
 	Name string // selector
 	Path string
@@ -8,7 +8,7 @@ import (
 )
 
 const (
-	packageEvalMode = packages.NeedSyntax | packages.NeedName | packages.NeedTypes | packages.NeedTypesInfo
+	packageEvalMode = packages.NeedSyntax | packages.NeedName | packages.NeedTypes | packages.NeedTypesInfo | packages.NeedModule
 )
 
 type gen struct {
@@ -22,6 +22,7 @@ type Inspector interface {
 
 type Renderer interface {
 	Render(f *File) ([]byte, error)
+	RenderTests(f *File) ([]byte, error)
 }
 
 func NewGenerator(i Inspector, r Renderer) *gen {
@@ -43,6 +44,15 @@ func loadPackage(targetPkg string) (*packages.Package, error) {
 }
 
 func (g *gen) Generate(targetPkg string) ([]byte, error) {
+	out, err := g.Inspect(targetPkg)
+	if err != nil {
+		return nil, err
+	}
+	return g.Render(out)
+}
+
+// Inspect loads the target package and detects all of its enums.
+func (g *gen) Inspect(targetPkg string) (*File, error) {
 	pkg, err := loadPackage(targetPkg)
 	if err != nil {
 		return nil, err
@@ -54,7 +64,21 @@ func (g *gen) Generate(targetPkg string) ([]byte, error) {
 	if len(out.TypeSpecs) == 0 {
 		return nil, fmt.Errorf("no enums detected.")
 	}
-	buf, err := g.r.Render(out)
+	return out, nil
+}
+
+// Render renders the formatted sources of an inspected file.
+func (g *gen) Render(f *File) ([]byte, error) {
+	buf, err := g.r.Render(f)
+	if err != nil {
+		return nil, err
+	}
+	return g.formatOutput(buf)
+}
+
+// RenderTests renders the formatted tests of an inspected file.
+func (g *gen) RenderTests(f *File) ([]byte, error) {
+	buf, err := g.r.RenderTests(f)
 	if err != nil {
 		return nil, err
 	}
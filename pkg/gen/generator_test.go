@@ -3,6 +3,7 @@ package gen
 import (
 	"bytes"
 	"fmt"
+	"go/types"
 	"io"
 	"os"
 	"path"
@@ -10,9 +11,11 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"golang.org/x/tools/go/packages"
 
 	"github.com/mvrahden/go-enumer/about"
 	"github.com/mvrahden/go-enumer/config"
+	"github.com/mvrahden/go-enumer/enum"
 	"github.com/mvrahden/go-enumer/pkg/enumer"
 )
 
@@ -32,6 +35,7 @@ func TestGeneratorExamples(t *testing.T) {
 		{"booking", "CSV source"},
 		{"colors", "CSV source with typed additional data"},
 		{"project", "a set of more realistic use cases"},
+		{"lookup", "CSV sources with each lookup strategy"},
 	} {
 		pkg := path.Join(packageBase, "examples", tC.directory)
 		testdatadir := filepath.Join("..", "..", "examples", tC.directory)
@@ -45,6 +49,18 @@ func TestGeneratorExamples(t *testing.T) {
 			require.NoError(t, err)
 			require.Equal(t, expected, string(srcs))
 		})
+		t.Run(fmt.Sprintf("Generate tests for package %q", tC.directory), func(t *testing.T) {
+			expected, err := os.ReadFile(filepath.Join(testdatadir, "generated_test.go"))
+			require.NoError(t, err)
+			cfg := getConfig(t, testdatadir)
+
+			g := NewGenerator(NewInspector(cfg), NewRenderer(cfg))
+			f, err := g.Inspect(pkg)
+			require.NoError(t, err)
+			tests, err := g.RenderTests(f)
+			require.NoError(t, err)
+			require.Equal(t, string(expected), string(tests))
+		})
 	}
 }
 
@@ -72,6 +88,12 @@ func TestGeneratorEdgeCaseDetection(t *testing.T) {
 			errMsg: "\"Unrelated\" type specification is invalid. err: enum const block must not contain unrelated type declarations"},
 		{directory: "docstring",
 			errMsg: "\"InvalidDocstring\" type specification is invalid. err: unknown option \"unsupported\""},
+		{directory: "unknown-transform",
+			errMsg: "\"UnknownTransform\" type specification is invalid. err: unknown transform strategy \"snkae\" (did you mean \"snake\"?)"},
+		{directory: "unknown-serializer",
+			errMsg: "\"UnknownSerializer\" type specification is invalid. err: unknown serializer \"jsno\" (did you mean \"json\"?)"},
+		{directory: "ent.missing-sql",
+			errMsg: "\"EntWithoutSQL\" type specification is invalid. err: feature \"ent\" requires serializer \"sql\" or \"sql.int\""},
 		{directory: "csv.no-path-traversal",
 			errMsg: "\"ForbiddenPathTraversalCSV\" type specification is invalid. err: source path cannot contain path traversals"},
 		{directory: "csv.no-path-traversal-2",
@@ -102,6 +124,46 @@ func TestGeneratorEdgeCaseDetection(t *testing.T) {
 			errMsg: "\"AssertionFailedCSV\" type specification is invalid. err: \"NotAnApple\" fails on assertion (reason: assertion failed)"},
 		{directory: "csv.assertion-failed-2",
 			errMsg: "\"AssertionFailedCSV\" type specification is invalid. err: \"NotAnApple\" fails on assertion (reason: missing terminating quote in assertion)"},
+		{directory: "transform-collision",
+			errMsg: "\"TransformCollision\" type specification is invalid. err: \"TransformCollisionDarkRed\" (enums.go:7:2) and \"TransformCollisionDark_Red\" (enums.go:8:2) both map to \"dark_red\""},
+		{directory: "csv.duplicate-value",
+			errMsg: "\"DuplicateValueCSV\" type specification is invalid. err: \"Apple\" (source.csv:2) and \"Apple\" (source.csv:4) both map to \"Apple\""},
+		{directory: "csv.ignore-case-collision",
+			errMsg: "\"IgnoreCaseCollisionCSV\" type specification is invalid. err: \"Apple\" (source.csv:2) and \"APPLE\" (source.csv:4) both map to \"apple\" when ignoring case"},
+		{directory: "custom-value",
+			errMsg: "\"InvalidCustomValue\" type specification is invalid. err: \"InvalidCustomValueB\" has an invalid custom value (reason: value must be a quoted string)"},
+		{directory: "csv.custom-value",
+			errMsg: "\"CustomValueCSV\" type specification is invalid. err: \"CustomApple\" fails on assertion (reason: custom values are not supported for filebased specs)"},
+		{directory: "deprecated-alternative",
+			errMsg: "\"DeprecatedAlternative\" type specification is invalid. err: alternative value \"Grey\" cannot be deprecated without its dominant value"},
+		{directory: "set.joined-separator",
+			errMsg: "\"JoinedSeparator\" type specification is invalid. err: \"JoinedSeparatorPepper\" (enums.go:8:2) maps to \"salt,pepper\", which cannot be an element of a \"text\" serialized set (must not contain \",\")"},
+		{directory: "csv.set-joined-separator",
+			errMsg: "\"JoinedSeparatorCSV\" type specification is invalid. err: \"Banana,Split\" (source.csv:3) maps to \"Banana,Split\", which cannot be an element of a \"xml\" serialized set (must not contain \",\")"},
+		{directory: "csv.invalid-deprecation",
+			errMsg: "\"InvalidDeprecationCSV\" type specification is invalid. err: failed parsing \"deprecated\" in row 3 column 2. err: strconv.ParseBool: parsing \"maybe\": invalid syntax"},
+		{directory: "unknown-default",
+			errMsg: "\"UnknownDefault\" type specification is invalid. err: default value \"Gren\" is not part of the enum spec (did you mean \"Green\"?)"},
+		{directory: "csv.invalid-label-language",
+			errMsg: "\"InvalidLabelLanguageCSV\" type specification is invalid. err: failed parsing header column 3. err: invalid language tag \"x\""},
+		{directory: "csv.duplicate-label-language",
+			errMsg: "\"DuplicateLabelLanguageCSV\" type specification is invalid. err: header contains duplicate labels for language \"en\""},
+		{directory: "default-undefined",
+			errMsg: "\"DefaultUndefined\" type specification is invalid. err: a default value cannot be combined with the \"undefined\" feature"},
+		{directory: "csv.invalid-order",
+			errMsg: "\"InvalidOrderCSV\" type specification is invalid. err: failed parsing \"order\" in row 3 column 2. err: strconv.ParseInt: parsing \"second\": invalid syntax"},
+		{directory: "csv.duplicate-order",
+			errMsg: "\"DuplicateOrderCSV\" type specification is invalid. err: value \"High\" has the same order 2 as a preceding value"},
+		{directory: "transitions.unknown-state",
+			errMsg: "\"UnknownTransitionState\" type specification is invalid. err: transition \"Open\" -> \"Closd\" refers to unknown state \"Closd\" (did you mean \"Closed\"?)"},
+		{directory: "transitions.unreachable-state",
+			errMsg: "\"UnreachableTransitionState\" type specification is invalid. err: state \"Archived\" is unreachable from initial state \"Open\""},
+		{directory: "csv.transitions-missing-file",
+			errMsg: "\"MissingTransitionsCSV\" type specification is invalid. err: no such transitions file"},
+		{directory: "graphql.invalid-name",
+			errMsg: "\"InvalidGraphQLName\" type specification is invalid. err: \"InvalidGraphQLNameDarkRed\" (enums.go:7:2) maps to \"dark-red\", which is not a valid GraphQL enum value"},
+		{directory: "lookup.perfect-hash-collision",
+			errMsg: "failed rendering sources for \"PerfectHashCollision\". err: lookup strategy \"perfect-hash\" cannot be applied. err: the hashes of \"abcdefgh_x_abcdefgh\" and \"abcdefgh_y_abcdefgh\" collide"},
 	} {
 		t.Run(fmt.Sprintf("Generate for package %q", tC.directory), func(t *testing.T) {
 			pkg := path.Join(packageBase, "examples", "_invalid", tC.directory)
@@ -134,3 +196,243 @@ func getExpectedOutputFile(t *testing.T, testdatadir string) string {
 	require.True(t, enumer.GEN_ENUMER_FILE.Match(firstLine), "Must be a generated file!")
 	return string(buf)
 }
+
+// goVersionInspector overrides the go version of the inspected module.
+type goVersionInspector struct {
+	Inspector
+	goVersion string
+}
+
+func (i goVersionInspector) Inspect(pkg *packages.Package) (*File, error) {
+	pkg.Module.GoVersion = i.goVersion
+	return i.Inspector.Inspect(pkg)
+}
+
+func TestGeneratorGoVersionFeatures(t *testing.T) {
+	for _, tC := range []struct {
+		goVersion         string
+		supportsIterators bool
+	}{
+		{goVersion: "", supportsIterators: false},
+		{goVersion: "1.20", supportsIterators: false},
+		{goVersion: "1.22.5", supportsIterators: false},
+		{goVersion: "1.23rc1", supportsIterators: true},
+		{goVersion: "1.23", supportsIterators: true},
+		{goVersion: "1.24.0", supportsIterators: true},
+	} {
+		t.Run(fmt.Sprintf("Generate for go version %q", tC.goVersion), func(t *testing.T) {
+			require.Equal(t, tC.supportsIterators, Module{GoVersion: tC.goVersion}.SupportsIterators())
+
+			pkg := path.Join(packageBase, "examples", "greetings")
+			cfg := getConfig(t, filepath.Join("..", "..", "examples", "greetings"))
+
+			g := NewGenerator(goVersionInspector{NewInspector(cfg), tC.goVersion}, NewRenderer(cfg))
+			srcs, err := g.Generate(pkg)
+			require.NoError(t, err)
+
+			for _, expected := range []string{
+				"\t\"iter\"\n",
+				"func GreetingAll() iter.Seq[Greeting] {",
+				"func GreetingAllWithStrings() iter.Seq2[Greeting, string] {",
+			} {
+				if tC.supportsIterators {
+					require.Contains(t, string(srcs), expected)
+				} else {
+					require.NotContains(t, string(srcs), expected)
+				}
+			}
+		})
+	}
+}
+
+func TestGeneratorRuntimePkgImport(t *testing.T) {
+	for _, tC := range []struct {
+		desc        string
+		serializers []string
+		features    []string
+		lookup      string
+		imports     bool
+	}{
+		{desc: "without serializers", imports: false},
+		{desc: "with numeric serializers", serializers: []string{"binary.varint", "sql.int"}, imports: false},
+		{desc: "with string based serializers", serializers: []string{"json"}, imports: true},
+		{desc: "with registry", features: []string{"registry"}, imports: true},
+		{desc: "with perfect hash lookup", lookup: config.LookupPerfectHash, imports: true},
+	} {
+		t.Run(fmt.Sprintf("Generate %s", tC.desc), func(t *testing.T) {
+			pkg := path.Join(packageBase, "examples", "greetings")
+			cfg := getConfig(t, filepath.Join("..", "..", "examples", "greetings"))
+			cfg.Serializers = tC.serializers
+			cfg.SupportedFeatures = tC.features
+			cfg.LookupStrategy = tC.lookup
+
+			g := NewGenerator(NewInspector(cfg), NewRenderer(cfg))
+			srcs, err := g.Generate(pkg)
+			require.NoError(t, err)
+			if tC.imports {
+				require.Contains(t, string(srcs), "\t_enumer \"github.com/mvrahden/go-enumer/enum\"\n")
+				require.Contains(t, string(srcs), "\tErrNoValidEnum = _enumer.ErrNoValidEnum\n")
+			} else {
+				require.NotContains(t, string(srcs), "_enumer")
+				require.Contains(t, string(srcs), "\t\"errors\"\n")
+				require.Contains(t, string(srcs), "\tErrNoValidEnum = errors.New(\"not a valid enum\")\n")
+			}
+		})
+	}
+}
+
+func TestGettextExport(t *testing.T) {
+	pkg := path.Join(packageBase, "examples", "project")
+	testdatadir := filepath.Join("..", "..", "examples", "project")
+	cfg := getConfig(t, testdatadir)
+
+	g := NewGenerator(NewInspector(cfg), NewRenderer(cfg))
+	f, err := g.Inspect(pkg)
+	require.NoError(t, err)
+
+	catalogs := ExportGettext(f)
+	require.Len(t, catalogs, 2)
+	for _, lang := range []string{"en", "de"} {
+		expected, err := os.ReadFile(filepath.Join(testdatadir, "locales", fmt.Sprintf("project.%s.po", lang)))
+		require.NoError(t, err)
+		require.Equal(t, string(expected), string(catalogs[lang]))
+	}
+}
+
+func TestGraphQLSchemaExport(t *testing.T) {
+	pkg := path.Join(packageBase, "examples", "booking")
+	testdatadir := filepath.Join("..", "..", "examples", "booking")
+	cfg := getConfig(t, testdatadir)
+
+	g := NewGenerator(NewInspector(cfg), NewRenderer(cfg))
+	f, err := g.Inspect(pkg)
+	require.NoError(t, err)
+
+	expected, err := os.ReadFile(filepath.Join(testdatadir, "graphql", "booking.graphqls"))
+	require.NoError(t, err)
+	require.Equal(t, string(expected), string(ExportGraphQLSchema(f)))
+}
+
+func TestSqlcOverridesExport(t *testing.T) {
+	pkg := path.Join(packageBase, "examples", "pills")
+	testdatadir := filepath.Join("..", "..", "examples", "pills")
+	cfg := getConfig(t, testdatadir)
+
+	g := NewGenerator(NewInspector(cfg), NewRenderer(cfg))
+	f, err := g.Inspect(pkg)
+	require.NoError(t, err)
+
+	expected, err := os.ReadFile(filepath.Join(testdatadir, "sqlc", "pills.sqlc.yaml"))
+	require.NoError(t, err)
+	require.Equal(t, string(expected), string(ExportSqlcOverrides(f)))
+}
+
+func TestEntFieldsExport(t *testing.T) {
+	pkg := path.Join(packageBase, "examples", "pills")
+	testdatadir := filepath.Join("..", "..", "examples", "pills")
+	cfg := getConfig(t, testdatadir)
+
+	g := NewGenerator(NewInspector(cfg), NewRenderer(cfg))
+	f, err := g.Inspect(pkg)
+	require.NoError(t, err)
+
+	expected, err := os.ReadFile(filepath.Join(testdatadir, "ent", "schema", "pills_enumer.go"))
+	require.NoError(t, err)
+	actual, err := ExportEntFields(f, "schema")
+	require.NoError(t, err)
+	require.Equal(t, string(expected), string(actual))
+}
+
+func TestEntField(t *testing.T) {
+	for _, tC := range []struct {
+		desc        string
+		kind        types.BasicKind
+		serializers []string
+		expected    string
+	}{
+		{"stored by String values via sql", types.Uint8, []string{"sql"},
+			"field.Enum(name).GoType(pkg.Enum(0))"},
+		{"stored by String values via sql and others", types.Uint32, []string{"json", "sql", "text"},
+			"field.Enum(name).GoType(pkg.Enum(0))"},
+		{"stored by numeric values via sql.int", types.Uint8, []string{"sql.int"},
+			"field.Uint8(name).GoType(pkg.Enum(0))"},
+		{"stored by numeric values via sql.int and others", types.Uint, []string{"binary", "json", "sql.int"},
+			"field.Uint(name).GoType(pkg.Enum(0))"},
+	} {
+		t.Run(tC.desc, func(t *testing.T) {
+			ts := &enumer.EnumType{
+				Kind:   tC.kind,
+				Config: &enumer.EnumTypeConfig{Options: &config.Options{Serializers: tC.serializers}},
+			}
+			require.Equal(t, tC.expected, entField(ts, "name", "pkg.Enum"))
+		})
+	}
+}
+
+func TestPerfectHash(t *testing.T) {
+	t.Run("places each string into a distinct slot", func(t *testing.T) {
+		for _, n := range []int{1, 2, 3, 16, 240, 4096} {
+			values := make([]string, n)
+			for i := range values {
+				values[i] = fmt.Sprintf("Value%d", i)
+			}
+			ph, err := newPerfectHash(values)
+			require.NoError(t, err, n)
+			require.Len(t, ph.Slots, n)
+			for idx, v := range values {
+				h := enum.HashV1(v)
+				slot := enum.DisplaceV1(h, ph.Seeds[h%uint32(len(ph.Seeds))]) % uint32(n)
+				require.Equal(t, idx, ph.Slots[slot], v)
+			}
+		}
+	})
+	t.Run("fails on colliding hashes", func(t *testing.T) {
+		_, err := newPerfectHash([]string{"abcdefgh_x_abcdefgh", "abcdefgh_y_abcdefgh"})
+		require.EqualError(t, err, "the hashes of \"abcdefgh_x_abcdefgh\" and \"abcdefgh_y_abcdefgh\" collide")
+	})
+	t.Run("resolves the lookup strategy of colliding values", func(t *testing.T) {
+		ts := &enumer.EnumType{
+			Config: &enumer.EnumTypeConfig{Options: &config.Options{}},
+			Spec:   &enumer.EnumTypeSpec{},
+		}
+		for i := 0; i <= maxSwitchLookupValues; i++ {
+			// hint: all values share their length and their first and last 8 bytes
+			ts.Spec.Values = append(ts.Spec.Values, &enumer.EnumTypeSpecValue{EnumValue: fmt.Sprintf("abcdefgh_%04d_abcdefgh", i)})
+		}
+		for _, tC := range []struct {
+			strategy string
+			expected string
+			errMsg   string
+		}{
+			{strategy: "", expected: config.LookupMap},
+			{strategy: config.LookupAuto, expected: config.LookupMap},
+			{strategy: config.LookupSwitch, expected: config.LookupSwitch},
+			{strategy: config.LookupPerfectHash, errMsg: "lookup strategy \"perfect-hash\" cannot be applied. err: the hashes of"},
+		} {
+			ts.Config.Options.LookupStrategy = tC.strategy
+			strategy, ph, err := resolveLookup(ts)
+			if len(tC.errMsg) > 0 {
+				require.ErrorContains(t, err, tC.errMsg)
+				continue
+			}
+			require.NoError(t, err)
+			require.Nil(t, ph)
+			require.Equal(t, tC.expected, strategy, tC.strategy)
+		}
+	})
+}
+
+func TestFoldTable(t *testing.T) {
+	t.Run("buckets by length in bytes with ASCII folding", func(t *testing.T) {
+		values := []string{"Mars", "Venus", "mars", "Pluto", "Io"}
+		ft := newFoldTable(values, false)
+		require.Equal(t, []int{4, 0, 3, 1}, ft.Entries) // hint: "mars" is shadowed by "Mars"
+		require.Equal(t, []int{0, 0, 0, 1, 1, 2, 4}, ft.Offsets)
+	})
+	t.Run("buckets by count of runes with Unicode folding", func(t *testing.T) {
+		values := []string{"Россия", "ſun", "SUN", "Česká"}
+		ft := newFoldTable(values, true)
+		require.Equal(t, []int{1, 3, 0}, ft.Entries) // hint: "SUN" is shadowed by "ſun"
+		require.Equal(t, []int{0, 0, 0, 0, 1, 1, 2, 3}, ft.Offsets)
+	})
+}
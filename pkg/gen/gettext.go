@@ -0,0 +1,58 @@
+package gen
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+
+	"github.com/mvrahden/go-enumer/about"
+	"github.com/mvrahden/go-enumer/pkg/enumer"
+)
+
+// ExportGettext renders the labels of all enums of the file as gettext
+// message catalogs (.po), one per language. The catalogs are keyed by
+// their language tag. Each message is identified by the String value of the
+// enum value (msgid) and the name of its enum type (msgctxt).
+func ExportGettext(f *File) map[string][]byte {
+	out := map[string]*bytes.Buffer{}
+	for _, ts := range f.TypeSpecs {
+		for langIdx, lang := range ts.Spec.LabelLanguages {
+			buf, ok := out[lang]
+			if !ok {
+				buf = new(bytes.Buffer)
+				writeGettextHeader(buf, f.Header.Package.Name, lang)
+				out[lang] = buf
+			}
+			for _, v := range ts.Spec.Values {
+				if v.IsAlternative {
+					continue
+				}
+				writeGettextMessage(buf, ts, v, langIdx)
+			}
+		}
+	}
+	catalogs := make(map[string][]byte, len(out))
+	for lang, buf := range out {
+		catalogs[lang] = buf.Bytes()
+	}
+	return catalogs
+}
+
+func writeGettextHeader(buf *bytes.Buffer, pkgName, lang string) {
+	fmt.Fprintf(buf, "# Labels of the enums of package %q.\n", pkgName)
+	fmt.Fprintf(buf, "# Exported by %q.\n", about.ShortInfo())
+	fmt.Fprintf(buf, "msgid \"\"\n")
+	fmt.Fprintf(buf, "msgstr \"\"\n")
+	fmt.Fprintf(buf, "%s\n", strconv.Quote(fmt.Sprintf("Language: %s\n", lang)))
+	fmt.Fprintf(buf, "%s\n", strconv.Quote("MIME-Version: 1.0\n"))
+	fmt.Fprintf(buf, "%s\n", strconv.Quote("Content-Type: text/plain; charset=UTF-8\n"))
+	fmt.Fprintf(buf, "%s\n", strconv.Quote("Content-Transfer-Encoding: 8bit\n"))
+}
+
+func writeGettextMessage(buf *bytes.Buffer, ts *enumer.EnumType, v *enumer.EnumTypeSpecValue, langIdx int) {
+	fmt.Fprintf(buf, "\n")
+	fmt.Fprintf(buf, "#. %s(%d)\n", ts.Name().Name, v.ID)
+	fmt.Fprintf(buf, "msgctxt %s\n", strconv.Quote(ts.Name().Name))
+	fmt.Fprintf(buf, "msgid %s\n", strconv.Quote(v.EnumValue))
+	fmt.Fprintf(buf, "msgstr %s\n", strconv.Quote(v.Labels[langIdx]))
+}
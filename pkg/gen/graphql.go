@@ -0,0 +1,74 @@
+package gen
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/mvrahden/go-enumer/about"
+	"github.com/mvrahden/go-enumer/config"
+	"github.com/mvrahden/go-enumer/pkg/enumer"
+)
+
+// ExportGraphQLSchema renders all enums of the file, which are serialized via
+// the "graphql" serializer, as GraphQL schema (SDL) enum type definitions.
+// The doc comments of the types and the descriptions of the values become
+// the descriptions of the schema. It returns nil if there are no such enums.
+func ExportGraphQLSchema(f *File) []byte {
+	buf := new(bytes.Buffer)
+	for _, ts := range f.TypeSpecs {
+		if !ts.Config.Options.Serializers.Contains(config.SerializerGQL) {
+			continue
+		}
+		if buf.Len() == 0 {
+			writeGraphQLHeader(buf, f.Header.Package.Name)
+		}
+		writeGraphQLEnum(buf, ts)
+	}
+	if buf.Len() == 0 {
+		return nil
+	}
+	return buf.Bytes()
+}
+
+func writeGraphQLHeader(buf *bytes.Buffer, pkgName string) {
+	fmt.Fprintf(buf, "# GraphQL enums of package %q.\n", pkgName)
+	fmt.Fprintf(buf, "# Exported by %q.\n", about.ShortInfo())
+}
+
+func writeGraphQLEnum(buf *bytes.Buffer, ts *enumer.EnumType) {
+	fmt.Fprintf(buf, "\n")
+	if ts.Node.Doc != nil {
+		if doc := strings.TrimSpace(ts.Node.Doc.Text()); len(doc) > 0 {
+			writeGraphQLDescription(buf, "", doc)
+		}
+	}
+	fmt.Fprintf(buf, "enum %s {\n", ts.Name().Name)
+	for _, v := range ts.Spec.Values {
+		if v.IsAlternative || len(v.EnumValue) == 0 {
+			continue // hint: alternative values are not serialized and the undefined value is serialized as null
+		}
+		if len(v.Description) > 0 {
+			writeGraphQLDescription(buf, "  ", v.Description)
+		}
+		if v.IsDeprecated {
+			fmt.Fprintf(buf, "  %s @deprecated\n", v.EnumValue)
+			continue
+		}
+		fmt.Fprintf(buf, "  %s\n", v.EnumValue)
+	}
+	fmt.Fprintf(buf, "}\n")
+}
+
+func writeGraphQLDescription(buf *bytes.Buffer, indent, doc string) {
+	doc = strings.ReplaceAll(doc, `"""`, `\"""`)
+	if !strings.Contains(doc, "\n") {
+		fmt.Fprintf(buf, "%s\"\"\"%s\"\"\"\n", indent, doc)
+		return
+	}
+	fmt.Fprintf(buf, "%s\"\"\"\n", indent)
+	for _, line := range strings.Split(doc, "\n") {
+		fmt.Fprintf(buf, "%s%s\n", indent, line)
+	}
+	fmt.Fprintf(buf, "%s\"\"\"\n", indent)
+}
@@ -95,9 +95,28 @@ func (i inspector) loadEnumTypes(pkg *packages.Package, out *File) error {
 	if err != nil {
 		goto SPEC_IS_INVALID
 	}
+	idx, err = slices.RangeErr(enumTypes, func(v *enumer.EnumType, _ int) error {
+		return v.LoadTransitions(pkg.Fset)
+	})
+	if err != nil {
+		goto SPEC_IS_INVALID
+	}
 	idx, err = slices.RangeErr(enumTypes, func(v *enumer.EnumType, _ int) error {
 		return v.CrossValidateConstBlockWithSpec(pkg.Fset, pkg.TypesInfo)
 	})
+	if err != nil {
+		goto SPEC_IS_INVALID
+	}
+	idx, err = slices.RangeErr(enumTypes, func(v *enumer.EnumType, _ int) error {
+		i.transformSpecValues(v)
+		if err := v.ValidateUniqueValues(pkg.Fset); err != nil {
+			return err
+		}
+		if err := v.ValidateSetValues(pkg.Fset); err != nil {
+			return err
+		}
+		return v.ValidateGraphQLNames(pkg.Fset)
+	})
 SPEC_IS_INVALID:
 	if err != nil {
 		return fmt.Errorf("%q type specification is invalid. err: %w", enumTypes[idx].Name(), err)
@@ -107,6 +126,24 @@ SPEC_IS_INVALID:
 	return nil
 }
 
+// transformSpecValues applies the configured string case transformation
+// to the values of simple block specs. Filebased specs and custom values
+// are taken as given.
+func (inspector) transformSpecValues(et *enumer.EnumType) {
+	if et.HasFileSpec() {
+		et.Config.Options.TransformStrategy = config.TransformNoop
+	}
+
+	util := newRenderUtil(et.Config.Options)
+
+	for _, v := range et.Spec.Values {
+		if v.IsCustom {
+			continue
+		}
+		v.EnumValue = util.transform(v.EnumValue)
+	}
+}
+
 func (inspector) detectTypeSpecs(insp *goinspect.Inspector, typesInfo *types.Info, genFile *ast.File) ([]*enumer.EnumType, error) {
 	var errs []error
 	var enumTypes []*enumer.EnumType
@@ -159,24 +196,74 @@ func (inspector) loadHeader(pkg *packages.Package, out *File) {
 }
 
 func (i inspector) determineImports(f *File) {
-	f.Imports = append(f.Imports, &Import{Path: "errors"})
 	f.Imports = append(f.Imports, &Import{Path: "fmt"})
+	if slices.Any(f.TypeSpecs, func(ts *enumer.EnumType, _ int) bool { return requiresRuntimePkg(ts) }) {
+		f.Imports = append(f.Imports, &Import{Name: runtimePkgAlias, Path: runtimePkgPath})
+	} else {
+		f.Imports = append(f.Imports, &Import{Path: "errors"}) // hint: declares a local ErrNoValidEnum
+	}
+	if f.Header.Module.SupportsIterators() {
+		f.Imports = append(f.Imports, &Import{Path: "iter"})
+	}
+
+	f.Imports = append(f.Imports, &Import{Path: "strings"}) // hint: required by the completion helpers
 
 	// we add all imports (also duplicates)
 	for _, ts := range f.TypeSpecs {
+		if len(ts.Spec.LabelLanguages) > 0 {
+			f.Imports = append(f.Imports, &Import{Path: "golang.org/x/text/language"})
+		}
+		if foldsUnicode(ts) {
+			f.Imports = append(f.Imports, &Import{Path: "strings"})
+			if hasFoldTable(ts) {
+				f.Imports = append(f.Imports, &Import{Path: "unicode/utf8"})
+			}
+		}
+		if ts.Config.Options.SupportedFeatures.Contains(config.SupportGorm) && storesStrings(ts) {
+			f.Imports = append(f.Imports, &Import{Path: "gorm.io/gorm"})
+			f.Imports = append(f.Imports, &Import{Path: "gorm.io/gorm/schema"})
+		}
+		if ts.Config.Options.SupportedFeatures.Contains(config.SupportPgx) {
+			if storesStrings(ts) {
+				f.Imports = append(f.Imports, &Import{Path: "context"})
+				f.Imports = append(f.Imports, &Import{Path: "github.com/jackc/pgx/v5"})
+			}
+			f.Imports = append(f.Imports, &Import{Path: "github.com/jackc/pgx/v5/pgtype"})
+		}
+		if ts.Config.Options.SupportedFeatures.Contains(config.SupportSlog) {
+			f.Imports = append(f.Imports, &Import{Path: "log/slog"})
+		}
+		if ts.Config.Options.SupportedFeatures.Contains(config.SupportZap) {
+			f.Imports = append(f.Imports, &Import{Path: "go.uber.org/zap/zapcore"})
+		}
+		if ts.Config.Options.SupportedFeatures.Contains(config.SupportSet) {
+			f.Imports = append(f.Imports, &Import{Path: "math/bits"})
+			f.Imports = append(f.Imports, &Import{Path: "strings"})
+		}
 		for _, v := range ts.Config.Options.Serializers {
 			switch v {
+			case config.SerializerBinaryVarint:
+				f.Imports = append(f.Imports, &Import{Path: "encoding/binary"})
 			case config.SerializerBSON:
 				f.Imports = append(f.Imports, &Import{Path: "go.mongodb.org/mongo-driver/bson"})
 				f.Imports = append(f.Imports, &Import{Path: "go.mongodb.org/mongo-driver/bson/bsontype"})
 				f.Imports = append(f.Imports, &Import{Path: "go.mongodb.org/mongo-driver/x/bsonx/bsoncore"})
+			case config.SerializerCBOR:
+				f.Imports = append(f.Imports, &Import{Path: "github.com/fxamacker/cbor/v2"})
 			case config.SerializerGQL:
 				f.Imports = append(f.Imports, &Import{Path: "io"})
 				f.Imports = append(f.Imports, &Import{Path: "strconv"})
 			case config.SerializerJSON:
 				f.Imports = append(f.Imports, &Import{Path: "encoding/json"})
+			case config.SerializerMsgpack:
+				f.Imports = append(f.Imports, &Import{Path: "github.com/vmihailenco/msgpack/v5"})
 			case config.SerializerSQL:
 				f.Imports = append(f.Imports, &Import{Path: "database/sql/driver"})
+			case config.SerializerSQLInt:
+				f.Imports = append(f.Imports, &Import{Path: "database/sql/driver"})
+				f.Imports = append(f.Imports, &Import{Path: "strconv"})
+			case config.SerializerXML:
+				f.Imports = append(f.Imports, &Import{Path: "encoding/xml"})
 			case config.SerializerYamlV3:
 				f.Imports = append(f.Imports, &Import{Path: "gopkg.in/yaml.v3"})
 			}
@@ -195,6 +282,32 @@ func (i inspector) determineImports(f *File) {
 	})
 }
 
+// requiresRuntimePkg tests whether the generated code of the enum refers to the runtime package.
+// It is required by the registry, by the perfect hash lookup, by the fold table lookup
+// with ASCII folding, by the parse errors of all string based deserializers
+// and by the Postgres arrays of sets stored as numeric values.
+func requiresRuntimePkg(ts *enumer.EnumType) bool {
+	features := ts.Config.Options.SupportedFeatures
+	strategy, _, _ := resolveLookup(ts) // hint: errors are reported upon rendering
+	switch {
+	case features.Contains(config.SupportRegistry),
+		strategy == config.LookupPerfectHash,
+		!foldsUnicode(ts),
+		parsesStrings(ts),
+		features.Contains(config.SupportSet) && ts.Config.Options.Serializers.Contains(config.SerializerSQLInt):
+		return true
+	}
+	return false
+}
+
+// parsesStrings tests whether any serializer of the enum deserializes its String values,
+// i.e. any serializer except for the numeric ones ("binary.varint" and "sql.int").
+func parsesStrings(ts *enumer.EnumType) bool {
+	return slices.Any(ts.Config.Options.Serializers, func(v string, _ int) bool {
+		return v != config.SerializerBinaryVarint && v != config.SerializerSQLInt
+	})
+}
+
 func (i inspector) sortTypeSpecs(f *File) {
 	// sort all enums
 	f.TypeSpecs = slices.SortStable(f.TypeSpecs, func(s []*enumer.EnumType, i, j int) bool {
@@ -0,0 +1,190 @@
+package gen
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/mvrahden/go-enumer/config"
+	"github.com/mvrahden/go-enumer/enum"
+	"github.com/mvrahden/go-enumer/pkg/enumer"
+	"github.com/mvrahden/go-enumer/pkg/utils/slices"
+)
+
+const (
+	maxSwitchLookupValues = 512     // hint: the "auto" lookup strategy applies a switch up to this count of values
+	maxPerfectHashSeed    = 1 << 20 // hint: the seeds tried per bucket, before building a perfect hash is given up
+)
+
+// lookupStrategy returns the configured lookup strategy of the FromString functions of the enum.
+// It defaults to "map". The "auto" strategy selects it by the count of values: enums with up
+// to 512 values are looked up by a switch statement, whose binary search outperforms the hashing
+// strategies at those sizes, and all larger enums by a minimal perfect hash.
+func lookupStrategy(ts *enumer.EnumType) string {
+	switch s := ts.Config.Options.LookupStrategy; s {
+	case config.LookupMap, config.LookupSwitch, config.LookupPerfectHash:
+		return s
+	case config.LookupAuto:
+		if len(ts.Spec.Values) <= maxSwitchLookupValues {
+			return config.LookupSwitch
+		}
+		return config.LookupPerfectHash
+	}
+	return config.LookupMap
+}
+
+// resolveLookup resolves the lookup strategy of the enum and builds the perfect hash
+// of its String values, if required. If no perfect hash can be built, an explicitly
+// configured "perfect-hash" strategy fails, while the "auto" strategy falls back to "map".
+func resolveLookup(ts *enumer.EnumType) (string, *perfectHash, error) {
+	strategy := lookupStrategy(ts)
+	if strategy != config.LookupPerfectHash {
+		return strategy, nil, nil
+	}
+	ph, err := newPerfectHash(slices.Map(ts.Spec.Values, func(v *enumer.EnumTypeSpecValue, _ int) string { return v.EnumValue }))
+	if err == nil {
+		return strategy, ph, nil
+	}
+	if ts.Config.Options.LookupStrategy == config.LookupPerfectHash {
+		return "", nil, fmt.Errorf("lookup strategy %q cannot be applied. err: %w", config.LookupPerfectHash, err)
+	}
+	return config.LookupMap, nil, nil
+}
+
+// perfectHash is a minimal perfect hash of a set of strings, built by hash and displace:
+// All strings are distributed to buckets by their hash and each bucket is assigned the seed,
+// which displaces all of its strings into free slots of the table.
+// The slot of a string is determined by
+//
+//	h := enum.HashV1(s)
+//	slot := enum.DisplaceV1(h, Seeds[h%len(Seeds)]) % len(Slots)
+type perfectHash struct {
+	Seeds []uint32 // hint: the seed of each bucket
+	Slots []int    // hint: the index of the string in each slot of the table
+}
+
+// newPerfectHash builds the perfect hash of the values, which are required to be unique.
+// It fails, if the hashes of two strings collide or if no seed displaces
+// the strings of a bucket into free slots.
+func newPerfectHash(values []string) (*perfectHash, error) {
+	n := uint32(len(values))
+	ph := &perfectHash{
+		Seeds: make([]uint32, n/2+1),
+		Slots: slices.Map(values, func(_ string, _ int) int { return -1 }),
+	}
+	hashes := slices.Map(values, func(s string, _ int) uint32 { return enum.HashV1(s) })
+	seen := make(map[uint32]int, n)
+	for idx, h := range hashes {
+		if prev, ok := seen[h]; ok {
+			// hint: strings of equal hashes cannot be displaced into distinct slots
+			return nil, fmt.Errorf("the hashes of %q and %q collide", values[prev], values[idx])
+		}
+		seen[h] = idx
+	}
+	buckets := make([][]int, len(ph.Seeds))
+	for idx, h := range hashes {
+		b := h % uint32(len(buckets))
+		buckets[b] = append(buckets[b], idx)
+	}
+	// hint: the larger buckets are displaced first, while most of the slots are still free
+	order := slices.SortStable(slices.Map(buckets, func(_ []int, idx int) int { return idx }), func(s []int, i, j int) bool {
+		return len(buckets[s[i]]) > len(buckets[s[j]])
+	})
+
+	slots := make([]uint32, 0, n)
+	for _, b := range order {
+		if len(buckets[b]) == 0 {
+			break
+		}
+		seed := uint32(0)
+	NEXT_SEED:
+		for ; seed < maxPerfectHashSeed; seed++ {
+			slots = slots[:0]
+			for _, idx := range buckets[b] {
+				slot := enum.DisplaceV1(hashes[idx], seed) % n
+				if ph.Slots[slot] != -1 || slices.Any(slots, func(v uint32, _ int) bool { return v == slot }) {
+					continue NEXT_SEED
+				}
+				slots = append(slots, slot)
+			}
+			break
+		}
+		if seed == maxPerfectHashSeed {
+			return nil, fmt.Errorf("no seed displaces the bucket of %q into free slots", values[buckets[b][0]])
+		}
+		ph.Seeds[b] = seed
+		for i, idx := range buckets[b] {
+			ph.Slots[slots[i]] = idx
+		}
+	}
+	return ph, nil
+}
+
+// foldsUnicode tests whether the case-insensitive lookup of the enum ignores the case
+// by Unicode simple folding (like strings.EqualFold) instead of ASCII folding only.
+// This applies to enums with the "unicode-fold" feature, to all enums with non-ASCII String values
+// and to all enums without the "ignore-case" feature, which scan their String values without a fold table.
+func foldsUnicode(ts *enumer.EnumType) bool {
+	if !hasFoldTable(ts) || ts.Config.Options.SupportedFeatures.Contains(config.SupportUnicodeFold) {
+		return true
+	}
+	return slices.Any(ts.Spec.Values, func(v *enumer.EnumTypeSpecValue, _ int) bool {
+		return strings.IndexFunc(v.EnumValue, func(r rune) bool { return r >= utf8.RuneSelf }) >= 0
+	})
+}
+
+// hasFoldTable tests whether the case-insensitive lookup of the enum searches a fold table,
+// which is only generated for enums with the "ignore-case" feature. All others scan their String values.
+func hasFoldTable(ts *enumer.EnumType) bool {
+	return ts.Config.Options.SupportedFeatures.Contains(config.SupportIgnoreCase)
+}
+
+// foldTable is the table of the case-insensitive lookup of the enum values.
+// Its entries are grouped into buckets by the length of their strings, i.e. by
+// the count of bytes with ASCII folding resp. by the count of runes with Unicode
+// simple folding, which retains the count of runes. Within their buckets,
+// the entries are ordered by enum.CompareFoldASCII for a binary search with ASCII folding,
+// while they are scanned by strings.EqualFold with Unicode simple folding.
+type foldTable struct {
+	Entries []int // hint: the index of the string of each entry
+	Offsets []int // hint: the entries of the strings of length l are Entries[Offsets[l]:Offsets[l+1]]
+}
+
+// newFoldTable builds the fold table of the values. Values, which equal a preceding
+// value when ignoring the case, are shadowed by it and omitted.
+func newFoldTable(values []string, unicode bool) *foldTable {
+	equal := func(a, b string) bool { return enum.CompareFoldASCII(a, b) == 0 }
+	length := func(s string) int { return len(s) }
+	if unicode {
+		equal, length = strings.EqualFold, utf8.RuneCountInString
+	}
+
+	var entries []int
+	for idx, v := range values {
+		if slices.None(values[:idx], func(p string, _ int) bool { return equal(p, v) }) {
+			entries = append(entries, idx)
+		}
+	}
+	entries = slices.SortStable(entries, func(s []int, i, j int) bool {
+		a, b := values[s[i]], values[s[j]]
+		if length(a) != length(b) {
+			return length(a) < length(b)
+		}
+		return enum.CompareFoldASCII(a, b) < 0
+	})
+
+	maxLength := slices.Reduce(entries, func(idx int, acc int) int {
+		if l := length(values[idx]); l > acc {
+			return l
+		}
+		return acc
+	})
+	offsets := make([]int, maxLength+2)
+	for _, idx := range entries {
+		offsets[length(values[idx])+1]++
+	}
+	for l := 1; l < len(offsets); l++ {
+		offsets[l] += offsets[l-1]
+	}
+	return &foldTable{Entries: entries, Offsets: offsets}
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"embed"
 	"fmt"
+	"strconv"
 	"strings"
 	"text/template"
 
@@ -37,7 +38,7 @@ func (r *renderer) Render(f *File) ([]byte, error) {
 	}
 
 	idx, err := slices.RangeErr(f.TypeSpecs, func(ts *enumer.EnumType, _ int) error {
-		return r.renderForTypeSpec(buf, ts)
+		return r.renderForTypeSpec(buf, f.Header, ts)
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed rendering sources for %q. err: %w", f.TypeSpecs[idx].Name().Name, err)
@@ -47,44 +48,46 @@ func (r *renderer) Render(f *File) ([]byte, error) {
 
 func (r *renderer) renderFileHeader(buf *bytes.Buffer, f *File) error {
 	type TplData struct {
-		RepoName          string
-		PackageName       string
-		Imports           []*Import
-		ContainsErrorsPkg bool
+		RepoName           string
+		PackageName        string
+		Imports            []*Import
+		ContainsRuntimePkg bool
 	}
 	data := TplData{
-		RepoName:          about.ShortInfo(),
-		PackageName:       f.Header.Package.Name,
-		Imports:           f.Imports,
-		ContainsErrorsPkg: slices.Any(f.Imports, func(v *Import, idx int) bool { return v.Path == "errors" }),
+		RepoName:           about.ShortInfo(),
+		PackageName:        f.Header.Package.Name,
+		Imports:            f.Imports,
+		ContainsRuntimePkg: slices.Any(f.Imports, func(v *Import, idx int) bool { return v.Path == runtimePkgPath }),
 	}
 	return headerTpl.ExecuteTemplate(buf, "header.go.tpl", map[string]any{"Header": data})
 }
 
-func (r *renderer) renderForTypeSpec(buf *bytes.Buffer, ts *enumer.EnumType) error {
-	if ts.HasFileSpec() {
-		ts.Config.Options.TransformStrategy = "noop"
-	}
-
-	util := newRenderUtil(ts.Config.Options)
-
-	for _, v := range ts.Spec.Values {
-		v.EnumValue = util.transform(v.EnumValue)
-	}
+func (r *renderer) renderForTypeSpec(buf *bytes.Buffer, header Header, ts *enumer.EnumType) error {
+	pkg, module := header.Package, header.Module
 
 	type EnumValue struct {
-		Value              uint64 // hint: the enum's numeric representation
-		String             string // hint: the enum's string representation
-		ConstName          string // hint: the enum's constant name
-		Position           int    // hint: start index of enum value string within enum aggregate string
-		Length             int    // hint: length of
-		IsAlternativeValue bool   // hint: is the enum an alternative value
+		Value              uint64   // hint: the enum's numeric representation
+		String             string   // hint: the enum's string representation
+		ConstName          string   // hint: the enum's constant name
+		Position           int      // hint: start index of enum value string within enum aggregate string
+		Length             int      // hint: length of
+		IsAlternativeValue bool     // hint: is the enum an alternative value
+		IsDeprecated       bool     // hint: is the enum value deprecated
+		Labels             []string // hint: the enum's human-readable labels in order of the label languages
 	}
 	type Enum struct {
 		Name                            string
 		Values                          []EnumValue
 		RequiresGeneratedUndefinedValue bool
 		IsFromCsvSource                 bool
+		HasDeprecatedValues             bool
+		HasDefault                      bool     // hint: has an explicitly configured default value
+		DefaultValue                    string   // hint: the source representation of the default value
+		HasLabels                       bool     // hint: has human-readable labels
+		LabelLanguages                  []string // hint: the language tags of the labels
+		HasOrder                        bool     // hint: values are ordered explicitly instead of by their IDs
+		OrderedValues                   []uint64 // hint: the unique values in order of the explicit ordering
+		Indices                         []int    // hint: the position of each unique value within the explicit ordering
 		HasAdditionalData               bool
 		AdditionalData                  *enumer.AdditionalData
 	}
@@ -104,14 +107,39 @@ func (r *renderer) renderForTypeSpec(buf *bytes.Buffer, ts *enumer.EnumType) err
 				}),
 				Length:             len(v.EnumValue),
 				IsAlternativeValue: v.IsAlternative,
+				IsDeprecated:       v.IsDeprecated,
+				Labels:             v.Labels,
 			}
 		}),
 		RequiresGeneratedUndefinedValue: ts.Config.Options.SupportedFeatures.Contains(config.SupportUndefined) &&
 			slices.None(ts.Spec.Values, func(v *enumer.EnumTypeSpecValue, idx int) bool { return v.ID == 0 }),
-		IsFromCsvSource:   ts.HasFileSpec(),
+		IsFromCsvSource: ts.HasFileSpec(),
+		HasDeprecatedValues: slices.Any(ts.Spec.Values, func(v *enumer.EnumTypeSpecValue, idx int) bool {
+			return v.IsDeprecated
+		}),
+		HasLabels:         len(ts.Spec.LabelLanguages) > 0,
+		LabelLanguages:    ts.Spec.LabelLanguages,
 		HasAdditionalData: ts.Spec.AdditionalData != nil,
 		AdditionalData:    ts.Spec.AdditionalData,
 	}
+	if ts.Spec.HasOrder {
+		unique := slices.Filter(ts.Spec.Values, func(v *enumer.EnumTypeSpecValue, _ int) bool { return !v.IsAlternative })
+		ordered := slices.SortStable(unique, func(s []*enumer.EnumTypeSpecValue, i, j int) bool {
+			return s[i].Order < s[j].Order
+		})
+		enum.HasOrder = true
+		enum.OrderedValues = slices.Map(ordered, func(v *enumer.EnumTypeSpecValue, _ int) uint64 { return v.ID })
+		enum.Indices = slices.Map(unique, func(v *enumer.EnumTypeSpecValue, _ int) int {
+			return slices.FindIndex(ordered, func(o *enumer.EnumTypeSpecValue, _ int) bool { return o == v })
+		})
+	}
+	if ts.Spec.Default != nil {
+		enum.HasDefault = true
+		enum.DefaultValue = fmt.Sprintf("%s(%d)", enum.Name, ts.Spec.Default.ID)
+		if ts.HasSimpleBlockSpec() {
+			enum.DefaultValue = ts.Spec.Default.ConstSpec.Node.Names[0].Name
+		}
+	}
 
 	{ // write consts
 		type TplData struct {
@@ -135,15 +163,16 @@ func (r *renderer) renderForTypeSpec(buf *bytes.Buffer, ts *enumer.EnumType) err
 		type TplData struct {
 			Enum
 			CountUniqueValues int // hint: count of all enums, less the alternative values
+			CountActiveValues int // hint: count of all enums, less the alternative and deprecated values
 		}
 
 		data := TplData{
 			Enum: enum,
 			CountUniqueValues: slices.Count(ts.Spec.Values, func(v *enumer.EnumTypeSpecValue, idx int) bool {
-				if idx == 0 {
-					return true
-				}
-				return v.ID != ts.Spec.Values[idx-1].ID
+				return !v.IsAlternative
+			}),
+			CountActiveValues: slices.Count(ts.Spec.Values, func(v *enumer.EnumTypeSpecValue, idx int) bool {
+				return !v.IsAlternative && !v.IsDeprecated
 			}),
 		}
 		if err := enumTpl.ExecuteTemplate(buf, "enum.vars.go.tpl", map[string]any{"Type": data}); err != nil {
@@ -164,8 +193,10 @@ func (r *renderer) renderForTypeSpec(buf *bytes.Buffer, ts *enumer.EnumType) err
 		}
 		type TplData struct {
 			Enum
-			Extent         Extent // hint: extent/range of the enum set [min,max]
-			RequiresOffset bool
+			Extent              Extent // hint: extent/range of the enum set [min,max]
+			RequiresOffset      bool
+			SupportsIterators   bool // hint: the target module supports range-over-func iterators
+			SupportEntInterface bool
 		}
 
 		lowerBound := ts.Spec.Values[0].ID
@@ -179,7 +210,9 @@ func (r *renderer) renderForTypeSpec(buf *bytes.Buffer, ts *enumer.EnumType) err
 				Min: lowerBound,
 				Max: ts.Spec.Values[len(ts.Spec.Values)-1].ID,
 			},
-			RequiresOffset: ts.Spec.Values[0].ID > 0,
+			RequiresOffset:      ts.Spec.Values[0].ID > 0,
+			SupportsIterators:   module.SupportsIterators(),
+			SupportEntInterface: ts.Config.Options.SupportedFeatures.Contains(config.SupportEntInterface),
 		}
 
 		if err := enumTpl.ExecuteTemplate(buf, "enum.base-funcs.go.tpl", map[string]any{"Type": data}); err != nil {
@@ -188,13 +221,46 @@ func (r *renderer) renderForTypeSpec(buf *bytes.Buffer, ts *enumer.EnumType) err
 	}
 
 	{ // value mappings and lookup
+		type HashTable struct {
+			Seeds  []uint32    // hint: the seed of each bucket
+			Values []EnumValue // hint: the value in each slot of the table
+		}
+		type FoldTable struct {
+			Values  []EnumValue // hint: the values in buckets by the length of their strings
+			Offsets []int       // hint: the offset of the bucket of each length
+		}
 		type TplData struct {
 			Enum
 			SupportUndefined bool
+			LookupStrategy   string    // hint: the resolved lookup strategy, i.e. "map", "switch" or "perfect-hash"
+			StringHash       HashTable // hint: the perfect hash of the String values
+			FoldsUnicode     bool      // hint: ignores the case by Unicode simple folding instead of ASCII folding
+			HasFoldTable     bool      // hint: searches a fold table instead of scanning the String values
+			FoldTable        FoldTable // hint: the table of the case-insensitive lookup
+		}
+		strategy, ph, err := resolveLookup(ts)
+		if err != nil {
+			return err
 		}
 		data := TplData{
 			Enum:             enum,
 			SupportUndefined: ts.Config.Options.SupportedFeatures.Contains(config.SupportUndefined),
+			LookupStrategy:   strategy,
+			FoldsUnicode:     foldsUnicode(ts),
+			HasFoldTable:     hasFoldTable(ts),
+		}
+		if ph != nil {
+			data.StringHash = HashTable{
+				Seeds:  ph.Seeds,
+				Values: slices.Map(ph.Slots, func(idx int, _ int) EnumValue { return enum.Values[idx] }),
+			}
+		}
+		if data.HasFoldTable {
+			ft := newFoldTable(slices.Map(enum.Values, func(v EnumValue, _ int) string { return v.String }), data.FoldsUnicode)
+			data.FoldTable = FoldTable{
+				Values:  slices.Map(ft.Entries, func(idx int, _ int) EnumValue { return enum.Values[idx] }),
+				Offsets: ft.Offsets,
+			}
 		}
 
 		if err := enumTpl.ExecuteTemplate(buf, "enum.lookup-funcs.go.tpl", map[string]any{"Type": data}); err != nil {
@@ -206,6 +272,8 @@ func (r *renderer) renderForTypeSpec(buf *bytes.Buffer, ts *enumer.EnumType) err
 		type TplData struct {
 			Name                            string
 			RequiresGeneratedUndefinedValue bool
+			HasDefault                      bool
+			DefaultValue                    string
 			Serializers                     []string
 			SupportIgnoreCase               bool
 			SupportUndefined                bool
@@ -213,6 +281,8 @@ func (r *renderer) renderForTypeSpec(buf *bytes.Buffer, ts *enumer.EnumType) err
 		data := TplData{
 			Name:                            enum.Name,
 			RequiresGeneratedUndefinedValue: enum.RequiresGeneratedUndefinedValue,
+			HasDefault:                      enum.HasDefault,
+			DefaultValue:                    enum.DefaultValue,
 			Serializers:                     ts.Config.Options.Serializers,
 			SupportIgnoreCase:               ts.Config.Options.SupportedFeatures.Contains(config.SupportIgnoreCase),
 			SupportUndefined:                ts.Config.Options.SupportedFeatures.Contains(config.SupportUndefined),
@@ -227,18 +297,215 @@ func (r *renderer) renderForTypeSpec(buf *bytes.Buffer, ts *enumer.EnumType) err
 		type TplData struct {
 			Name                string
 			SupportEntInterface bool
+			EntField            string // hint: the ent field builder matching the SQL storage of the enum
 		}
 		data := TplData{
 			Name:                ts.Name().Name,
 			SupportEntInterface: ts.Config.Options.SupportedFeatures.Contains(config.SupportEntInterface),
+			EntField:            entField(ts, fmt.Sprintf("%q", snakeCaseTransformer(ts.Name().Name)), ts.Name().Name),
 		}
 		if err := enumTpl.ExecuteTemplate(buf, "enum.misc.ent.go.tpl", map[string]any{"Type": data}); err != nil {
 			return err
 		}
 	}
+
+	{ // misc (Database drivers)
+		type TplData struct {
+			Name                            string
+			SnakeName                       string // hint: the snake cased name, e.g. of the Postgres enum type
+			RequiresGeneratedUndefinedValue bool
+			HasDefault                      bool
+			DefaultValue                    string
+			SupportIgnoreCase               bool
+			SupportUndefined                bool
+			SupportGorm                     bool
+			SupportPgx                      bool
+			StoresStrings                   bool // hint: stored by its String values instead of its numeric values
+			MaxLength                       int  // hint: length of the longest String value
+		}
+		data := TplData{
+			Name:                            ts.Name().Name,
+			SnakeName:                       snakeCaseTransformer(ts.Name().Name),
+			RequiresGeneratedUndefinedValue: enum.RequiresGeneratedUndefinedValue,
+			HasDefault:                      enum.HasDefault,
+			DefaultValue:                    enum.DefaultValue,
+			SupportIgnoreCase:               ts.Config.Options.SupportedFeatures.Contains(config.SupportIgnoreCase),
+			SupportUndefined:                ts.Config.Options.SupportedFeatures.Contains(config.SupportUndefined),
+			SupportGorm:                     ts.Config.Options.SupportedFeatures.Contains(config.SupportGorm),
+			SupportPgx:                      ts.Config.Options.SupportedFeatures.Contains(config.SupportPgx),
+			StoresStrings:                   storesStrings(ts),
+			MaxLength: slices.Reduce(ts.Spec.Values, func(v *enumer.EnumTypeSpecValue, acc int) int {
+				if len(v.EnumValue) > acc {
+					return len(v.EnumValue)
+				}
+				return acc
+			}),
+		}
+		if err := enumTpl.ExecuteTemplate(buf, "enum.misc.database.go.tpl", map[string]any{"Type": data}); err != nil {
+			return err
+		}
+	}
+
+	{ // misc (Registry)
+		type TplData struct {
+			Name              string
+			QualifiedName     string // hint: the name of the enum within the registry
+			SupportIgnoreCase bool
+			SupportRegistry   bool
+		}
+		data := TplData{
+			Name:              ts.Name().Name,
+			QualifiedName:     pkg.Path + "." + ts.Name().Name,
+			SupportIgnoreCase: ts.Config.Options.SupportedFeatures.Contains(config.SupportIgnoreCase),
+			SupportRegistry:   ts.Config.Options.SupportedFeatures.Contains(config.SupportRegistry),
+		}
+		if err := enumTpl.ExecuteTemplate(buf, "enum.misc.registry.go.tpl", map[string]any{"Type": data}); err != nil {
+			return err
+		}
+	}
+
+	{ // misc (Logging)
+		type TplData struct {
+			Name        string
+			SupportSlog bool
+			SupportZap  bool
+		}
+		data := TplData{
+			Name:        ts.Name().Name,
+			SupportSlog: ts.Config.Options.SupportedFeatures.Contains(config.SupportSlog),
+			SupportZap:  ts.Config.Options.SupportedFeatures.Contains(config.SupportZap),
+		}
+		if err := enumTpl.ExecuteTemplate(buf, "enum.misc.logging.go.tpl", map[string]any{"Type": data}); err != nil {
+			return err
+		}
+	}
+
+	{ // misc (Completions)
+		type TplData struct {
+			Name                string
+			HasDeprecatedValues bool
+			Completions         []string // hint: completion candidates of the active values, e.g. "<value>\t<description>"
+		}
+		data := TplData{
+			Name:                ts.Name().Name,
+			HasDeprecatedValues: slices.Any(ts.Spec.Values, func(v *enumer.EnumTypeSpecValue, _ int) bool { return v.IsDeprecated }),
+		}
+		for _, v := range ts.Spec.Values {
+			if v.IsAlternative || v.IsDeprecated {
+				continue
+			}
+			candidate := v.EnumValue
+			if len(v.Description) > 0 {
+				candidate += "\t" + v.Description
+			}
+			data.Completions = append(data.Completions, candidate)
+		}
+		if err := enumTpl.ExecuteTemplate(buf, "enum.misc.completions.go.tpl", map[string]any{"Type": data}); err != nil {
+			return err
+		}
+	}
+
+	{ // misc (Set)
+		type TplData struct {
+			Name              string
+			Min               uint64 // hint: the lower numerical bound of the enum set
+			Words             uint64 // hint: count of 64 bit words required to represent all values
+			Serializers       []string
+			ParsesStrings     bool // hint: any serializer deserializes the String values
+			SupportIgnoreCase bool
+			SupportSet        bool
+		}
+		lowerBound := ts.Spec.Values[0].ID
+		if enum.RequiresGeneratedUndefinedValue {
+			lowerBound = 0
+		}
+		data := TplData{
+			Name:              ts.Name().Name,
+			Min:               lowerBound,
+			Words:             (ts.Spec.Values[len(ts.Spec.Values)-1].ID-lowerBound)/64 + 1,
+			Serializers:       ts.Config.Options.Serializers,
+			ParsesStrings:     parsesStrings(ts),
+			SupportIgnoreCase: ts.Config.Options.SupportedFeatures.Contains(config.SupportIgnoreCase),
+			SupportSet:        ts.Config.Options.SupportedFeatures.Contains(config.SupportSet),
+		}
+		if err := enumTpl.ExecuteTemplate(buf, "enum.misc.set.go.tpl", map[string]any{"Type": data}); err != nil {
+			return err
+		}
+	}
+
+	{ // misc (Transitions)
+		type State struct {
+			Value   string   // hint: the source representation of the state
+			Targets []string // hint: the source representations of the states it can transition to
+		}
+		type TplData struct {
+			Name           string
+			HasTransitions bool
+			States         []State
+			DOT            string // hint: the state machine as Graphviz DOT graph
+			Mermaid        string // hint: the state machine as Mermaid state diagram
+		}
+		literal := func(v *enumer.EnumTypeSpecValue) string {
+			if ts.HasSimpleBlockSpec() {
+				return v.ConstSpec.Node.Names[0].Name
+			}
+			return strconv.FormatUint(v.ID, 10)
+		}
+		data := TplData{
+			Name:           ts.Name().Name,
+			HasTransitions: len(ts.Spec.Transitions) > 0,
+		}
+		for _, v := range ts.Spec.Values {
+			if v.IsAlternative {
+				continue
+			}
+			targets := slices.Filter(ts.Spec.Transitions, func(t *enumer.EnumTypeSpecTransition, _ int) bool { return t.From == v })
+			if len(targets) == 0 {
+				continue
+			}
+			data.States = append(data.States, State{
+				Value:   literal(v),
+				Targets: slices.Map(targets, func(t *enumer.EnumTypeSpecTransition, _ int) string { return literal(t.To) }),
+			})
+		}
+		if data.HasTransitions {
+			data.DOT, data.Mermaid = renderStateDiagrams(ts)
+		}
+		if err := enumTpl.ExecuteTemplate(buf, "enum.misc.transitions.go.tpl", map[string]any{"Type": data}); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
+// renderStateDiagrams renders the state machine of the enum
+// as Graphviz DOT graph and as Mermaid state diagram.
+func renderStateDiagrams(ts *enumer.EnumType) (string, string) {
+	initial := ts.InitialState()
+	dot, mermaid := &strings.Builder{}, &strings.Builder{}
+	fmt.Fprintf(dot, "digraph %s {\n", ts.Name().Name)
+	fmt.Fprintf(dot, "\t%q [shape=doublecircle];\n", initial.EnumValue)
+	fmt.Fprint(mermaid, "stateDiagram-v2\n")
+	for _, v := range ts.Spec.Values {
+		if !v.IsAlternative {
+			fmt.Fprintf(mermaid, "\tstate %q as s%d\n", v.EnumValue, v.ID)
+		}
+	}
+	fmt.Fprintf(mermaid, "\t[*] --> s%d\n", initial.ID)
+	for _, t := range ts.Spec.Transitions {
+		fmt.Fprintf(dot, "\t%q -> %q;\n", t.From.EnumValue, t.To.EnumValue)
+		fmt.Fprintf(mermaid, "\ts%d --> s%d\n", t.From.ID, t.To.ID)
+	}
+	for _, v := range ts.Spec.Values {
+		isTerminal := slices.None(ts.Spec.Transitions, func(t *enumer.EnumTypeSpecTransition, _ int) bool { return t.From == v })
+		if !v.IsAlternative && isTerminal {
+			fmt.Fprintf(mermaid, "\ts%d --> [*]\n", v.ID)
+		}
+	}
+	fmt.Fprint(dot, "}\n")
+	return dot.String(), mermaid.String()
+}
+
 var tplFuncs = template.FuncMap{
 	"add": func(a, b int) int {
 		return a + b
@@ -308,23 +575,23 @@ var (
 
 func getTransformStrategy(c *config.Options) func(string) string {
 	switch c.TransformStrategy {
-	case "camel":
+	case config.TransformCamel:
 		return camelCaseTransformer
-	case "pascal":
+	case config.TransformPascal:
 		return pascalCaseTransformer
-	case "kebab":
+	case config.TransformKebab:
 		return kebabCaseTransformer
-	case "snake":
+	case config.TransformSnake:
 		return snakeCaseTransformer
-	case "lower":
+	case config.TransformLower:
 		return lowerCaseTransformer
-	case "upper":
+	case config.TransformUpper:
 		return upperCaseTransformer
-	case "upper-kebab":
+	case config.TransformUpperKebab:
 		return upperKebabCaseTransformer
-	case "upper-snake":
+	case config.TransformUpperSnake:
 		return upperSnakeCaseTransformer
-	case "whitespace":
+	case config.TransformWhitespace:
 		return whitespaceCaseTransformer
 	default:
 		return noopCaseTransformer
@@ -0,0 +1,61 @@
+package gen
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/mvrahden/go-enumer/about"
+	"github.com/mvrahden/go-enumer/config"
+	"github.com/mvrahden/go-enumer/pkg/enumer"
+)
+
+// storesStrings reports whether the enum type is stored by its String values
+// in SQL databases, which is the case if it is serialized via "sql".
+// Otherwise it is stored by its numeric values.
+func storesStrings(ts *enumer.EnumType) bool {
+	return ts.Config.Options.Serializers.Contains(config.SerializerSQL)
+}
+
+// ExportSqlcOverrides renders the sqlc type overrides for all enums of the file,
+// which are serialized via the "sql" serializer. Each enum is mapped from the
+// Postgres enum type named after the snake cased enum type, e.g. "booking_state".
+// It returns nil if there are no such enums.
+func ExportSqlcOverrides(f *File) []byte {
+	buf := new(bytes.Buffer)
+	for _, ts := range f.TypeSpecs {
+		if !storesStrings(ts) {
+			continue
+		}
+		if buf.Len() == 0 {
+			writeSqlcHeader(buf, f.Header.Package.Name)
+		}
+		writeSqlcOverrides(buf, f.Header.Package.Path, ts)
+	}
+	if buf.Len() == 0 {
+		return nil
+	}
+	return buf.Bytes()
+}
+
+func writeSqlcHeader(buf *bytes.Buffer, pkgName string) {
+	fmt.Fprintf(buf, "# sqlc overrides of package %q.\n", pkgName)
+	fmt.Fprintf(buf, "# Exported by %q.\n", about.ShortInfo())
+	fmt.Fprintf(buf, "# Merge them into the overrides of your sqlc.yaml (version 2), e.g. into sql[].gen.go.overrides.\n")
+	fmt.Fprintf(buf, "overrides:\n")
+}
+
+func writeSqlcOverrides(buf *bytes.Buffer, pkgPath string, ts *enumer.EnumType) {
+	dbType, name := snakeCaseTransformer(ts.Name().Name), ts.Name().Name
+	for _, nullable := range []bool{false, true} {
+		fmt.Fprintf(buf, "  - db_type: %q\n", dbType)
+		if nullable {
+			fmt.Fprintf(buf, "    nullable: true\n")
+		}
+		fmt.Fprintf(buf, "    go_type:\n")
+		fmt.Fprintf(buf, "      import: %q\n", pkgPath)
+		fmt.Fprintf(buf, "      type: %q\n", name)
+		if nullable {
+			fmt.Fprintf(buf, "      pointer: true\n")
+		}
+	}
+}
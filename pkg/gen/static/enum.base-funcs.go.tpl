@@ -12,6 +12,51 @@ func {{ $ts.Name }}Strings() []string {
 	return cp[:]
 }
 
+{{ if not $ts.SupportEntInterface -}}
+// Values returns all values of the enum.
+func ({{ $ts.Name }}) Values() []{{ $ts.Name }} {
+	return {{ $ts.Name }}Values()
+}
+
+{{ end -}}
+{{ if $ts.SupportsIterators -}}
+// {{ $ts.Name }}All returns an iterator over all values of the enum.
+func {{ $ts.Name }}All() iter.Seq[{{ $ts.Name }}] {
+	return func(yield func({{ $ts.Name }}) bool) {
+		for idx := range _{{ $ts.Name }}Values {
+			if !yield(_{{ $ts.Name }}Values[idx]) {
+				return
+			}
+		}
+	}
+}
+
+// {{ $ts.Name }}AllWithStrings returns an iterator over all values of the enum and their String values.
+func {{ $ts.Name }}AllWithStrings() iter.Seq2[{{ $ts.Name }}, string] {
+	return func(yield func({{ $ts.Name }}, string) bool) {
+		for idx := range _{{ $ts.Name }}Values {
+			if !yield(_{{ $ts.Name }}Values[idx], _{{ $ts.Name }}Strings[idx]) {
+				return
+			}
+		}
+	}
+}
+
+{{ end -}}
+{{ if $ts.HasDeprecatedValues -}}
+// {{ $ts.Name }}ActiveValues returns all values of the enum, less the deprecated values.
+func {{ $ts.Name }}ActiveValues() []{{ $ts.Name }} {
+	cp := _{{ $ts.Name }}ActiveValues
+	return cp[:]
+}
+
+// {{ $ts.Name }}ActiveStrings returns a slice of all String values of the enum, less the deprecated values.
+func {{ $ts.Name }}ActiveStrings() []string {
+	cp := _{{ $ts.Name }}ActiveStrings
+	return cp[:]
+}
+
+{{ end -}}
 // IsValid tests whether the value is a valid enum value.
 func ({{ receiver $ts.Name }} {{ $ts.Name }}) IsValid() bool {
 	return {{ receiver $ts.Name }} >= {{ $ts.Extent.Min }} && {{ receiver $ts.Name }} <= {{ $ts.Extent.Max }}
@@ -25,6 +70,21 @@ func ({{ receiver $ts.Name }} {{ $ts.Name }}) Validate() error {
 	return nil
 }
 
+{{ if $ts.HasDefault -}}
+// Default returns the default value of the enum.
+func ({{ $ts.Name }}) Default() {{ $ts.Name }} {
+	return {{ $ts.DefaultValue }}
+}
+
+// OrDefault returns the value if it is valid and the default value otherwise.
+func ({{ receiver $ts.Name }} {{ $ts.Name }}) OrDefault() {{ $ts.Name }} {
+	if !{{ receiver $ts.Name }}.IsValid() {
+		return {{ $ts.DefaultValue }}
+	}
+	return {{ receiver $ts.Name }}
+}
+
+{{ end -}}
 // String returns the string of the enum value.
 // If the enum value is invalid, it will produce a string
 // of the following pattern {{ $ts.Name }}(%d) instead.
@@ -41,7 +101,99 @@ func ({{ receiver $ts.Name }} {{ $ts.Name }}) String() string {
 	idx := uint({{ receiver $ts.Name }}){{- if $ts.RequiresOffset }} - 1{{- end }}
 	return _{{ $ts.Name }}Strings[idx]
 }
+{{ $ordered := printf "_%sValues" $ts.Name }}
+{{- if $ts.HasOrder }}{{ $ordered = printf "_%sOrderedValues" $ts.Name }}{{ end }}
+// Index returns the position of the value within the ordered values of the enum
+// (ignoring alternative values) or -1 if the value is invalid.
+func ({{ receiver $ts.Name }} {{ $ts.Name }}) Index() int {
+	if !{{ receiver $ts.Name }}.IsValid() {{- if $ts.RequiresGeneratedUndefinedValue }} || {{ receiver $ts.Name }} == 0 {{- end }} {
+		return -1
+	}
+	idx := int({{ receiver $ts.Name }}){{- if $ts.RequiresOffset }} - 1{{- end }}
+{{- if $ts.HasOrder }}
+	return _{{ $ts.Name }}Indices[idx]
+{{- else }}
+	return idx
+{{- end }}
+}
+
+// Compare returns -1, 0 or +1 depending on whether the value orders before,
+// equal to or after the other value. Invalid values order before all valid values.
+func ({{ receiver $ts.Name }} {{ $ts.Name }}) Compare(other {{ $ts.Name }}) int {
+	a, b := {{ receiver $ts.Name }}.Index(), other.Index()
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return +1
+	}
+	return 0
+}
+
+// Less tests whether the value orders before the other value.
+func ({{ receiver $ts.Name }} {{ $ts.Name }}) Less(other {{ $ts.Name }}) bool {
+	return {{ receiver $ts.Name }}.Compare(other) < 0
+}
+
+// Next returns the succeeding value in order of the enum.
+// It returns false if the value is invalid or the last value.
+func ({{ receiver $ts.Name }} {{ $ts.Name }}) Next() ({{ $ts.Name }}, bool) {
+	idx := {{ receiver $ts.Name }}.Index()
+	if idx == -1 || idx+1 == len({{ $ordered }}) {
+		return {{ $ts.Name }}(0), false
+	}
+	return {{ $ordered }}[idx+1], true
+}
+
+// Prev returns the preceding value in order of the enum.
+// It returns false if the value is invalid or the first value.
+func ({{ receiver $ts.Name }} {{ $ts.Name }}) Prev() ({{ $ts.Name }}, bool) {
+	idx := {{ receiver $ts.Name }}.Index()
+	if idx < 1 {
+		return {{ $ts.Name }}(0), false
+	}
+	return {{ $ordered }}[idx-1], true
+}
 
+{{ if $ts.HasDeprecatedValues -}}
+// IsDeprecated tests whether the value is deprecated.
+// Deprecated values can still be deserialized, but should no longer be used.
+func ({{ receiver $ts.Name }} {{ $ts.Name }}) IsDeprecated() bool {
+	switch {{ receiver $ts.Name }} {
+	case {{ $sep := "" }}{{ range $v := $ts.Values }}{{ if and $v.IsDeprecated (not $v.IsAlternativeValue) }}{{ $sep }}{{ $v.Value }}{{ $sep = ", " }}{{ end }}{{ end }}:
+		return true
+	}
+	return false
+}
+{{ end }}
+{{ if $ts.HasLabels -}}
+// {{ $ts.Name }}LabelLanguages returns the languages of the enum's labels.
+// The first language is used as fallback language.
+func {{ $ts.Name }}LabelLanguages() []language.Tag {
+	cp := _{{ $ts.Name }}LabelLanguages
+	return cp[:]
+}
+
+// Label returns the human-readable label of the enum value in the given language.
+// It falls back to the closest available language, then to the label of the
+// fallback language and finally to the String value of the enum.
+func ({{ receiver $ts.Name }} {{ $ts.Name }}) Label(lang language.Tag) string {
+	if !{{ receiver $ts.Name }}.IsValid() {{- if $ts.RequiresGeneratedUndefinedValue }} || {{ receiver $ts.Name }} == 0 {{- end }} {
+		return {{ receiver $ts.Name }}.String()
+	}
+	idx := uint({{ receiver $ts.Name }}){{- if $ts.RequiresOffset }} - 1{{- end }}
+	labels := _{{ $ts.Name }}Labels[idx]
+	_, langIdx, _ := _{{ $ts.Name }}LabelMatcher.Match(lang)
+	if len(labels[langIdx]) > 0 {
+		return labels[langIdx]
+	}
+	if len(labels[0]) > 0 {
+		return labels[0]
+	}
+	return {{ receiver $ts.Name }}.String()
+}
+
+{{ end -}}
 {{ if $ts.HasAdditionalData }}
 {{- /* Generate typed getter for additional data */}}
 {{- range $h := $ts.AdditionalData.Headers -}}
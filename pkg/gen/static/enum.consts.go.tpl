@@ -1,8 +1,7 @@
 {{- /* Declaration of enum's base constants */ -}}
 {{- with $ts := .Type -}}
 const (
-	_{{ $ts.Name }}String      = "{{ $ts.AggregatedValueStrings }}"
-	_{{ $ts.Name }}LowerString = "{{ lower $ts.AggregatedValueStrings }}"
+	_{{ $ts.Name }}String = {{ printf "%q" $ts.AggregatedValueStrings }}
 )
 
 {{ end -}}
@@ -1,36 +1,168 @@
 
 {{- /* Declare lookup functions of enum type */ -}}
 {{- with $ts := .Type -}}
+{{- if eq $ts.LookupStrategy "map" -}}
 var (
 	_{{ $ts.Name }}StringToValueMap = map[string]{{ $ts.Name }}{
 {{- range $v := $ts.Values }}
 		_{{ $ts.Name }}String[{{ $v.Position }}:{{ add $v.Position $v.Length }}]: {{ if $ts.IsFromCsvSource }}{{ $v.Value }}{{ else }}{{ $v.ConstName }}{{ end }},
 {{- end }}
 	}
-	_{{ $ts.Name }}LowerStringToValueMap = map[string]{{ $ts.Name }}{
+)
+
+{{ else if eq $ts.LookupStrategy "switch" -}}
+// _{{ $ts.Name }}LookupString determines the enum value of the string by a switch statement,
+// which the compiler turns into a search by the length and the contents of the string.
+func _{{ $ts.Name }}LookupString(raw string) ({{ $ts.Name }}, bool) {
+	switch raw {
 {{- range $v := $ts.Values }}
-		_{{ $ts.Name }}LowerString[{{ $v.Position }}:{{ add $v.Position $v.Length }}]: {{ if $ts.IsFromCsvSource }}{{ $v.Value }}{{ else }}{{ $v.ConstName }}{{ end }},
+	case {{ printf "%q" $v.String }}:
+		return {{ if $ts.IsFromCsvSource }}{{ $v.Value }}{{ else }}{{ $v.ConstName }}{{ end }}, true
+{{- end }}
+	}
+	return {{ $ts.Name }}(0), false
+}
+
+{{ else if eq $ts.LookupStrategy "perfect-hash" -}}
+var (
+	_{{ $ts.Name }}StringHashSeeds = [{{ len $ts.StringHash.Seeds }}]uint32{ {{- range $idx, $s := $ts.StringHash.Seeds }}{{ if $idx }}, {{ end }}{{ $s }}{{ end -}} }
+	_{{ $ts.Name }}StringHashTable = [{{ len $ts.StringHash.Values }}]struct {
+		s string
+		v {{ $ts.Name }}
+	}{
+{{- range $v := $ts.StringHash.Values }}
+		{_{{ $ts.Name }}String[{{ $v.Position }}:{{ add $v.Position $v.Length }}], {{ if $ts.IsFromCsvSource }}{{ $v.Value }}{{ else }}{{ $v.ConstName }}{{ end }}},
 {{- end }}
 	}
 )
 
+// _{{ $ts.Name }}LookupString determines the enum value of the string by a minimal perfect hash.
+func _{{ $ts.Name }}LookupString(raw string) ({{ $ts.Name }}, bool) {
+	h := _enumer.HashV1(raw)
+	e := &_{{ $ts.Name }}StringHashTable[_enumer.DisplaceV1(h, _{{ $ts.Name }}StringHashSeeds[h%{{ len $ts.StringHash.Seeds }}])%{{ len $ts.StringHash.Values }}]
+	if e.s != raw {
+		return {{ $ts.Name }}(0), false
+	}
+	return e.v, true
+}
+
+{{ end -}}
+{{ if $ts.HasFoldTable -}}
+var (
+	_{{ $ts.Name }}FoldOffsets = [{{ len $ts.FoldTable.Offsets }}]{{ if gt (len $ts.FoldTable.Values) 65535 }}uint32{{ else }}uint16{{ end }}{ {{- range $idx, $o := $ts.FoldTable.Offsets }}{{ if $idx }}, {{ end }}{{ $o }}{{ end -}} }
+	_{{ $ts.Name }}FoldTable   = [{{ len $ts.FoldTable.Values }}]struct {
+		s string
+		v {{ $ts.Name }}
+	}{
+{{- range $v := $ts.FoldTable.Values }}
+		{_{{ $ts.Name }}String[{{ $v.Position }}:{{ add $v.Position $v.Length }}], {{ if $ts.IsFromCsvSource }}{{ $v.Value }}{{ else }}{{ $v.ConstName }}{{ end }}},
+{{- end }}
+	}
+)
+
+{{ if $ts.FoldsUnicode -}}
+// _{{ $ts.Name }}LookupFold determines the enum value of the string regardless of its case
+// by Unicode simple folding. It scans the entries of the fold table, whose strings
+// have as many runes as the string.
+func _{{ $ts.Name }}LookupFold(raw string) ({{ $ts.Name }}, bool) {
+	n := utf8.RuneCountInString(raw)
+	if n+1 >= len(_{{ $ts.Name }}FoldOffsets) {
+		return {{ $ts.Name }}(0), false
+	}
+	for i := _{{ $ts.Name }}FoldOffsets[n]; i < _{{ $ts.Name }}FoldOffsets[n+1]; i++ {
+		if e := &_{{ $ts.Name }}FoldTable[i]; strings.EqualFold(e.s, raw) {
+			return e.v, true
+		}
+	}
+	return {{ $ts.Name }}(0), false
+}
+{{- else -}}
+// _{{ $ts.Name }}LookupFold determines the enum value of the string regardless of the case
+// of its ASCII letters. It searches the entries of the fold table, whose strings
+// are as long as the string.
+func _{{ $ts.Name }}LookupFold(raw string) ({{ $ts.Name }}, bool) {
+	n := len(raw)
+	if n+1 >= len(_{{ $ts.Name }}FoldOffsets) {
+		return {{ $ts.Name }}(0), false
+	}
+	lo, hi := int(_{{ $ts.Name }}FoldOffsets[n]), int(_{{ $ts.Name }}FoldOffsets[n+1])
+	for lo < hi {
+		m := int(uint(lo+hi) >> 1)
+		e := &_{{ $ts.Name }}FoldTable[m]
+		switch c := _enumer.CompareFoldASCII(e.s, raw); {
+		case c == 0:
+			return e.v, true
+		case c < 0:
+			lo = m + 1
+		default:
+			hi = m
+		}
+	}
+	return {{ $ts.Name }}(0), false
+}
+{{- end }}
+{{- else -}}
+// _{{ $ts.Name }}LookupFold determines the enum value of the string regardless of its case
+// by Unicode simple folding. It scans the String values, as a fold table is only generated
+// for enums with the "ignore-case" feature.
+func _{{ $ts.Name }}LookupFold(raw string) ({{ $ts.Name }}, bool) {
+	for idx := range _{{ $ts.Name }}Strings {
+		if strings.EqualFold(_{{ $ts.Name }}Strings[idx], raw) {
+			return _{{ $ts.Name }}Values[idx], true
+		}
+	}
+{{- range $v := $ts.Values }}
+{{- if $v.IsAlternativeValue }}
+	if strings.EqualFold(_{{ $ts.Name }}String[{{ $v.Position }}:{{ add $v.Position $v.Length }}], raw) {
+		return {{ if $ts.IsFromCsvSource }}{{ $v.Value }}{{ else }}{{ $v.ConstName }}{{ end }}, true
+	}
+{{- end }}
+{{- end }}
+	return {{ $ts.Name }}(0), false
+}
+{{- end }}
+
+{{ if $ts.HasDeprecatedValues -}}
+// {{ $ts.Name }}OnDeprecated is an optional hook, which is called whenever
+// a deprecated value of {{ $ts.Name }} is determined from its string representation.
+var {{ $ts.Name }}OnDeprecated func(v {{ $ts.Name }})
+
+{{ end -}}
 // {{ $ts.Name }}FromString determines the enum value with an exact case match.
 func {{ $ts.Name }}FromString(raw string) ({{ $ts.Name }}, bool) {
-{{- if $ts.SupportUndefined }}
+{{- if $ts.HasDefault }}
+	if len(raw) == 0 {
+		return {{ $ts.DefaultValue }}, true
+	}
+{{- else if $ts.SupportUndefined }}
 	if len(raw) == 0 {
 		return {{ $ts.Name }}(0), true
 	}
 {{- end }}
+{{- if eq $ts.LookupStrategy "map" }}
 	v, ok := _{{ $ts.Name }}StringToValueMap[raw]
+{{- else }}
+	v, ok := _{{ $ts.Name }}LookupString(raw)
+{{- end }}
 	if !ok {
 		return {{ $ts.Name }}(0), false
 	}
+{{- if $ts.HasDeprecatedValues }}
+	if {{ $ts.Name }}OnDeprecated != nil && v.IsDeprecated() {
+		{{ $ts.Name }}OnDeprecated(v)
+	}
+{{- end }}
 	return v, true
 }
 
-// {{ $ts.Name }}FromStringIgnoreCase determines the enum value with a case-insensitive match.
+// {{ $ts.Name }}FromStringIgnoreCase determines the enum value with a case-insensitive match
+// {{ if $ts.FoldsUnicode }}by Unicode simple folding{{ else }}of the ASCII letters{{ end }}, regardless of the casing of raw.
 func {{ $ts.Name }}FromStringIgnoreCase(raw string) ({{ $ts.Name }}, bool) {
-{{- if $ts.SupportUndefined }}
+{{- if $ts.HasDefault }}
+	if len(raw) == 0 {
+		return {{ $ts.DefaultValue }}, true
+	}
+{{- else if $ts.SupportUndefined }}
 	if len(raw) == 0 {
 		return {{ $ts.Name }}(0), true
 	}
@@ -39,10 +171,15 @@ func {{ $ts.Name }}FromStringIgnoreCase(raw string) ({{ $ts.Name }}, bool) {
 	if ok {
 		return v, ok
 	}
-	v, ok = _{{ $ts.Name }}LowerStringToValueMap[raw]
+	v, ok = _{{ $ts.Name }}LookupFold(raw)
 	if !ok {
 		return {{ $ts.Name }}(0), false
 	}
+{{- if $ts.HasDeprecatedValues }}
+	if {{ $ts.Name }}OnDeprecated != nil && v.IsDeprecated() {
+		{{ $ts.Name }}OnDeprecated(v)
+	}
+{{- end }}
 	return v, true
 }
 
@@ -0,0 +1,28 @@
+{{- /* Declare completion helpers of enum type */ -}}
+{{- with $ts := .Type -}}
+var _{{ $ts.Name }}Completions = [{{ len $ts.Completions }}]string{
+{{- range $c := $ts.Completions }}
+	{{ printf "%q" $c }},
+{{- end }}
+}
+
+// {{ $ts.Name }}Usage returns a usage hint of all String values of the enum, less the deprecated values,
+// e.g. for flag descriptions.
+func {{ $ts.Name }}Usage() string {
+	return "one of: " + strings.Join(_{{ $ts.Name }}{{ if $ts.HasDeprecatedValues }}Active{{ end }}Strings[:], "|")
+}
+
+// {{ $ts.Name }}Completions returns the completion candidates of the enum matching the given prefix,
+// less the deprecated values. Each candidate is formatted as "<value>" or "<value>\t<description>",
+// as expected by shell completions, e.g. of cobra's RegisterFlagCompletionFunc.
+func {{ $ts.Name }}Completions(toComplete string) []string {
+	out := make([]string, 0, len(_{{ $ts.Name }}Completions))
+	for _, c := range _{{ $ts.Name }}Completions {
+		if strings.HasPrefix(c, toComplete) {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+{{ end -}}
@@ -0,0 +1,111 @@
+{{- /* Declare native database driver interfaces of enum type */ -}}
+{{- with $ts := .Type -}}
+{{- if $ts.SupportGorm -}}
+{{- if $ts.StoresStrings -}}
+// GormDataType implements the gorm schema.GormDataTypeInterface for {{ $ts.Name }}.
+// It is stored by its String values.
+func ({{ $ts.Name }}) GormDataType() string {
+	return "string"
+}
+
+// GormDBDataType implements the gorm migrator.GormDataTypeInterface for {{ $ts.Name }}.
+// The column is sized to fit the longest String value.
+func ({{ $ts.Name }}) GormDBDataType(db *gorm.DB, field *schema.Field) string {
+	return "varchar({{ $ts.MaxLength }})"
+}
+{{- else -}}
+// GormDataType implements the gorm schema.GormDataTypeInterface for {{ $ts.Name }}.
+// It is stored by its numeric values.
+func ({{ $ts.Name }}) GormDataType() string {
+	return "uint"
+}
+{{- end }}
+
+{{ end -}}
+{{- if $ts.SupportPgx -}}
+{{- if $ts.StoresStrings -}}
+// Register{{ $ts.Name }}PgxType loads the Postgres enum type of the given name
+// and its array type, e.g. "{{ $ts.SnakeName }}" and "_{{ $ts.SnakeName }}", and registers them
+// with the type map of the connection, e.g. within the AfterConnect hook of a pgxpool.
+func Register{{ $ts.Name }}PgxType(ctx context.Context, conn *pgx.Conn, typeName string) error {
+	for _, name := range []string{typeName, "_" + typeName} {
+		t, err := conn.LoadType(ctx, name)
+		if err != nil {
+			return fmt.Errorf("failed loading Postgres type %q of {{ $ts.Name }}. err: %w", name, err)
+		}
+		conn.TypeMap().RegisterType(t)
+	}
+	return nil
+}
+
+// TextValue implements the pgtype.TextValuer interface for {{ $ts.Name }}.
+func ({{ receiver $ts.Name }} {{ $ts.Name }}) TextValue() (pgtype.Text, error) {
+	if err := {{ receiver $ts.Name }}.Validate(); err != nil {
+		return pgtype.Text{}, fmt.Errorf("Cannot serialize value %q as {{ $ts.Name }}. %w", {{ receiver $ts.Name }}, err)
+	}
+{{- if $ts.RequiresGeneratedUndefinedValue }}
+	if {{ receiver $ts.Name }} == 0 {
+		return pgtype.Text{}, nil
+	}
+{{- end }}
+	return pgtype.Text{String: {{ receiver $ts.Name }}.String(), Valid: true}, nil
+}
+
+// ScanText implements the pgtype.TextScanner interface for {{ $ts.Name }}.
+func ({{ receiver $ts.Name }} *{{ $ts.Name }}) ScanText(v pgtype.Text) error {
+{{- if not (or $ts.SupportUndefined $ts.HasDefault) }}
+	if !v.Valid || len(v.String) == 0 {
+		return fmt.Errorf("{{ $ts.Name }} cannot be derived from NULL or empty string")
+	}
+{{- end }}
+
+	var ok bool
+	*{{ receiver $ts.Name }}, ok = {{ $ts.Name }}FromString{{ if $ts.SupportIgnoreCase }}IgnoreCase{{ end }}(v.String)
+	if !ok {
+		return _enumer.NewParseError("{{ $ts.Name }}", v.String, {{ $ts.Name }}Strings())
+	}
+	return nil
+}
+{{- else -}}
+// Int64Value implements the pgtype.Int64Valuer interface for {{ $ts.Name }}.
+func ({{ receiver $ts.Name }} {{ $ts.Name }}) Int64Value() (pgtype.Int8, error) {
+	if err := {{ receiver $ts.Name }}.Validate(); err != nil {
+		return pgtype.Int8{}, fmt.Errorf("Cannot serialize value %q as {{ $ts.Name }}. %w", {{ receiver $ts.Name }}, err)
+	}
+{{- if $ts.RequiresGeneratedUndefinedValue }}
+	if {{ receiver $ts.Name }} == 0 {
+		return pgtype.Int8{}, nil
+	}
+{{- end }}
+	return pgtype.Int8{Int64: int64({{ receiver $ts.Name }}), Valid: true}, nil
+}
+
+// ScanInt64 implements the pgtype.Int64Scanner interface for {{ $ts.Name }}.
+func ({{ receiver $ts.Name }} *{{ $ts.Name }}) ScanInt64(v pgtype.Int8) error {
+	if !v.Valid {
+{{- if $ts.HasDefault }}
+		*{{ receiver $ts.Name }} = {{ $ts.DefaultValue }}
+		return nil
+{{- else if $ts.SupportUndefined }}
+		*{{ receiver $ts.Name }} = 0
+		return nil
+{{- else }}
+		return fmt.Errorf("{{ $ts.Name }} cannot be derived from NULL")
+{{- end }}
+	}
+	if v.Int64 < 0 {
+		return fmt.Errorf("{{ $ts.Name }}(%d) is %w", v.Int64, ErrNoValidEnum)
+	}
+
+	id := uint64(v.Int64)
+	e := {{ $ts.Name }}(id)
+	if uint64(e) != id || !e.IsValid() {
+		return fmt.Errorf("{{ $ts.Name }}(%d) is %w", id, ErrNoValidEnum)
+	}
+	*{{ receiver $ts.Name }} = e
+	return nil
+}
+{{- end }}
+
+{{ end -}}
+{{ end -}}
@@ -2,6 +2,9 @@
 {{- with $ts := .Type -}}
 {{- if $ts.SupportEntInterface -}}
 // Values returns a slice of all String values of the enum.
+// It implements the ent EnumValues interface. Declare the ent field e.g. as follows:
+//
+//	{{ $ts.EntField }}
 func ({{ $ts.Name }}) Values() []string {
 	return {{ $ts.Name }}Strings()
 }
@@ -0,0 +1,26 @@
+{{- /* Declare logging interfaces of enum type */ -}}
+{{- with $ts := .Type -}}
+{{- if $ts.SupportSlog -}}
+// LogValue implements the slog.LogValuer interface for {{ $ts.Name }}.
+// It logs the String value, the numeric id and whether the value is valid.
+func ({{ receiver $ts.Name }} {{ $ts.Name }}) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("value", {{ receiver $ts.Name }}.String()),
+		slog.Uint64("id", uint64({{ receiver $ts.Name }})),
+		slog.Bool("valid", {{ receiver $ts.Name }}.IsValid()),
+	)
+}
+
+{{ end -}}
+{{- if $ts.SupportZap -}}
+// MarshalLogObject implements the zapcore.ObjectMarshaler interface for {{ $ts.Name }}.
+// It logs the String value, the numeric id and whether the value is valid.
+func ({{ receiver $ts.Name }} {{ $ts.Name }}) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddString("value", {{ receiver $ts.Name }}.String())
+	enc.AddUint64("id", uint64({{ receiver $ts.Name }}))
+	enc.AddBool("valid", {{ receiver $ts.Name }}.IsValid())
+	return nil
+}
+
+{{ end -}}
+{{ end -}}
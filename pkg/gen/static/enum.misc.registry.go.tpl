@@ -0,0 +1,9 @@
+{{- /* Declare registration of enum type */ -}}
+{{- with $ts := .Type -}}
+{{- if $ts.SupportRegistry -}}
+func init() {
+	_enumer.Register({{ printf "%q" $ts.QualifiedName }}, {{ $ts.Name }}Values, {{ $ts.Name }}FromString{{ if $ts.SupportIgnoreCase }}IgnoreCase{{ end }})
+}
+
+{{ end -}}
+{{ end -}}
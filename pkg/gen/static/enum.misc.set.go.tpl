@@ -0,0 +1,434 @@
+{{- /* Declare set type of enum type */ -}}
+{{- with $ts := .Type -}}
+{{- if $ts.SupportSet -}}
+{{- $set := printf "%sSet" $ts.Name -}}
+{{- $r := receiver $set -}}
+// {{ $set }} is a set of {{ $ts.Name }} values backed by a bitset.
+// The zero value is an empty set.
+type {{ $set }} struct {
+	bits [{{ $ts.Words }}]uint64
+}
+
+// New{{ $set }} returns a set containing the given values.
+// Invalid values are ignored.
+func New{{ $set }}(values ...{{ $ts.Name }}) {{ $set }} {
+	var {{ $r }} {{ $set }}
+	{{ $r }}.Add(values...)
+	return {{ $r }}
+}
+
+// Add adds the values to the set. Invalid values are ignored.
+func ({{ $r }} *{{ $set }}) Add(values ...{{ $ts.Name }}) {
+	for _, v := range values {
+		if !v.IsValid() {
+			continue
+		}
+		idx := uint64(v) - {{ $ts.Min }}
+		{{ $r }}.bits[idx/64] |= 1 << (idx % 64)
+	}
+}
+
+// Remove removes the values from the set.
+func ({{ $r }} *{{ $set }}) Remove(values ...{{ $ts.Name }}) {
+	for _, v := range values {
+		if !v.IsValid() {
+			continue
+		}
+		idx := uint64(v) - {{ $ts.Min }}
+		{{ $r }}.bits[idx/64] &^= 1 << (idx % 64)
+	}
+}
+
+// Contains tests whether the value is an element of the set.
+func ({{ $r }} {{ $set }}) Contains(v {{ $ts.Name }}) bool {
+	if !v.IsValid() {
+		return false
+	}
+	idx := uint64(v) - {{ $ts.Min }}
+	return {{ $r }}.bits[idx/64]&(1<<(idx%64)) != 0
+}
+
+// Union returns a set of all values contained in either set.
+func ({{ $r }} {{ $set }}) Union(other {{ $set }}) {{ $set }} {
+	for idx := range {{ $r }}.bits {
+		{{ $r }}.bits[idx] |= other.bits[idx]
+	}
+	return {{ $r }}
+}
+
+// Intersect returns a set of all values contained in both sets.
+func ({{ $r }} {{ $set }}) Intersect(other {{ $set }}) {{ $set }} {
+	for idx := range {{ $r }}.bits {
+		{{ $r }}.bits[idx] &= other.bits[idx]
+	}
+	return {{ $r }}
+}
+
+// Difference returns a set of all values contained in the set, but not in the other set.
+func ({{ $r }} {{ $set }}) Difference(other {{ $set }}) {{ $set }} {
+	for idx := range {{ $r }}.bits {
+		{{ $r }}.bits[idx] &^= other.bits[idx]
+	}
+	return {{ $r }}
+}
+
+// Len returns the count of values in the set.
+func ({{ $r }} {{ $set }}) Len() int {
+	var n int
+	for _, w := range {{ $r }}.bits {
+		n += bits.OnesCount64(w)
+	}
+	return n
+}
+
+// All returns all values of the set in ascending order.
+func ({{ $r }} {{ $set }}) All() []{{ $ts.Name }} {
+	out := make([]{{ $ts.Name }}, 0, {{ $r }}.Len())
+	for idx, w := range {{ $r }}.bits {
+		for ; w != 0; w &= w - 1 {
+			out = append(out, {{ $ts.Name }}(uint64(idx*64+bits.TrailingZeros64(w))+{{ $ts.Min }}))
+		}
+	}
+	return out
+}
+
+// Strings returns the String values of all values of the set in ascending order.
+func ({{ $r }} {{ $set }}) Strings() []string {
+	values := {{ $r }}.All()
+	out := make([]string, len(values))
+	for idx, v := range values {
+		out[idx] = v.String()
+	}
+	return out
+}
+
+// String implements the Stringer interface.
+func ({{ $r }} {{ $set }}) String() string {
+	return "[" + strings.Join({{ $r }}.Strings(), " ") + "]"
+}
+{{- if $ts.ParsesStrings }}
+
+// setStrings replaces the values of the set by the values of the given String values.
+func ({{ $r }} *{{ $set }}) setStrings(strs []string) error {
+	*{{ $r }} = {{ $set }}{}
+	for _, str := range strs {
+		v, ok := {{ $ts.Name }}FromString{{ if $ts.SupportIgnoreCase }}IgnoreCase{{ end }}(str)
+		if !ok {
+			return _enumer.NewParseError("{{ $ts.Name }}", str, {{ $ts.Name }}Strings())
+		}
+		{{ $r }}.Add(v)
+	}
+	return nil
+}
+{{- end }}
+{{- $isJoined := or (contains $ts.Serializers "binary") (contains $ts.Serializers "gob") (contains $ts.Serializers "text") (contains $ts.Serializers "xml") }}
+{{- if $isJoined }}
+
+// setJoined replaces the values of the set by the values of a comma-joined
+// list of String values.
+func ({{ $r }} *{{ $set }}) setJoined(str string) error {
+	if len(str) == 0 {
+		*{{ $r }} = {{ $set }}{}
+		return nil
+	}
+	return {{ $r }}.setStrings(strings.Split(str, ","))
+}
+{{- end }}
+{{ if contains $ts.Serializers "binary" }}
+// MarshalBinary implements the encoding.BinaryMarshaler interface for {{ $set }}.
+func ({{ $r }} {{ $set }}) MarshalBinary() ([]byte, error) {
+	return {{ $r }}.AppendBinary(nil)
+}
+
+// AppendBinary implements the encoding.BinaryAppender interface for {{ $set }}.
+func ({{ $r }} {{ $set }}) AppendBinary(b []byte) ([]byte, error) {
+	return append(b, strings.Join({{ $r }}.Strings(), ",")...), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface for {{ $set }}.
+func ({{ $r }} *{{ $set }}) UnmarshalBinary(text []byte) error {
+	return {{ $r }}.setJoined(string(text))
+}
+{{ end }}
+{{- if contains $ts.Serializers "binary.varint" }}
+// MarshalBinary implements the encoding.BinaryMarshaler interface for {{ $set }}.
+// The values are encoded compactly as a sequence of uvarints of their numeric representation.
+func ({{ $r }} {{ $set }}) MarshalBinary() ([]byte, error) {
+	return {{ $r }}.AppendBinary(nil)
+}
+
+// AppendBinary implements the encoding.BinaryAppender interface for {{ $set }}.
+func ({{ $r }} {{ $set }}) AppendBinary(b []byte) ([]byte, error) {
+	for idx, w := range {{ $r }}.bits {
+		for ; w != 0; w &= w - 1 {
+			b = binary.AppendUvarint(b, uint64(idx*64+bits.TrailingZeros64(w))+{{ $ts.Min }})
+		}
+	}
+	return b, nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface for {{ $set }}.
+func ({{ $r }} *{{ $set }}) UnmarshalBinary(data []byte) error {
+	*{{ $r }} = {{ $set }}{}
+	for len(data) > 0 {
+		id, n := binary.Uvarint(data)
+		if n <= 0 {
+			return fmt.Errorf("{{ $set }} cannot be derived from malformed varint %#x", data)
+		}
+		v := {{ $ts.Name }}(id)
+		if uint64(v) != id || !v.IsValid() {
+			return fmt.Errorf("{{ $ts.Name }}(%d) is %w", id, ErrNoValidEnum)
+		}
+		{{ $r }}.Add(v)
+		data = data[n:]
+	}
+	return nil
+}
+{{ end }}
+{{- if contains $ts.Serializers "bson" }}
+// MarshalBSONValue implements the bson.ValueMarshaler interface for {{ $set }}.
+func ({{ $r }} {{ $set }}) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	return bson.MarshalValue({{ $r }}.Strings())
+}
+
+// UnmarshalBSONValue implements the bson.ValueUnmarshaler interface for {{ $set }}.
+func ({{ $r }} *{{ $set }}) UnmarshalBSONValue(t bsontype.Type, data []byte) error {
+	if t == bsontype.Undefined || t == bsontype.Null {
+		*{{ $r }} = {{ $set }}{}
+		return nil
+	}
+	if t != bsontype.Array {
+		return fmt.Errorf("{{ $set }} should be an array, got %q of Type %q", data, t)
+	}
+	var strs []string
+	if err := (bson.RawValue{Type: t, Value: data}).Unmarshal(&strs); err != nil {
+		return fmt.Errorf("{{ $set }} should be an array of strings. %w", err)
+	}
+	return {{ $r }}.setStrings(strs)
+}
+{{ end }}
+{{- if contains $ts.Serializers "cbor" }}
+// MarshalCBOR implements the cbor.Marshaler interface for {{ $set }}.
+func ({{ $r }} {{ $set }}) MarshalCBOR() ([]byte, error) {
+	return cbor.Marshal({{ $r }}.Strings())
+}
+
+// UnmarshalCBOR implements the cbor.Unmarshaler interface for {{ $set }}.
+func ({{ $r }} *{{ $set }}) UnmarshalCBOR(data []byte) error {
+	var strs []string
+	if err := cbor.Unmarshal(data, &strs); err != nil {
+		return fmt.Errorf("{{ $set }} should be an array of strings, got %q", data)
+	}
+	return {{ $r }}.setStrings(strs)
+}
+{{ end }}
+{{- if contains $ts.Serializers "gob" }}
+// GobEncode implements the gob.GobEncoder interface for {{ $set }}.
+func ({{ $r }} {{ $set }}) GobEncode() ([]byte, error) {
+	return []byte(strings.Join({{ $r }}.Strings(), ",")), nil
+}
+
+// GobDecode implements the gob.GobDecoder interface for {{ $set }}.
+func ({{ $r }} *{{ $set }}) GobDecode(data []byte) error {
+	return {{ $r }}.setJoined(string(data))
+}
+{{ end }}
+{{- if contains $ts.Serializers "graphql" }}
+// MarshalGQL implements the graphql.Marshaler interface for {{ $set }}.
+func ({{ $r }} {{ $set }}) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, "[")
+	for idx, str := range {{ $r }}.Strings() {
+		if idx > 0 {
+			fmt.Fprint(w, ",")
+		}
+		fmt.Fprint(w, strconv.Quote(str))
+	}
+	fmt.Fprint(w, "]")
+}
+
+// UnmarshalGQL implements the graphql.Unmarshaler interface for {{ $set }}.
+func ({{ $r }} *{{ $set }}) UnmarshalGQL(value interface{}) error {
+	var values []interface{}
+	switch v := value.(type) {
+	case nil:
+	case []interface{}:
+		values = v
+	default:
+		values = []interface{}{v} // hint: GraphQL coerces a single value into a list
+	}
+	strs := make([]string, len(values))
+	for idx, value := range values {
+		switch v := value.(type) {
+		case []byte:
+			strs[idx] = string(v)
+		case string:
+			strs[idx] = v
+		case fmt.Stringer:
+			strs[idx] = v.String()
+		default:
+			return fmt.Errorf("invalid value of {{ $ts.Name }}: %[1]T(%[1]v)", value)
+		}
+	}
+	return {{ $r }}.setStrings(strs)
+}
+{{ end }}
+{{- if contains $ts.Serializers "json" }}
+// MarshalJSON implements the json.Marshaler interface for {{ $set }}.
+func ({{ $r }} {{ $set }}) MarshalJSON() ([]byte, error) {
+	return json.Marshal({{ $r }}.Strings())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for {{ $set }}.
+func ({{ $r }} *{{ $set }}) UnmarshalJSON(data []byte) error {
+	var strs []string
+	if err := json.Unmarshal(data, &strs); err != nil {
+		return fmt.Errorf("{{ $set }} should be an array of strings, got %q", data)
+	}
+	return {{ $r }}.setStrings(strs)
+}
+{{ end }}
+{{- if contains $ts.Serializers "msgpack" }}
+// MarshalMsgpack implements the msgpack.Marshaler interface for {{ $set }}.
+func ({{ $r }} {{ $set }}) MarshalMsgpack() ([]byte, error) {
+	return msgpack.Marshal({{ $r }}.Strings())
+}
+
+// UnmarshalMsgpack implements the msgpack.Unmarshaler interface for {{ $set }}.
+func ({{ $r }} *{{ $set }}) UnmarshalMsgpack(data []byte) error {
+	var strs []string
+	if err := msgpack.Unmarshal(data, &strs); err != nil {
+		return fmt.Errorf("{{ $set }} should be an array of strings, got %q", data)
+	}
+	return {{ $r }}.setStrings(strs)
+}
+{{ end }}
+{{- if contains $ts.Serializers "sql" }}
+// Value implements the sql/driver.Valuer interface for {{ $set }}.
+// The set is stored as Postgres array of its String values, e.g. `{a,b}`.
+func ({{ $r }} {{ $set }}) Value() (driver.Value, error) {
+	return string(_enumer.AppendArray(nil, {{ $r }}.Strings())), nil
+}
+{{ else if contains $ts.Serializers "sql.int" }}
+// Value implements the sql/driver.Valuer interface for {{ $set }}.
+// The set is stored as Postgres array of its numeric values, e.g. `{1,3}`.
+func ({{ $r }} {{ $set }}) Value() (driver.Value, error) {
+	values := {{ $r }}.All()
+	elems := make([]string, len(values))
+	for idx, v := range values {
+		elems[idx] = strconv.FormatUint(uint64(v), 10)
+	}
+	return string(_enumer.AppendArray(nil, elems)), nil
+}
+{{ end }}
+{{- if or (contains $ts.Serializers "sql") (contains $ts.Serializers "sql.int") }}
+// Scan implements the sql/driver.Scanner interface for {{ $set }}.
+// It accepts Postgres arrays of {{ if contains $ts.Serializers "sql" }}String{{ else }}numeric{{ end }} values.
+func ({{ $r }} *{{ $set }}) Scan(value interface{}) error {
+	var str string
+	switch v := value.(type) {
+	case nil:
+		*{{ $r }} = {{ $set }}{}
+		return nil
+	case []byte:
+		str = string(v)
+	case string:
+		str = v
+	case fmt.Stringer:
+		str = v.String()
+	default:
+		return fmt.Errorf("invalid value of {{ $set }}: %[1]T(%[1]v)", value)
+	}
+	elems, err := _enumer.ParseArray(str)
+	if err != nil {
+		return fmt.Errorf("invalid value of {{ $set }}: %w", err)
+	}
+{{- if contains $ts.Serializers "sql" }}
+	return {{ $r }}.setStrings(elems)
+{{- else }}
+	*{{ $r }} = {{ $set }}{}
+	for _, elem := range elems {
+		id, err := strconv.ParseUint(elem, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid value of {{ $set }}: %w", err)
+		}
+		v := {{ $ts.Name }}(id)
+		if uint64(v) != id || !v.IsValid() {
+			return fmt.Errorf("{{ $ts.Name }}(%d) is %w", id, ErrNoValidEnum)
+		}
+		{{ $r }}.Add(v)
+	}
+	return nil
+{{- end }}
+}
+{{ end }}
+{{- if contains $ts.Serializers "text" }}
+// MarshalText implements the encoding.TextMarshaler interface for {{ $set }}.
+func ({{ $r }} {{ $set }}) MarshalText() ([]byte, error) {
+	return {{ $r }}.AppendText(nil)
+}
+
+// AppendText implements the encoding.TextAppender interface for {{ $set }}.
+func ({{ $r }} {{ $set }}) AppendText(b []byte) ([]byte, error) {
+	return append(b, strings.Join({{ $r }}.Strings(), ",")...), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface for {{ $set }}.
+func ({{ $r }} *{{ $set }}) UnmarshalText(text []byte) error {
+	return {{ $r }}.setJoined(string(text))
+}
+{{ end }}
+{{- if contains $ts.Serializers "xml" }}
+// MarshalXML implements the xml.Marshaler interface for {{ $set }}.
+func ({{ $r }} {{ $set }}) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return e.EncodeElement(strings.Join({{ $r }}.Strings(), ","), start)
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface for {{ $set }}.
+func ({{ $r }} *{{ $set }}) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var str string
+	if err := d.DecodeElement(&str, &start); err != nil {
+		return err
+	}
+	return {{ $r }}.setJoined(str)
+}
+
+// MarshalXMLAttr implements the xml.MarshalerAttr interface for {{ $set }}.
+func ({{ $r }} {{ $set }}) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return xml.Attr{Name: name, Value: strings.Join({{ $r }}.Strings(), ",")}, nil
+}
+
+// UnmarshalXMLAttr implements the xml.UnmarshalerAttr interface for {{ $set }}.
+func ({{ $r }} *{{ $set }}) UnmarshalXMLAttr(attr xml.Attr) error {
+	return {{ $r }}.setJoined(attr.Value)
+}
+{{ end }}
+{{- $serializeYamlV3 := contains $ts.Serializers "yaml.v3" -}}
+{{- if or (contains $ts.Serializers "yaml") $serializeYamlV3 }}
+// MarshalYAML implements a YAML Marshaler for {{ $set }}.
+func ({{ $r }} {{ $set }}) MarshalYAML() (interface{}, error) {
+	return {{ $r }}.Strings(), nil
+}
+
+{{ if $serializeYamlV3 -}}
+// UnmarshalYAML implements a YAML Unmarshaler for {{ $set }}.
+func ({{ $r }} *{{ $set }}) UnmarshalYAML(n *yaml.Node) error {
+	var strs []string
+	if err := n.Decode(&strs); err != nil {
+		return err
+	}
+	return {{ $r }}.setStrings(strs)
+}
+{{- else -}}
+// UnmarshalYAML implements a YAML Unmarshaler for {{ $set }}.
+func ({{ $r }} *{{ $set }}) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var strs []string
+	if err := unmarshal(&strs); err != nil {
+		return err
+	}
+	return {{ $r }}.setStrings(strs)
+}
+{{- end }}
+{{ end }}
+
+{{ end -}}
+{{ end -}}
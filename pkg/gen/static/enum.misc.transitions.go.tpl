@@ -0,0 +1,46 @@
+{{- /* Declare state machine of enum type */ -}}
+{{- with $ts := .Type -}}
+{{- if $ts.HasTransitions -}}
+// CanTransitionTo tests whether the enum can transition to the next value.
+func ({{ receiver $ts.Name }} {{ $ts.Name }}) CanTransitionTo(next {{ $ts.Name }}) bool {
+	switch {{ receiver $ts.Name }} {
+	{{- range $v := $ts.States }}
+	case {{ $v.Value }}:
+		return {{ $sep := "" }}{{ range $t := $v.Targets }}{{ $sep }}next == {{ $t }}{{ $sep = " || " }}{{ end }}
+	{{- end }}
+	}
+	return false
+}
+
+// Transitions returns all values the enum can transition to.
+func ({{ receiver $ts.Name }} {{ $ts.Name }}) Transitions() []{{ $ts.Name }} {
+	switch {{ receiver $ts.Name }} {
+	{{- range $v := $ts.States }}
+	case {{ $v.Value }}:
+		return []{{ $ts.Name }}{ {{- $sep := "" }}{{ range $t := $v.Targets }}{{ $sep }}{{ $t }}{{ $sep = ", " }}{{ end -}} }
+	{{- end }}
+	}
+	return nil
+}
+
+// IsTerminal tests whether the enum is a valid value which cannot transition to any other value.
+func ({{ receiver $ts.Name }} {{ $ts.Name }}) IsTerminal() bool {
+	switch {{ receiver $ts.Name }} {
+	case {{ $sep := "" }}{{ range $v := $ts.States }}{{ $sep }}{{ $v.Value }}{{ $sep = ", " }}{{ end }}:
+		return false
+	}
+	return {{ receiver $ts.Name }}.IsValid()
+}
+
+// {{ $ts.Name }}TransitionsDOT returns the state machine of the enum as Graphviz DOT graph.
+func {{ $ts.Name }}TransitionsDOT() string {
+	return {{ printf "%q" $ts.DOT }}
+}
+
+// {{ $ts.Name }}TransitionsMermaid returns the state machine of the enum as Mermaid state diagram.
+func {{ $ts.Name }}TransitionsMermaid() string {
+	return {{ printf "%q" $ts.Mermaid }}
+}
+
+{{ end -}}
+{{ end -}}
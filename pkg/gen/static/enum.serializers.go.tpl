@@ -3,16 +3,21 @@
 {{- if contains $ts.Serializers "binary" }}
 // MarshalBinary implements the encoding.BinaryMarshaler interface for {{ $ts.Name }}.
 func ({{ receiver $ts.Name }} {{ $ts.Name }}) MarshalBinary() ([]byte, error) {
+	return {{ receiver $ts.Name }}.AppendBinary(nil)
+}
+
+// AppendBinary implements the encoding.BinaryAppender interface for {{ $ts.Name }}.
+func ({{ receiver $ts.Name }} {{ $ts.Name }}) AppendBinary(b []byte) ([]byte, error) {
 	if err := {{ receiver $ts.Name }}.Validate(); err != nil {
-		return nil, fmt.Errorf("Cannot marshal value %q as {{ $ts.Name }}. %w", {{ receiver $ts.Name }}, err)
+		return b, fmt.Errorf("Cannot marshal value %q as {{ $ts.Name }}. %w", {{ receiver $ts.Name }}, err)
 	}
-	return []byte({{ receiver $ts.Name }}.String()), nil
+	return append(b, {{ receiver $ts.Name }}.String()...), nil
 }
 
 // UnmarshalBinary implements the encoding.BinaryUnmarshaler interface for {{ $ts.Name }}.
 func ({{ receiver $ts.Name }} *{{ $ts.Name }}) UnmarshalBinary(text []byte) error {
 	str := string(text)
-{{- if not $ts.SupportUndefined }}
+{{- if not (or $ts.SupportUndefined $ts.HasDefault) }}
 	if len(str) == 0 {
 		return fmt.Errorf("{{ $ts.Name }} cannot be derived from empty string")
 	}
@@ -21,8 +26,48 @@ func ({{ receiver $ts.Name }} *{{ $ts.Name }}) UnmarshalBinary(text []byte) erro
 	var ok bool
 	*{{ receiver $ts.Name }}, ok = {{ $ts.Name }}FromString{{ if $ts.SupportIgnoreCase }}IgnoreCase{{ end }}(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a {{ $ts.Name }}", str)
+		return _enumer.NewParseError("{{ $ts.Name }}", str, {{ $ts.Name }}Strings())
+	}
+	return nil
+}
+{{ end }}
+{{- if contains $ts.Serializers "binary.varint" }}
+// MarshalBinary implements the encoding.BinaryMarshaler interface for {{ $ts.Name }}.
+// The value is encoded compactly as the uvarint of its numeric representation.
+func ({{ receiver $ts.Name }} {{ $ts.Name }}) MarshalBinary() ([]byte, error) {
+	return {{ receiver $ts.Name }}.AppendBinary(nil)
+}
+
+// AppendBinary implements the encoding.BinaryAppender interface for {{ $ts.Name }}.
+func ({{ receiver $ts.Name }} {{ $ts.Name }}) AppendBinary(b []byte) ([]byte, error) {
+	if err := {{ receiver $ts.Name }}.Validate(); err != nil {
+		return b, fmt.Errorf("Cannot marshal value %q as {{ $ts.Name }}. %w", {{ receiver $ts.Name }}, err)
+	}
+	return binary.AppendUvarint(b, uint64({{ receiver $ts.Name }})), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface for {{ $ts.Name }}.
+func ({{ receiver $ts.Name }} *{{ $ts.Name }}) UnmarshalBinary(data []byte) error {
+{{- if $ts.HasDefault }}
+	if len(data) == 0 {
+		*{{ receiver $ts.Name }} = {{ $ts.DefaultValue }}
+		return nil
+	}
+{{- else if $ts.SupportUndefined }}
+	if len(data) == 0 {
+		*{{ receiver $ts.Name }} = 0
+		return nil
+	}
+{{- end }}
+	id, n := binary.Uvarint(data)
+	if n <= 0 || n != len(data) {
+		return fmt.Errorf("{{ $ts.Name }} cannot be derived from malformed varint %#x", data)
+	}
+	v := {{ $ts.Name }}(id)
+	if uint64(v) != id || !v.IsValid() {
+		return fmt.Errorf("{{ $ts.Name }}(%d) is %w", id, ErrNoValidEnum)
 	}
+	*{{ receiver $ts.Name }} = v
 	return nil
 }
 {{ end }}
@@ -42,6 +87,12 @@ func ({{ receiver $ts.Name }} {{ $ts.Name }}) MarshalBSONValue() (bsontype.Type,
 
 // UnmarshalBSONValue implements the bson.ValueUnmarshaler interface for {{ $ts.Name }}.
 func ({{ receiver $ts.Name }} *{{ $ts.Name }}) UnmarshalBSONValue(t bsontype.Type, data []byte) error {
+{{- if $ts.HasDefault }}
+	if t == bsontype.Undefined || t == bsontype.Null {
+		*{{ receiver $ts.Name }} = {{ $ts.DefaultValue }}
+		return nil
+	}
+{{- end }}
 	if t != bsontype.String {{- if $ts.SupportUndefined }} && t != bsontype.Undefined {{- end }} {
 		return fmt.Errorf("{{ $ts.Name }} should be a string, got %q of Type %q", data, t)
 	}
@@ -49,15 +100,92 @@ func ({{ receiver $ts.Name }} *{{ $ts.Name }}) UnmarshalBSONValue(t bsontype.Typ
 	if !ok {
 		return fmt.Errorf("failed reading value as string, got %q", data)
 	}
-{{- if not $ts.SupportUndefined }}
+{{- if not (or $ts.SupportUndefined $ts.HasDefault) }}
+	if len(str) == 0 {
+		return fmt.Errorf("{{ $ts.Name }} cannot be derived from empty string")
+	}
+{{- end }}
+
+	*{{ receiver $ts.Name }}, ok = {{ $ts.Name }}FromString{{ if $ts.SupportIgnoreCase }}IgnoreCase{{ end }}(str)
+	if !ok {
+		return _enumer.NewParseError("{{ $ts.Name }}", str, {{ $ts.Name }}Strings())
+	}
+	return nil
+}
+{{ end }}
+{{- if contains $ts.Serializers "cbor" }}
+// MarshalCBOR implements the cbor.Marshaler interface for {{ $ts.Name }}.
+func ({{ receiver $ts.Name }} {{ $ts.Name }}) MarshalCBOR() ([]byte, error) {
+	if err := {{ receiver $ts.Name }}.Validate(); err != nil {
+		return nil, fmt.Errorf("Cannot marshal value %q as {{ $ts.Name }}. %w", {{ receiver $ts.Name }}, err)
+	}
+	return cbor.Marshal({{ receiver $ts.Name }}.String())
+}
+
+// UnmarshalCBOR implements the cbor.Unmarshaler interface for {{ $ts.Name }}.
+func ({{ receiver $ts.Name }} *{{ $ts.Name }}) UnmarshalCBOR(data []byte) error {
+	var str string
+	if err := cbor.Unmarshal(data, &str); err != nil {
+		return fmt.Errorf("{{ $ts.Name }} should be a string, got %q", data)
+	}
+{{- if not (or $ts.SupportUndefined $ts.HasDefault) }}
 	if len(str) == 0 {
 		return fmt.Errorf("{{ $ts.Name }} cannot be derived from empty string")
 	}
 {{- end }}
 
+	var ok bool
 	*{{ receiver $ts.Name }}, ok = {{ $ts.Name }}FromString{{ if $ts.SupportIgnoreCase }}IgnoreCase{{ end }}(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a {{ $ts.Name }}", str)
+		return _enumer.NewParseError("{{ $ts.Name }}", str, {{ $ts.Name }}Strings())
+	}
+	return nil
+}
+{{ end }}
+{{- if contains $ts.Serializers "flag" }}
+// Set implements the flag.Value interface for {{ $ts.Name }}.
+// It also satisfies the pflag.Value interface (github.com/spf13/pflag) along with Type.
+func ({{ receiver $ts.Name }} *{{ $ts.Name }}) Set(value string) error {
+	v, ok := {{ $ts.Name }}FromString{{ if $ts.SupportIgnoreCase }}IgnoreCase{{ end }}(value)
+	if !ok {
+		return fmt.Errorf("%w (allowed values: %s)", _enumer.NewParseError("{{ $ts.Name }}", value, {{ $ts.Name }}Strings()), strings.Join({{ $ts.Name }}Strings(), ", "))
+	}
+	*{{ receiver $ts.Name }} = v
+	return nil
+}
+
+// Type returns the name of the value type for the pflag.Value interface (github.com/spf13/pflag).
+func ({{ $ts.Name }}) Type() string {
+	return "{{ $ts.Name }}"
+}
+
+// Decode implements the envconfig.Decoder interface (github.com/kelseyhightower/envconfig) for {{ $ts.Name }}.
+func ({{ receiver $ts.Name }} *{{ $ts.Name }}) Decode(value string) error {
+	return {{ receiver $ts.Name }}.Set(value)
+}
+{{ end }}
+{{- if contains $ts.Serializers "gob" }}
+// GobEncode implements the gob.GobEncoder interface for {{ $ts.Name }}.
+func ({{ receiver $ts.Name }} {{ $ts.Name }}) GobEncode() ([]byte, error) {
+	if err := {{ receiver $ts.Name }}.Validate(); err != nil {
+		return nil, fmt.Errorf("Cannot marshal value %q as {{ $ts.Name }}. %w", {{ receiver $ts.Name }}, err)
+	}
+	return []byte({{ receiver $ts.Name }}.String()), nil
+}
+
+// GobDecode implements the gob.GobDecoder interface for {{ $ts.Name }}.
+func ({{ receiver $ts.Name }} *{{ $ts.Name }}) GobDecode(data []byte) error {
+	str := string(data)
+{{- if not (or $ts.SupportUndefined $ts.HasDefault) }}
+	if len(str) == 0 {
+		return fmt.Errorf("{{ $ts.Name }} cannot be derived from empty string")
+	}
+{{- end }}
+
+	var ok bool
+	*{{ receiver $ts.Name }}, ok = {{ $ts.Name }}FromString{{ if $ts.SupportIgnoreCase }}IgnoreCase{{ end }}(str)
+	if !ok {
+		return _enumer.NewParseError("{{ $ts.Name }}", str, {{ $ts.Name }}Strings())
 	}
 	return nil
 }
@@ -72,7 +200,7 @@ func ({{ receiver $ts.Name }} {{ $ts.Name }}) MarshalGQL(w io.Writer) {
 func ({{ receiver $ts.Name }} *{{ $ts.Name }}) UnmarshalGQL(value interface{}) error {
 	var str string
 	switch v := value.(type) {
-	{{- if $ts.SupportUndefined }}
+	{{- if or $ts.SupportUndefined $ts.HasDefault }}
 	case nil:
 	{{- end }}
 	case []byte:
@@ -84,7 +212,7 @@ func ({{ receiver $ts.Name }} *{{ $ts.Name }}) UnmarshalGQL(value interface{}) e
 	default:
 		return fmt.Errorf("invalid value of {{ $ts.Name }}: %[1]T(%[1]v)", value)
 	}
-{{- if not $ts.SupportUndefined }}
+{{- if not (or $ts.SupportUndefined $ts.HasDefault) }}
 	if len(str) == 0 {
 		return fmt.Errorf("{{ $ts.Name }} cannot be derived from empty string")
 	}
@@ -93,7 +221,7 @@ func ({{ receiver $ts.Name }} *{{ $ts.Name }}) UnmarshalGQL(value interface{}) e
 	var ok bool
 	*{{ receiver $ts.Name }}, ok = {{ $ts.Name }}FromString{{ if $ts.SupportIgnoreCase }}IgnoreCase{{ end }}(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a {{ $ts.Name }}", str)
+		return _enumer.NewParseError("{{ $ts.Name }}", str, {{ $ts.Name }}Strings())
 	}
 	return nil
 }
@@ -113,7 +241,7 @@ func ({{ receiver $ts.Name }} *{{ $ts.Name }}) UnmarshalJSON(data []byte) error
 	if err := json.Unmarshal(data, &str); err != nil {
 		return fmt.Errorf("{{ $ts.Name }} should be a string, got %q", data)
 	}
-{{- if not $ts.SupportUndefined }}
+{{- if not (or $ts.SupportUndefined $ts.HasDefault) }}
 	if len(str) == 0 {
 		return fmt.Errorf("{{ $ts.Name }} cannot be derived from empty string")
 	}
@@ -122,7 +250,36 @@ func ({{ receiver $ts.Name }} *{{ $ts.Name }}) UnmarshalJSON(data []byte) error
 	var ok bool
 	*{{ receiver $ts.Name }}, ok = {{ $ts.Name }}FromString{{ if $ts.SupportIgnoreCase }}IgnoreCase{{ end }}(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a {{ $ts.Name }}", str)
+		return _enumer.NewParseError("{{ $ts.Name }}", str, {{ $ts.Name }}Strings())
+	}
+	return nil
+}
+{{ end }}
+{{- if contains $ts.Serializers "msgpack" }}
+// MarshalMsgpack implements the msgpack.Marshaler interface for {{ $ts.Name }}.
+func ({{ receiver $ts.Name }} {{ $ts.Name }}) MarshalMsgpack() ([]byte, error) {
+	if err := {{ receiver $ts.Name }}.Validate(); err != nil {
+		return nil, fmt.Errorf("Cannot marshal value %q as {{ $ts.Name }}. %w", {{ receiver $ts.Name }}, err)
+	}
+	return msgpack.Marshal({{ receiver $ts.Name }}.String())
+}
+
+// UnmarshalMsgpack implements the msgpack.Unmarshaler interface for {{ $ts.Name }}.
+func ({{ receiver $ts.Name }} *{{ $ts.Name }}) UnmarshalMsgpack(data []byte) error {
+	var str string
+	if err := msgpack.Unmarshal(data, &str); err != nil {
+		return fmt.Errorf("{{ $ts.Name }} should be a string, got %q", data)
+	}
+{{- if not (or $ts.SupportUndefined $ts.HasDefault) }}
+	if len(str) == 0 {
+		return fmt.Errorf("{{ $ts.Name }} cannot be derived from empty string")
+	}
+{{- end }}
+
+	var ok bool
+	*{{ receiver $ts.Name }}, ok = {{ $ts.Name }}FromString{{ if $ts.SupportIgnoreCase }}IgnoreCase{{ end }}(str)
+	if !ok {
+		return _enumer.NewParseError("{{ $ts.Name }}", str, {{ $ts.Name }}Strings())
 	}
 	return nil
 }
@@ -145,7 +302,7 @@ func ({{ receiver $ts.Name }} {{ $ts.Name }}) Value() (driver.Value, error) {
 func ({{ receiver $ts.Name }} *{{ $ts.Name }}) Scan(value interface{}) error {
 	var str string
 	switch v := value.(type) {
-	{{- if $ts.SupportUndefined }}
+	{{- if or $ts.SupportUndefined $ts.HasDefault }}
 	case nil:
 	{{- end }}
 	case []byte:
@@ -157,7 +314,7 @@ func ({{ receiver $ts.Name }} *{{ $ts.Name }}) Scan(value interface{}) error {
 	default:
 		return fmt.Errorf("invalid value of {{ $ts.Name }}: %[1]T(%[1]v)", value)
 	}
-{{- if not $ts.SupportUndefined }}
+{{- if not (or $ts.SupportUndefined $ts.HasDefault) }}
 	if len(str) == 0 {
 		return fmt.Errorf("{{ $ts.Name }} cannot be derived from empty string")
 	}
@@ -166,24 +323,82 @@ func ({{ receiver $ts.Name }} *{{ $ts.Name }}) Scan(value interface{}) error {
 	var ok bool
 	*{{ receiver $ts.Name }}, ok = {{ $ts.Name }}FromString{{ if $ts.SupportIgnoreCase }}IgnoreCase{{ end }}(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a {{ $ts.Name }}", str)
+		return _enumer.NewParseError("{{ $ts.Name }}", str, {{ $ts.Name }}Strings())
 	}
 	return nil
 }
 {{ end }}
+{{- if contains $ts.Serializers "sql.int" }}
+// Value implements the sql/driver.Valuer interface for {{ $ts.Name }}.
+// The value is stored by its numeric representation.
+func ({{ receiver $ts.Name }} {{ $ts.Name }}) Value() (driver.Value, error) {
+	if err := {{ receiver $ts.Name }}.Validate(); err != nil {
+		return nil, fmt.Errorf("Cannot serialize value %q as {{ $ts.Name }}. %w", {{ receiver $ts.Name }}, err)
+	}
+{{- if $ts.RequiresGeneratedUndefinedValue }}
+	if {{ receiver $ts.Name }} == 0 {
+		return nil, nil
+	}
+{{- end }}
+	return int64({{ receiver $ts.Name }}), nil
+}
+
+// Scan implements the sql/driver.Scanner interface for {{ $ts.Name }}.
+func ({{ receiver $ts.Name }} *{{ $ts.Name }}) Scan(value interface{}) error {
+	var id uint64
+	switch v := value.(type) {
+	{{- if $ts.HasDefault }}
+	case nil:
+		*{{ receiver $ts.Name }} = {{ $ts.DefaultValue }}
+		return nil
+	{{- else if $ts.SupportUndefined }}
+	case nil:
+		*{{ receiver $ts.Name }} = 0
+		return nil
+	{{- end }}
+	case int64:
+		if v < 0 {
+			return fmt.Errorf("{{ $ts.Name }}(%d) is %w", v, ErrNoValidEnum)
+		}
+		id = uint64(v)
+	case []byte:
+		return {{ receiver $ts.Name }}.Scan(string(v))
+	case string:
+		parsed, err := strconv.ParseUint(v, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid value of {{ $ts.Name }}: %w", err)
+		}
+		id = parsed
+	default:
+		return fmt.Errorf("invalid value of {{ $ts.Name }}: %[1]T(%[1]v)", value)
+	}
+
+	v := {{ $ts.Name }}(id)
+	if uint64(v) != id || !v.IsValid() {
+		return fmt.Errorf("{{ $ts.Name }}(%d) is %w", id, ErrNoValidEnum)
+	}
+	*{{ receiver $ts.Name }} = v
+	return nil
+}
+{{ end }}
 {{- if contains $ts.Serializers "text" }}
 // MarshalText implements the encoding.TextMarshaler interface for {{ $ts.Name }}.
 func ({{ receiver $ts.Name }} {{ $ts.Name }}) MarshalText() ([]byte, error) {
+	return {{ receiver $ts.Name }}.AppendText(nil)
+}
+
+// AppendText implements the encoding.TextAppender interface for {{ $ts.Name }}.
+func ({{ receiver $ts.Name }} {{ $ts.Name }}) AppendText(b []byte) ([]byte, error) {
 	if err := {{ receiver $ts.Name }}.Validate(); err != nil {
-		return nil, fmt.Errorf("Cannot marshal value %q as {{ $ts.Name }}. %w", {{ receiver $ts.Name }}, err)
+		return b, fmt.Errorf("Cannot marshal value %q as {{ $ts.Name }}. %w", {{ receiver $ts.Name }}, err)
 	}
-	return []byte({{ receiver $ts.Name }}.String()), nil
+	return append(b, {{ receiver $ts.Name }}.String()...), nil
 }
 
 // UnmarshalText implements the encoding.TextUnmarshaler interface for {{ $ts.Name }}.
 func ({{ receiver $ts.Name }} *{{ $ts.Name }}) UnmarshalText(text []byte) error {
 	str := string(text)
-{{- if not $ts.SupportUndefined }}
+{{- if not (or $ts.SupportUndefined $ts.HasDefault) }}
 	if len(str) == 0 {
 		return fmt.Errorf("{{ $ts.Name }} cannot be derived from empty string")
 	}
@@ -192,7 +407,66 @@ func ({{ receiver $ts.Name }} *{{ $ts.Name }}) UnmarshalText(text []byte) error
 	var ok bool
 	*{{ receiver $ts.Name }}, ok = {{ $ts.Name }}FromString{{ if $ts.SupportIgnoreCase }}IgnoreCase{{ end }}(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a {{ $ts.Name }}", str)
+		return _enumer.NewParseError("{{ $ts.Name }}", str, {{ $ts.Name }}Strings())
+	}
+	return nil
+}
+{{ end }}
+{{- if contains $ts.Serializers "xml" }}
+// MarshalXML implements the xml.Marshaler interface for {{ $ts.Name }}.
+func ({{ receiver $ts.Name }} {{ $ts.Name }}) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if err := {{ receiver $ts.Name }}.Validate(); err != nil {
+		return fmt.Errorf("Cannot marshal value %q as {{ $ts.Name }}. %w", {{ receiver $ts.Name }}, err)
+	}
+	return e.EncodeElement({{ receiver $ts.Name }}.String(), start)
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface for {{ $ts.Name }}.
+func ({{ receiver $ts.Name }} *{{ $ts.Name }}) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var str string
+	if err := d.DecodeElement(&str, &start); err != nil {
+		return err
+	}
+{{- if not (or $ts.SupportUndefined $ts.HasDefault) }}
+	if len(str) == 0 {
+		return fmt.Errorf("{{ $ts.Name }} cannot be derived from empty string")
+	}
+{{- end }}
+
+	var ok bool
+	*{{ receiver $ts.Name }}, ok = {{ $ts.Name }}FromString{{ if $ts.SupportIgnoreCase }}IgnoreCase{{ end }}(str)
+	if !ok {
+		return _enumer.NewParseError("{{ $ts.Name }}", str, {{ $ts.Name }}Strings())
+	}
+	return nil
+}
+
+// MarshalXMLAttr implements the xml.MarshalerAttr interface for {{ $ts.Name }}.
+func ({{ receiver $ts.Name }} {{ $ts.Name }}) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	if err := {{ receiver $ts.Name }}.Validate(); err != nil {
+		return xml.Attr{}, fmt.Errorf("Cannot marshal value %q as {{ $ts.Name }}. %w", {{ receiver $ts.Name }}, err)
+	}
+{{- if $ts.RequiresGeneratedUndefinedValue }}
+	if {{ receiver $ts.Name }} == 0 {
+		return xml.Attr{}, nil // an empty name omits the attribute
+	}
+{{- end }}
+	return xml.Attr{Name: name, Value: {{ receiver $ts.Name }}.String()}, nil
+}
+
+// UnmarshalXMLAttr implements the xml.UnmarshalerAttr interface for {{ $ts.Name }}.
+func ({{ receiver $ts.Name }} *{{ $ts.Name }}) UnmarshalXMLAttr(attr xml.Attr) error {
+	str := attr.Value
+{{- if not (or $ts.SupportUndefined $ts.HasDefault) }}
+	if len(str) == 0 {
+		return fmt.Errorf("{{ $ts.Name }} cannot be derived from empty string")
+	}
+{{- end }}
+
+	var ok bool
+	*{{ receiver $ts.Name }}, ok = {{ $ts.Name }}FromString{{ if $ts.SupportIgnoreCase }}IgnoreCase{{ end }}(str)
+	if !ok {
+		return _enumer.NewParseError("{{ $ts.Name }}", str, {{ $ts.Name }}Strings())
 	}
 	return nil
 }
@@ -211,6 +485,12 @@ func ({{ receiver $ts.Name }} {{ $ts.Name }}) MarshalYAML() (interface{}, error)
 // UnmarshalYAML implements a YAML Unmarshaler for {{ $ts.Name }}.
 func ({{ receiver $ts.Name }} *{{ $ts.Name }}) UnmarshalYAML(n *yaml.Node) error {
 	const stringTag = "!!str"
+{{- if $ts.HasDefault }}
+	if n.ShortTag() == "!!null" {
+		*{{ receiver $ts.Name }} = {{ $ts.DefaultValue }}
+		return nil
+	}
+{{- end }}
 	if n.ShortTag() != stringTag {
 		return fmt.Errorf("{{ $ts.Name }} must be derived from a string node")
 	}
@@ -223,7 +503,7 @@ func ({{ receiver $ts.Name }} *{{ $ts.Name }}) UnmarshalYAML(unmarshal func(inte
 		return err
 	}
 {{- end }}
-{{- if not $ts.SupportUndefined }}
+{{- if not (or $ts.SupportUndefined $ts.HasDefault) }}
 	if len(str) == 0 {
 		return fmt.Errorf("{{ $ts.Name }} cannot be derived from empty string")
 	}
@@ -232,7 +512,7 @@ func ({{ receiver $ts.Name }} *{{ $ts.Name }}) UnmarshalYAML(unmarshal func(inte
 	var ok bool
 	*{{ receiver $ts.Name }}, ok = {{ $ts.Name }}FromString{{ if $ts.SupportIgnoreCase }}IgnoreCase{{ end }}(str)
 	if !ok {
-		return fmt.Errorf("Value %q does not represent a {{ $ts.Name }}", str)
+		return _enumer.NewParseError("{{ $ts.Name }}", str, {{ $ts.Name }}Strings())
 	}
 	return nil
 }
@@ -15,6 +15,37 @@ var (
 		_{{ $ts.Name }}String[{{ $v.Position }}:{{ add $v.Position $v.Length }}]
 		{{- if $isNotLast }}, {{ end -}}
 	{{- end -}}}
+{{- /* Declaration of enum's active values (less the deprecated values) */ -}}
+{{- if $ts.HasDeprecatedValues }}
+	_{{ $ts.Name }}ActiveValues  = [{{ $ts.CountActiveValues }}]{{ $ts.Name }}{
+		{{- range $v := $ts.Values }}
+			{{- if or $v.IsAlternativeValue $v.IsDeprecated }}{{continue}}{{ end -}}
+			{{- $v.Value }}, {{ end -}}}
+	_{{ $ts.Name }}ActiveStrings = [{{ $ts.CountActiveValues }}]string{
+		{{- range $v := $ts.Values }}
+			{{- if or $v.IsAlternativeValue $v.IsDeprecated }}{{continue}}{{ end -}}
+			_{{ $ts.Name }}String[{{ $v.Position }}:{{ add $v.Position $v.Length }}], {{ end -}}}
+{{- end }}
+{{- /* Declaration of enum's explicit ordering */ -}}
+{{- if $ts.HasOrder }}
+	_{{ $ts.Name }}OrderedValues = [{{ $ts.CountUniqueValues }}]{{ $ts.Name }}{
+		{{- range $idx, $v := $ts.OrderedValues }}{{ if $idx }}, {{ end }}{{ $v }}{{ end -}} }
+	_{{ $ts.Name }}Indices       = [{{ $ts.CountUniqueValues }}]int{
+		{{- range $idx, $v := $ts.Indices }}{{ if $idx }}, {{ end }}{{ $v }}{{ end -}} }
+{{- end }}
+{{- /* Declaration of enum's labels */ -}}
+{{- if $ts.HasLabels }}
+	_{{ $ts.Name }}LabelLanguages = [{{ len $ts.LabelLanguages }}]language.Tag{
+		{{- range $idx, $l := $ts.LabelLanguages }}{{ if $idx }}, {{ end }}language.MustParse({{ printf "%q" $l }}){{ end -}}
+	}
+	_{{ $ts.Name }}LabelMatcher = language.NewMatcher(_{{ $ts.Name }}LabelLanguages[:])
+	_{{ $ts.Name }}Labels = [{{ $ts.CountUniqueValues }}][{{ len $ts.LabelLanguages }}]string{
+	{{- range $v := $ts.Values }}
+		{{- if $v.IsAlternativeValue }}{{continue}}{{end}}
+		{ {{- range $idx, $l := $v.Labels }}{{ if $idx }}, {{ end }}{{ printf "%q" $l }}{{ end -}} },
+	{{- end }}
+	}
+{{- end }}
 {{- /* Declaration of enum's additional data */ -}}
 {{- if $ts.HasAdditionalData }}
 	_{{ $ts.Name }}AdditionalData  = [{{ $ts.CountUniqueValues }}]struct{
@@ -15,7 +15,11 @@ import (
 {{- end }}
 
 {{/* Declaration of enum specific error */}}
-{{- if .ContainsErrorsPkg -}}
+{{- if .ContainsRuntimePkg -}}
+var (
+	ErrNoValidEnum = _enumer.ErrNoValidEnum
+)
+{{- else -}}
 var (
 	ErrNoValidEnum = errors.New("not a valid enum")
 )
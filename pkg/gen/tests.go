@@ -0,0 +1,141 @@
+package gen
+
+import (
+	"bytes"
+	"fmt"
+	"go/types"
+	"math"
+	"strings"
+	"text/template"
+
+	"github.com/mvrahden/go-enumer/about"
+	"github.com/mvrahden/go-enumer/config"
+	"github.com/mvrahden/go-enumer/pkg/enumer"
+	"github.com/mvrahden/go-enumer/pkg/utils/slices"
+)
+
+var testsTpl = template.Must(template.New("test").Funcs(tplFuncs).ParseFS(templates, "static/test.*"))
+
+// RenderTests renders the tests of all enums of the file, which are meant
+// to be placed next to the generated file, e.g. as "types_enumer_test.go".
+// They round-trip all values through the serializers of the enums and
+// include fuzz targets for the lookup and the JSON deserialization.
+func (r *renderer) RenderTests(f *File) ([]byte, error) {
+	type Header struct {
+		RepoName    string
+		PackageName string
+		Imports     []string
+	}
+	type TplData struct {
+		Name               string
+		HasDefault         bool
+		DefaultValue       string
+		SupportUndefined   bool
+		SupportIgnoreCase  bool
+		Serializers        []string
+		InvalidIDs         []uint64 // hint: ids right outside of the extent of the enum
+		UnknownString      string   // hint: a string, which does not represent any value of the enum
+		AlternativeStrings []string // hint: the strings of the alternative values, which are looked up besides the String values
+	}
+	header := Header{
+		RepoName:    about.ShortInfo(),
+		PackageName: f.Header.Package.Name,
+		Imports:     []string{"strings", "testing"},
+	}
+	data := slices.Map(f.TypeSpecs, func(ts *enumer.EnumType, _ int) TplData {
+		d := TplData{
+			Name:              ts.Name().Name,
+			SupportUndefined:  ts.Config.Options.SupportedFeatures.Contains(config.SupportUndefined),
+			SupportIgnoreCase: ts.Config.Options.SupportedFeatures.Contains(config.SupportIgnoreCase),
+			Serializers:       ts.Config.Options.Serializers,
+			InvalidIDs:        invalidIDs(ts),
+			UnknownString:     "unknown",
+			AlternativeStrings: slices.Map(slices.Filter(ts.Spec.Values, func(v *enumer.EnumTypeSpecValue, _ int) bool {
+				return v.IsAlternative
+			}), func(v *enumer.EnumTypeSpecValue, _ int) string { return v.EnumValue }),
+		}
+		if ts.Spec.Default != nil {
+			d.HasDefault = true
+			d.DefaultValue = testDefaultValue(ts)
+		}
+		for slices.Any(ts.Spec.Values, func(v *enumer.EnumTypeSpecValue, _ int) bool {
+			return strings.EqualFold(v.EnumValue, d.UnknownString)
+		}) {
+			d.UnknownString += "_"
+		}
+		header.Imports = append(header.Imports, testImports(ts)...)
+		if len(d.InvalidIDs) > 0 || len(d.Serializers) > 0 {
+			header.Imports = append(header.Imports, "errors")
+		}
+		return d
+	})
+	header.Imports = slices.SortStable(header.Imports, func(s []string, i, j int) bool { return s[i] < s[j] })
+	header.Imports = slices.Filter(header.Imports, func(v string, idx int) bool {
+		return idx == 0 || v != header.Imports[idx-1]
+	})
+
+	buf := new(bytes.Buffer)
+	if err := testsTpl.ExecuteTemplate(buf, "test.go.tpl", map[string]any{"Header": header, "Types": data}); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// testDefaultValue returns the source representation of the default value.
+func testDefaultValue(ts *enumer.EnumType) string {
+	if ts.HasSimpleBlockSpec() {
+		return ts.Spec.Default.ConstSpec.Node.Names[0].Name
+	}
+	return fmt.Sprintf("%s(%d)", ts.Name().Name, ts.Spec.Default.ID)
+}
+
+// invalidIDs returns the ids right below and above the extent of the enum,
+// as far as they can be represented by its underlying type.
+func invalidIDs(ts *enumer.EnumType) []uint64 {
+	var ids []uint64
+	lowerBound := ts.Spec.Values[0].ID
+	if ts.Config.Options.SupportedFeatures.Contains(config.SupportUndefined) {
+		lowerBound = 0
+	}
+	if lowerBound > 0 {
+		ids = append(ids, lowerBound-1)
+	}
+	upperBound := ts.Spec.Values[len(ts.Spec.Values)-1].ID
+	var max uint64 = math.MaxUint64
+	switch ts.Kind {
+	case types.Uint8:
+		max = math.MaxUint8
+	case types.Uint16:
+		max = math.MaxUint16
+	case types.Uint32:
+		max = math.MaxUint32
+	}
+	if upperBound < max {
+		ids = append(ids, upperBound+1)
+	}
+	return ids
+}
+
+// testImports returns the imports required by the tests of the enum.
+func testImports(ts *enumer.EnumType) []string {
+	var imports []string
+	for _, v := range ts.Config.Options.Serializers {
+		switch v {
+		case config.SerializerBSON:
+			imports = append(imports, "go.mongodb.org/mongo-driver/bson/bsontype", "go.mongodb.org/mongo-driver/x/bsonx/bsoncore")
+		case config.SerializerCBOR:
+			imports = append(imports, "github.com/fxamacker/cbor/v2")
+		case config.SerializerGQL:
+			imports = append(imports, "bytes", "strconv")
+		case config.SerializerJSON:
+			imports = append(imports, "encoding/json", "strconv")
+		case config.SerializerMsgpack:
+			imports = append(imports, "github.com/vmihailenco/msgpack/v5")
+		case config.SerializerXML:
+			imports = append(imports, "encoding/xml")
+		case config.SerializerYamlV3:
+			imports = append(imports, "gopkg.in/yaml.v3")
+		}
+	}
+	return imports
+}
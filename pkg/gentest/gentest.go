@@ -0,0 +1,157 @@
+// Package gentest provides golden file tests of generated enums, e.g. to
+// snapshot-test custom renderers or configurations.
+//
+// Each test case is a testdata directory, which contains a Go package with
+// the enum definitions (incl. their CSV sources), an optional configuration
+// file "config.yml" and the golden files of the expected outputs:
+//
+//	testdata/weekday/
+//	├── config.yml
+//	├── enums.go
+//	├── generated.golden
+//	└── generated_test.golden (only with the WithTests option)
+//
+// Run the tests with the -gentest.update flag to write the actual outputs
+// to the golden files, e.g. `go test ./... -gentest.update`.
+package gentest
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/mvrahden/go-enumer/config"
+	"github.com/mvrahden/go-enumer/pkg/gen"
+)
+
+const (
+	ConfigFile      = "config.yml"            // hint: the optional configuration of a test case
+	GoldenFile      = "generated.golden"      // hint: the expected generated enums
+	GoldenTestsFile = "generated_test.golden" // hint: the expected generated tests of the enums
+)
+
+// hint: the flag is namespaced to not collide with an "update" flag of the test package
+var update = flag.Bool("gentest.update", false, "update the golden files of gentest with the actual outputs")
+
+// Option configures a run of the golden file tests.
+type Option func(o *options)
+
+type options struct {
+	cfg      *config.Options
+	renderer gen.Renderer
+	tests    bool
+}
+
+// WithConfig applies the configuration instead of the "config.yml" of the test case.
+func WithConfig(cfg *config.Options) Option {
+	return func(o *options) {
+		o.cfg = cfg
+	}
+}
+
+// WithRenderer renders the enums with a custom renderer instead of the default renderer.
+func WithRenderer(r gen.Renderer) Option {
+	return func(o *options) {
+		o.renderer = r
+	}
+}
+
+// WithTests also compares the generated tests of the enums against the golden file "generated_test.golden".
+func WithTests() Option {
+	return func(o *options) {
+		o.tests = true
+	}
+}
+
+// Run generates the enums of the Go package in dir via the Inspector/Renderer
+// pipeline and compares the outputs against the golden files in dir.
+// Differences are reported as errors of t; with the -gentest.update flag the golden files
+// are overwritten instead.
+func Run(t testing.TB, dir string, opts ...Option) {
+	t.Helper()
+
+	var o options
+	for _, fn := range opts {
+		fn(&o)
+	}
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		t.Fatalf("failed resolving directory %q. err: %s", dir, err)
+	}
+	if o.cfg == nil {
+		o.cfg = config.LoadFrom(filepath.Join(dir, ConfigFile))
+	}
+	if err := o.cfg.Validate(); err != nil {
+		t.Fatalf("invalid configuration. err: %s", err)
+	}
+	if o.renderer == nil {
+		o.renderer = gen.NewRenderer(o.cfg)
+	}
+
+	g := gen.NewGenerator(gen.NewInspector(o.cfg), o.renderer)
+	f, err := g.Inspect(dir)
+	if err != nil {
+		t.Fatalf("failed inspecting %q. err: %s", dir, err)
+	}
+	src, err := g.Render(f)
+	if err != nil {
+		t.Fatalf("failed rendering %q. err: %s", dir, err)
+	}
+	compare(t, filepath.Join(dir, GoldenFile), src)
+
+	if o.tests {
+		src, err := g.RenderTests(f)
+		if err != nil {
+			t.Fatalf("failed rendering the tests of %q. err: %s", dir, err)
+		}
+		compare(t, filepath.Join(dir, GoldenTestsFile), src)
+	}
+}
+
+func compare(t testing.TB, golden string, actual []byte) {
+	t.Helper()
+	if *update {
+		if err := os.WriteFile(golden, actual, 0o644); err != nil {
+			t.Fatalf("failed updating golden file. err: %s", err)
+		}
+		return
+	}
+	expected, err := os.ReadFile(golden)
+	if err != nil {
+		t.Fatalf("failed reading golden file (run with -gentest.update to create it). err: %s", err)
+	}
+	if d := diff(expected, actual); len(d) > 0 {
+		t.Errorf("%s does not match the actual output (run with -gentest.update to update it):\n%s", golden, d)
+	}
+}
+
+// diff returns the lines of the expected and the actual output, which differ,
+// less their common leading and trailing lines. It returns an empty string if
+// both are equal.
+func diff(expected, actual []byte) string {
+	if bytes.Equal(expected, actual) {
+		return ""
+	}
+	e, a := strings.Split(string(expected), "\n"), strings.Split(string(actual), "\n")
+	var prefix, suffix int
+	for prefix < len(e) && prefix < len(a) && e[prefix] == a[prefix] {
+		prefix++
+	}
+	for suffix < len(e)-prefix && suffix < len(a)-prefix && e[len(e)-1-suffix] == a[len(a)-1-suffix] {
+		suffix++
+	}
+
+	buf := new(strings.Builder)
+	fmt.Fprintf(buf, "@@ line %d @@\n", prefix+1)
+	for _, line := range e[prefix : len(e)-suffix] {
+		fmt.Fprintf(buf, "-%s\n", line)
+	}
+	for _, line := range a[prefix : len(a)-suffix] {
+		fmt.Fprintf(buf, "+%s\n", line)
+	}
+	return buf.String()
+}
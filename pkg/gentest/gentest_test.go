@@ -0,0 +1,102 @@
+package gentest
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/mvrahden/go-enumer/config"
+)
+
+// hint: test packages commonly declare their own update flag, which must not collide
+var _ = flag.Bool("update", false, "update the golden files of the test package")
+
+// recorder records the failures of a test instead of failing it.
+type recorder struct {
+	testing.TB
+	failures []string
+}
+
+type fatal struct{}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Errorf(format string, args ...any) {
+	r.failures = append(r.failures, fmt.Sprintf(format, args...))
+}
+
+func (r *recorder) Fatalf(format string, args ...any) {
+	r.Errorf(format, args...)
+	panic(fatal{})
+}
+
+func (r *recorder) run(fn func(t testing.TB)) {
+	defer func() {
+		if v := recover(); v != nil {
+			if _, ok := v.(fatal); !ok {
+				panic(v)
+			}
+		}
+	}()
+	fn(r)
+}
+
+func TestRun(t *testing.T) {
+	t.Run("Compare enums and their tests", func(t *testing.T) {
+		Run(t, filepath.Join("testdata", "weekday"), WithTests())
+	})
+	t.Run("Compare enums from CSV source", func(t *testing.T) {
+		Run(t, filepath.Join("testdata", "colors"))
+	})
+	t.Run("Fail on a deviating configuration", func(t *testing.T) {
+		cfg := config.LoadFrom(filepath.Join("testdata", "weekday", ConfigFile))
+		cfg.Serializers = append(cfg.Serializers, config.SerializerSQL)
+
+		r := &recorder{TB: t}
+		r.run(func(t testing.TB) { Run(t, filepath.Join("testdata", "weekday"), WithConfig(cfg)) })
+		require.Len(t, r.failures, 1)
+		require.Contains(t, r.failures[0], GoldenFile+" does not match the actual output (run with -gentest.update to update it)")
+		require.Contains(t, r.failures[0], "+func (_w Weekday) Value() (driver.Value, error) {")
+	})
+	t.Run("Fail on a missing golden file", func(t *testing.T) {
+		r := &recorder{TB: t}
+		r.run(func(t testing.TB) { compare(t, filepath.Join(t.TempDir(), GoldenFile), []byte("package x\n")) })
+		require.Len(t, r.failures, 1)
+		require.Contains(t, r.failures[0], "failed reading golden file (run with -gentest.update to create it)")
+	})
+	t.Run("Update the golden file", func(t *testing.T) {
+		defer func(v bool) { *update = v }(*update)
+		*update = true
+
+		golden := filepath.Join(t.TempDir(), GoldenFile)
+		require.NoError(t, os.WriteFile(golden, []byte("package x\n"), 0o644))
+		compare(t, golden, []byte("package y\n"))
+
+		actual, err := os.ReadFile(golden)
+		require.NoError(t, err)
+		require.Equal(t, "package y\n", string(actual))
+	})
+}
+
+func TestDiff(t *testing.T) {
+	for _, tC := range []struct {
+		desc     string
+		expected string
+		actual   string
+		diff     string
+	}{
+		{"equal", "a\nb\nc\n", "a\nb\nc\n", ""},
+		{"changed line", "a\nb\nc\n", "a\nx\nc\n", "@@ line 2 @@\n-b\n+x\n"},
+		{"added line", "a\nc\n", "a\nb\nc\n", "@@ line 2 @@\n+b\n"},
+		{"removed line", "a\nb\nc\n", "a\nc\n", "@@ line 2 @@\n-b\n"},
+		{"repeated lines", "a\na\n", "a\na\na\n", "@@ line 3 @@\n+a\n"},
+	} {
+		t.Run(tC.desc, func(t *testing.T) {
+			require.Equal(t, tC.diff, diff([]byte(tC.expected), []byte(tC.actual)))
+		})
+	}
+}
@@ -0,0 +1,4 @@
+package colors
+
+//go:enum -from=colors.csv
+type Color uint
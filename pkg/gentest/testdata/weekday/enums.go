@@ -0,0 +1,14 @@
+package weekday
+
+//go:enum
+type Weekday uint8
+
+const (
+	WeekdayMonday Weekday = iota + 1
+	WeekdayTuesday
+	WeekdayWednesday
+	WeekdayThursday
+	WeekdayFriday
+	WeekdaySaturday
+	WeekdaySunday
+)
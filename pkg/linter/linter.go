@@ -3,6 +3,7 @@ package linter
 import (
 	"errors"
 	"go/ast"
+	"go/types"
 
 	"golang.org/x/tools/go/analysis"
 	"golang.org/x/tools/go/analysis/passes/inspect"
@@ -31,10 +32,19 @@ func New(c *Config) *analysis.Analyzer {
 			}
 			return run(p, c)
 		},
-		Requires: []*analysis.Analyzer{inspect.Analyzer},
+		Requires:  []*analysis.Analyzer{inspect.Analyzer},
+		FactTypes: []analysis.Fact{new(deprecatedFact)},
 	}
 }
 
+// deprecatedFact is the fact of a constant, which refers to a deprecated enum value.
+// It is exported to report the usages of the constant in importing packages.
+type deprecatedFact struct{}
+
+func (*deprecatedFact) AFact() {}
+
+func (*deprecatedFact) String() string { return "deprecated" }
+
 func run(pass *analysis.Pass, c *Config) (_ interface{}, err error) {
 	inspector, ok := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
 	if !ok {
@@ -47,7 +57,8 @@ func run(pass *analysis.Pass, c *Config) (_ interface{}, err error) {
 
 	enumTypes := determineEnumTypes(inspector, pass, genFile)
 	if len(enumTypes) == 0 {
-		// nothing to evaluate
+		// hint: the package may still use deprecated values of imported enums
+		reportDeprecatedUsages(inspector, pass, genFile, nil)
 		return nil, nil
 	}
 	enumTypes = validateEnumTypes(pass, enumTypes)
@@ -56,6 +67,8 @@ func run(pass *analysis.Pass, c *Config) (_ interface{}, err error) {
 
 	enumTypes = loadAndValidateSpec(pass, enumTypes)
 
+	reportDeprecatedUsages(inspector, pass, genFile, enumTypes)
+
 	// hint: marking existence of generated file is deferred to here
 	// so that the enum blocks can be evaluated, even without the
 	// existence of it.
@@ -178,3 +191,39 @@ func loadAndValidateSpec(pass *analysis.Pass, enumTypes []*enumer.EnumType) []*e
 		return true
 	})
 }
+
+func reportDeprecatedUsages(inspector *inspector.Inspector, pass *analysis.Pass, genFile *ast.File, enumTypes []*enumer.EnumType) {
+	// collect the constants which refer to deprecated enum values
+	// and export them as facts for the importing packages
+	deprecated := map[types.Object]bool{}
+	for _, et := range enumTypes {
+		if et.ConstBlock == nil {
+			continue
+		}
+		for _, vs := range et.ConstBlock.Specs {
+			isDeprecated := slices.Any(et.Spec.Values, func(v *enumer.EnumTypeSpecValue, _ int) bool {
+				return v.ID == vs.Value && v.IsDeprecated
+			})
+			if isDeprecated {
+				deprecated[vs.GetObjectVia(pass.TypesInfo)] = true
+			}
+		}
+	}
+	for obj := range deprecated {
+		pass.ExportObjectFact(obj, new(deprecatedFact))
+	}
+
+	inspector.Preorder([]ast.Node{(*ast.Ident)(nil)}, func(n ast.Node) {
+		if genFile != nil && n.Pos() >= genFile.Pos() && n.Pos() <= genFile.End() {
+			return
+		}
+		ident := n.(*ast.Ident)
+		obj, ok := pass.TypesInfo.Uses[ident]
+		if !ok {
+			return
+		}
+		if deprecated[obj] || (obj.Pkg() != nil && obj.Pkg() != pass.Pkg && pass.ImportObjectFact(obj, new(deprecatedFact))) {
+			pass.Reportf(ident.Pos(), "%s is deprecated", ident.Name)
+		}
+	})
+}
@@ -25,7 +25,7 @@ func Test_Linter_All(t *testing.T) {
 	wd := utils.Must(os.Getwd())
 
 	testdata := filepath.Join(wd, "testdata")
-	analysistest.Run(t, testdata, New(&Config{}), "basic", "enums", "nonenums")
+	analysistest.Run(t, testdata, New(&Config{}), "basic", "deprecated", "deprecatedusage", "enums", "nonenums")
 }
 
 func Test_Linter_Csv(t *testing.T) {
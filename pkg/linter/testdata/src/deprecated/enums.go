@@ -0,0 +1,21 @@
+package deprecated
+
+// Method
+//go:enum
+type Method uint
+
+const (
+	MethodCard Method = iota + 1
+	// Deprecated: no longer accepted.
+	MethodCheque // want MethodCheque:"deprecated"
+	MethodDebit // Deprecated: use MethodCard instead. // want MethodDebit:"deprecated"
+)
+
+// Plan
+//go:enum -from=plans.csv
+type Plan uint
+
+const (
+	PlanFree   Plan = 1
+	PlanLegacy Plan = 2 // want PlanLegacy:"deprecated"
+)
@@ -0,0 +1,11 @@
+package deprecated
+
+func usage() []any {
+	return []any{
+		MethodCard,
+		MethodCheque, // want `MethodCheque is deprecated`
+		MethodDebit,  // want `MethodDebit is deprecated`
+		PlanFree,
+		PlanLegacy, // want `PlanLegacy is deprecated`
+	}
+}
@@ -0,0 +1,13 @@
+package deprecatedusage
+
+import "deprecated"
+
+func usage() []any {
+	return []any{
+		deprecated.MethodCard,
+		deprecated.MethodCheque, // want `MethodCheque is deprecated`
+		deprecated.MethodDebit,  // want `MethodDebit is deprecated`
+		deprecated.PlanFree,
+		deprecated.PlanLegacy, // want `PlanLegacy is deprecated`
+	}
+}
@@ -116,3 +116,11 @@ type InvalidL uint
 // InvalidM
 //go:enum -someOption=abc // want `unknown option \"someOption\"`
 type InvalidM uint
+
+// InvalidN
+//go:enum -transform=snkae // want `unknown transform strategy \"snkae\" \(did you mean \"snake\"\?\)`
+type InvalidN uint
+
+const (
+	InvalidNHello InvalidN = iota
+)
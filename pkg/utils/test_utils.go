@@ -3,13 +3,17 @@ package utils
 import (
 	"bytes"
 	"database/sql/driver"
+	"encoding/binary"
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 	"io"
 	"reflect"
 	"testing"
 
+	"github.com/fxamacker/cbor/v2"
 	"github.com/stretchr/testify/require"
+	"github.com/vmihailenco/msgpack/v5"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/bsontype"
 	"gopkg.in/yaml.v3"
@@ -59,6 +63,9 @@ type Expected struct {
 type TestConfig struct {
 	SupportUndefined bool
 	HasDefault       bool
+	// Default is the explicitly configured default value (`-default`),
+	// which is expected upon deserialization of empty values.
+	Default any
 }
 
 func AssertMissingSerializationInterfacesFor[T any](t *testing.T, missingSerializers []string) {
@@ -102,6 +109,41 @@ func assertSerializer[T any](t *testing.T, tC TestCase, cfg TestConfig, serializ
 			require.NoError(t, err)
 			require.Equal(t, tC.Expected.AsSerialized, string(j))
 		})
+		t.Run("AppendBinary", func(t *testing.T) {
+			enum := tC.Enum.(interface {
+				AppendBinary(b []byte) ([]byte, error)
+			})
+			j, err := enum.AppendBinary([]byte("prefix:"))
+			if tC.Expected.IsInvalid && isDefault(cfg.HasDefault, tC.Enum) {
+				require.Error(t, err)
+				require.Equal(t, "prefix:", string(j))
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, "prefix:"+tC.Expected.AsSerialized, string(j))
+		})
+
+	case "binary.varint":
+		t.Run("MarhsalBinary (varint)", func(t *testing.T) {
+			enum := tC.Enum.(interface {
+				MarshalBinary() (data []byte, err error)
+				AppendBinary(b []byte) ([]byte, error)
+			})
+			j, err := enum.MarshalBinary()
+			if tC.Expected.IsInvalid && isDefault(cfg.HasDefault, tC.Enum) {
+				require.Error(t, err)
+				j, err = enum.AppendBinary([]byte{0xff})
+				require.Error(t, err)
+				require.Equal(t, []byte{0xff}, j)
+				return
+			}
+			require.NoError(t, err)
+			expected := binary.AppendUvarint(nil, reflect.ValueOf(tC.Enum).Elem().Uint())
+			require.Equal(t, expected, j)
+			j, err = enum.AppendBinary([]byte{0xff})
+			require.NoError(t, err)
+			require.Equal(t, append([]byte{0xff}, expected...), j)
+		})
 
 	case "bson":
 		t.Run("MarshalBSONValue", func(t *testing.T) {
@@ -127,6 +169,32 @@ func assertSerializer[T any](t *testing.T, tC TestCase, cfg TestConfig, serializ
 			require.NotNil(t, actual)
 		})
 
+	case "cbor":
+		t.Run("MarshalCBOR", func(t *testing.T) {
+			cborSerialized, err := cbor.Marshal(tC.Expected.AsSerialized)
+			require.NoError(t, err)
+			enum := tC.Enum.(interface {
+				MarshalCBOR() ([]byte, error)
+			})
+			actual, err := enum.MarshalCBOR()
+			if tC.Expected.IsInvalid && isDefault(cfg.HasDefault, tC.Enum) {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, cborSerialized, actual)
+		})
+
+	case "flag":
+		t.Run("String (flag)", func(t *testing.T) {
+			enum := tC.Enum.(interface {
+				String() string
+				Type() string
+			})
+			require.NotEmpty(t, enum.Type())
+			require.Equal(t, tC.Expected.AsSerialized, enum.String())
+		})
+
 	case "graphql":
 		t.Run("MarhsalGQL", func(t *testing.T) {
 			enum := tC.Enum.(interface {
@@ -153,6 +221,36 @@ func assertSerializer[T any](t *testing.T, tC TestCase, cfg TestConfig, serializ
 			require.Equal(t, jsonSerialized, actual)
 		})
 
+	case "msgpack":
+		t.Run("MarshalMsgpack", func(t *testing.T) {
+			msgpackSerialized, err := msgpack.Marshal(tC.Expected.AsSerialized)
+			require.NoError(t, err)
+			enum := tC.Enum.(interface {
+				MarshalMsgpack() ([]byte, error)
+			})
+			actual, err := enum.MarshalMsgpack()
+			if tC.Expected.IsInvalid && isDefault(cfg.HasDefault, tC.Enum) {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, msgpackSerialized, actual)
+		})
+
+	case "gob":
+		t.Run("GobEncode", func(t *testing.T) {
+			enum := tC.Enum.(interface {
+				GobEncode() ([]byte, error)
+			})
+			actual, err := enum.GobEncode()
+			if tC.Expected.IsInvalid && isDefault(cfg.HasDefault, tC.Enum) {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tC.Expected.AsSerialized, string(actual))
+		})
+
 	case "sql":
 		t.Run("Value (SQL)", func(t *testing.T) {
 			enum := tC.Enum.(interface {
@@ -175,6 +273,28 @@ func assertSerializer[T any](t *testing.T, tC TestCase, cfg TestConfig, serializ
 			require.Equal(t, tC.Expected.AsSerialized, actual)
 		})
 
+	case "sql.int":
+		t.Run("Value (SQL int)", func(t *testing.T) {
+			enum := tC.Enum.(interface {
+				Value() (driver.Value, error)
+			})
+			actual, err := enum.Value()
+			if tC.Expected.IsInvalid && isDefault(cfg.HasDefault, tC.Enum) {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			if cfg.SupportUndefined && !cfg.HasDefault {
+				// If expected is Zero Value
+				// we need Nullability
+				if isZero(tC.Enum) {
+					require.Equal(t, nil, actual)
+					return
+				}
+			}
+			require.Equal(t, int64(reflect.ValueOf(tC.Enum).Elem().Uint()), actual)
+		})
+
 	case "text":
 		t.Run("MarhsalText", func(t *testing.T) {
 			enum := tC.Enum.(interface {
@@ -188,6 +308,59 @@ func assertSerializer[T any](t *testing.T, tC TestCase, cfg TestConfig, serializ
 			require.NoError(t, err)
 			require.Equal(t, tC.Expected.AsSerialized, string(actual))
 		})
+		t.Run("AppendText", func(t *testing.T) {
+			enum := tC.Enum.(interface {
+				AppendText(b []byte) ([]byte, error)
+			})
+			actual, err := enum.AppendText([]byte("prefix:"))
+			if tC.Expected.IsInvalid && isDefault(cfg.HasDefault, tC.Enum) {
+				require.Error(t, err)
+				require.Equal(t, "prefix:", string(actual))
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, "prefix:"+tC.Expected.AsSerialized, string(actual))
+		})
+
+	case "xml":
+		t.Run("MarshalXML", func(t *testing.T) {
+			enum := tC.Enum.(interface {
+				MarshalXML(e *xml.Encoder, start xml.StartElement) error
+			})
+			var buf bytes.Buffer
+			enc := xml.NewEncoder(&buf)
+			err := enum.MarshalXML(enc, xml.StartElement{Name: xml.Name{Local: "v"}})
+			if tC.Expected.IsInvalid && isDefault(cfg.HasDefault, tC.Enum) {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.NoError(t, enc.Flush())
+			var expected bytes.Buffer
+			require.NoError(t, xml.EscapeText(&expected, []byte(tC.Expected.AsSerialized)))
+			require.Equal(t, "<v>"+expected.String()+"</v>", buf.String())
+		})
+		t.Run("MarshalXMLAttr", func(t *testing.T) {
+			enum := tC.Enum.(interface {
+				MarshalXMLAttr(name xml.Name) (xml.Attr, error)
+			})
+			name := xml.Name{Local: "v"}
+			actual, err := enum.MarshalXMLAttr(name)
+			if tC.Expected.IsInvalid && isDefault(cfg.HasDefault, tC.Enum) {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			if cfg.SupportUndefined && !cfg.HasDefault {
+				// If expected is Zero Value
+				// the attribute is omitted
+				if isZero(tC.Enum) {
+					require.Equal(t, xml.Attr{}, actual)
+					return
+				}
+			}
+			require.Equal(t, xml.Attr{Name: name, Value: tC.Expected.AsSerialized}, actual)
+		})
 
 	case "yaml", "yaml.v3":
 		t.Run("MarhsalYAML", func(t *testing.T) {
@@ -252,6 +425,25 @@ func assertDeserializer[T any](t *testing.T, tC TestCase, cfg TestConfig, deseri
 			require.Equal(t, tC.Enum, enum)
 		})
 
+	case "binary.varint":
+		t.Run("UnmarshalBinary (varint)", func(t *testing.T) {
+			enum := zeroValuer[T]()
+			unmarshaler := (any)(enum).(interface {
+				UnmarshalBinary(data []byte) error
+			})
+			if tC.Expected.IsInvalid {
+				// hint: the string representation cannot be mapped onto a varint,
+				// hence truncated and out of range varints are asserted instead
+				require.Error(t, unmarshaler.UnmarshalBinary([]byte{0xff}))
+				require.Error(t, unmarshaler.UnmarshalBinary(binary.AppendUvarint(nil, 1<<63)))
+				return
+			}
+			data := binary.AppendUvarint(nil, reflect.ValueOf(tC.Enum).Elem().Uint())
+			require.Error(t, unmarshaler.UnmarshalBinary(append(data, 0)))
+			require.NoError(t, unmarshaler.UnmarshalBinary(data))
+			require.Equal(t, tC.Enum, enum)
+		})
+
 	case "bson":
 		t.Run("UnmarshalBSONValue", func(t *testing.T) {
 			enum := zeroValuer[T]()
@@ -271,6 +463,46 @@ func assertDeserializer[T any](t *testing.T, tC TestCase, cfg TestConfig, deseri
 			require.Equal(t, tC.Enum, enum)
 		})
 
+	case "cbor":
+		t.Run("UnmarshalCBOR", func(t *testing.T) {
+			enum := zeroValuer[T]()
+			err := (any)(enum).(interface {
+				UnmarshalCBOR([]byte) error
+			}).UnmarshalCBOR(Must(cbor.Marshal(tC.From)))
+			if tC.Expected.IsInvalid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tC.Enum, enum)
+		})
+
+	case "flag":
+		t.Run("Set (flag)", func(t *testing.T) {
+			enum := zeroValuer[T]()
+			err := (any)(enum).(interface {
+				Set(value string) error
+			}).Set(tC.From)
+			if tC.Expected.IsInvalid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tC.Enum, enum)
+		})
+		t.Run("Decode (envconfig)", func(t *testing.T) {
+			enum := zeroValuer[T]()
+			err := (any)(enum).(interface {
+				Decode(value string) error
+			}).Decode(tC.From)
+			if tC.Expected.IsInvalid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tC.Enum, enum)
+		})
+
 	case "graphql":
 		t.Run("UnmarshalGQL", func(t *testing.T) {
 			values := []any{tC.From, []byte(tC.From), stringer{tC.From}}
@@ -293,6 +525,11 @@ func assertDeserializer[T any](t *testing.T, tC TestCase, cfg TestConfig, deseri
 			err := (any)(enum).(interface {
 				UnmarshalGQL(value any) error
 			}).UnmarshalGQL(v)
+			if cfg.Default != nil {
+				require.NoError(t, err)
+				require.Equal(t, cfg.Default, *enum)
+				return
+			}
 			require.Equal(t, zeroValuer[T](), enum)
 			if !cfg.SupportUndefined {
 				require.Error(t, err)
@@ -315,6 +552,34 @@ func assertDeserializer[T any](t *testing.T, tC TestCase, cfg TestConfig, deseri
 			require.Equal(t, tC.Enum, enum)
 		})
 
+	case "msgpack":
+		t.Run("UnmarshalMsgpack", func(t *testing.T) {
+			enum := zeroValuer[T]()
+			err := (any)(enum).(interface {
+				UnmarshalMsgpack([]byte) error
+			}).UnmarshalMsgpack(Must(msgpack.Marshal(tC.From)))
+			if tC.Expected.IsInvalid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tC.Enum, enum)
+		})
+
+	case "gob":
+		t.Run("GobDecode", func(t *testing.T) {
+			enum := zeroValuer[T]()
+			err := (any)(enum).(interface {
+				GobDecode(data []byte) error
+			}).GobDecode([]byte(tC.From))
+			if tC.Expected.IsInvalid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tC.Enum, enum)
+		})
+
 	case "sql":
 		t.Run("Scan (SQL)", func(t *testing.T) {
 			values := []any{tC.From, []byte(tC.From), stringer{tC.From}}
@@ -337,6 +602,50 @@ func assertDeserializer[T any](t *testing.T, tC TestCase, cfg TestConfig, deseri
 			err := (any)(enum).(interface {
 				Scan(src any) error
 			}).Scan(v)
+			if cfg.Default != nil {
+				require.NoError(t, err)
+				require.Equal(t, cfg.Default, *enum)
+				return
+			}
+			require.Equal(t, zeroValuer[T](), enum)
+			if !cfg.SupportUndefined {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+
+	case "sql.int":
+		t.Run("Scan (SQL int)", func(t *testing.T) {
+			enum := zeroValuer[T]()
+			scanner := (any)(enum).(interface {
+				Scan(src any) error
+			})
+			if tC.Expected.IsInvalid {
+				// hint: the string representation cannot be mapped onto a number,
+				// hence negative and out of range numbers are asserted instead
+				require.Error(t, scanner.Scan(int64(-1)))
+				require.Error(t, scanner.Scan(int64(1<<62)))
+				require.Error(t, scanner.Scan(tC.From))
+				return
+			}
+			id := reflect.ValueOf(tC.Enum).Elem().Uint()
+			for _, v := range []any{int64(id), fmt.Sprint(id), []byte(fmt.Sprint(id))} {
+				*enum = *zeroValuer[T]()
+				require.NoError(t, scanner.Scan(v))
+				require.Equal(t, tC.Enum, enum)
+			}
+		})
+		t.Run("Scan from <nil> (SQL int)", func(t *testing.T) {
+			enum := zeroValuer[T]()
+			err := (any)(enum).(interface {
+				Scan(src any) error
+			}).Scan(nil)
+			if cfg.Default != nil {
+				require.NoError(t, err)
+				require.Equal(t, cfg.Default, *enum)
+				return
+			}
 			require.Equal(t, zeroValuer[T](), enum)
 			if !cfg.SupportUndefined {
 				require.Error(t, err)
@@ -359,6 +668,34 @@ func assertDeserializer[T any](t *testing.T, tC TestCase, cfg TestConfig, deseri
 			require.Equal(t, tC.Enum, enum)
 		})
 
+	case "xml":
+		t.Run("UnmarshalXML", func(t *testing.T) {
+			enum := zeroValuer[T]()
+			var from bytes.Buffer
+			require.NoError(t, xml.EscapeText(&from, []byte(tC.From)))
+			err := xml.Unmarshal([]byte("<v>"+from.String()+"</v>"), (any)(enum).(interface {
+				UnmarshalXML(d *xml.Decoder, start xml.StartElement) error
+			}))
+			if tC.Expected.IsInvalid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tC.Enum, enum)
+		})
+		t.Run("UnmarshalXMLAttr", func(t *testing.T) {
+			enum := zeroValuer[T]()
+			err := (any)(enum).(interface {
+				UnmarshalXMLAttr(attr xml.Attr) error
+			}).UnmarshalXMLAttr(xml.Attr{Name: xml.Name{Local: "v"}, Value: tC.From})
+			if tC.Expected.IsInvalid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tC.Enum, enum)
+		})
+
 	case "yaml":
 		t.Run("UnmarshalYAML", func(t *testing.T) {
 			enum := zeroValuer[T]()
@@ -396,7 +733,7 @@ func assertDeserializer[T any](t *testing.T, tC TestCase, cfg TestConfig, deseri
 func assertMissingSerializer[T any](t *testing.T, serializer string) {
 	var ok bool
 	switch serializer {
-	case "binary":
+	case "binary", "binary.varint":
 		t.Run("MarhsalBinary", func(t *testing.T) {
 			var enum T
 			_, ok = (any)(enum).(interface {
@@ -404,6 +741,22 @@ func assertMissingSerializer[T any](t *testing.T, serializer string) {
 			})
 		})
 
+	case "cbor":
+		t.Run("MarshalCBOR", func(t *testing.T) {
+			var enum T
+			_, ok = (any)(enum).(interface {
+				MarshalCBOR() ([]byte, error)
+			})
+		})
+
+	case "flag":
+		t.Run("Type (flag)", func(t *testing.T) {
+			var enum T
+			_, ok = (any)(enum).(interface {
+				Type() string
+			})
+		})
+
 	case "graphql":
 		t.Run("MarhsalGQL", func(t *testing.T) {
 			var enum T
@@ -420,7 +773,23 @@ func assertMissingSerializer[T any](t *testing.T, serializer string) {
 			})
 		})
 
-	case "sql":
+	case "msgpack":
+		t.Run("MarshalMsgpack", func(t *testing.T) {
+			var enum T
+			_, ok = (any)(enum).(interface {
+				MarshalMsgpack() ([]byte, error)
+			})
+		})
+
+	case "gob":
+		t.Run("GobEncode", func(t *testing.T) {
+			var enum T
+			_, ok = (any)(enum).(interface {
+				GobEncode() ([]byte, error)
+			})
+		})
+
+	case "sql", "sql.int":
 		t.Run("Value (SQL)", func(t *testing.T) {
 			var enum T
 			_, ok = (any)(enum).(interface {
@@ -436,6 +805,14 @@ func assertMissingSerializer[T any](t *testing.T, serializer string) {
 			})
 		})
 
+	case "xml":
+		t.Run("MarshalXML", func(t *testing.T) {
+			var enum T
+			_, ok = (any)(enum).(interface {
+				MarshalXML(e *xml.Encoder, start xml.StartElement) error
+			})
+		})
+
 	case "yaml", "yaml.v3":
 		t.Run("MarhsalYAML", func(t *testing.T) {
 			var enum T
@@ -453,13 +830,27 @@ func assertMissingSerializer[T any](t *testing.T, serializer string) {
 func assertMissingDeserializer[T any](t *testing.T, deserializer string) {
 	var ok bool
 	switch deserializer {
-	case "binary":
+	case "binary", "binary.varint":
 		t.Run("UnmarshalBinary", func(t *testing.T) {
 			_, ok = (any)(zeroValuer[T]()).(interface {
 				UnmarshalBinary(data []byte) error
 			})
 		})
 
+	case "cbor":
+		t.Run("UnmarshalCBOR", func(t *testing.T) {
+			_, ok = (any)(zeroValuer[T]()).(interface {
+				UnmarshalCBOR([]byte) error
+			})
+		})
+
+	case "flag":
+		t.Run("Set (flag)", func(t *testing.T) {
+			_, ok = (any)(zeroValuer[T]()).(interface {
+				Set(value string) error
+			})
+		})
+
 	case "graphql":
 		t.Run("UnmarshalGQL", func(t *testing.T) {
 			_, ok = (any)(zeroValuer[T]()).(interface {
@@ -479,7 +870,21 @@ func assertMissingDeserializer[T any](t *testing.T, deserializer string) {
 			})
 		})
 
-	case "sql":
+	case "msgpack":
+		t.Run("UnmarshalMsgpack", func(t *testing.T) {
+			_, ok = (any)(zeroValuer[T]()).(interface {
+				UnmarshalMsgpack([]byte) error
+			})
+		})
+
+	case "gob":
+		t.Run("GobDecode", func(t *testing.T) {
+			_, ok = (any)(zeroValuer[T]()).(interface {
+				GobDecode(data []byte) error
+			})
+		})
+
+	case "sql", "sql.int":
 		t.Run("Scan (SQL)", func(t *testing.T) {
 			_, ok = (any)(zeroValuer[T]()).(interface {
 				Scan(src any) error
@@ -493,6 +898,13 @@ func assertMissingDeserializer[T any](t *testing.T, deserializer string) {
 			})
 		})
 
+	case "xml":
+		t.Run("UnmarshalXML", func(t *testing.T) {
+			_, ok = (any)(zeroValuer[T]()).(interface {
+				UnmarshalXML(d *xml.Decoder, start xml.StartElement) error
+			})
+		})
+
 	case "yaml":
 		t.Run("UnmarshalYAML", func(t *testing.T) {
 			_, ok = (any)(zeroValuer[T]()).(interface {